@@ -0,0 +1,81 @@
+// Package clock abstracts the passing of time for the containers that depend on
+// it, so that they can be driven deterministically in tests.
+package clock
+
+import (
+	"time"
+)
+
+// Clock is an interface that tells the time and creates timers.
+type Clock interface {
+	// Now returns the current time.
+	//
+	// Returns:
+	//   - time.Time: The current time.
+	Now() time.Time
+
+	// NewTimer creates a timer that fires once, after the given duration.
+	//
+	// Parameters:
+	//   - d: The duration. Non-positive durations fire immediately.
+	//
+	// Returns:
+	//   - Timer: The timer. Never returns nil.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is an interface for a timer created by a Clock.
+type Timer interface {
+	// C returns the channel on which the time is sent when the timer fires.
+	//
+	// Returns:
+	//   - <-chan time.Time: The channel.
+	C() <-chan time.Time
+
+	// Stop prevents the timer from firing.
+	//
+	// Returns:
+	//   - bool: True if the timer was stopped, false if it had already fired or
+	//     been stopped.
+	Stop() bool
+}
+
+// System is a function that returns the clock of the system, backed by the time
+// package.
+//
+// Returns:
+//   - Clock: The clock. Never returns nil.
+func System() Clock {
+	return system_clock{}
+}
+
+// system_clock is the Clock of the system.
+type system_clock struct{}
+
+// Now implements the Clock interface.
+func (system_clock) Now() time.Time {
+	return time.Now()
+}
+
+// NewTimer implements the Clock interface.
+func (system_clock) NewTimer(d time.Duration) Timer {
+	return system_timer{
+		timer: time.NewTimer(d),
+	}
+}
+
+// system_timer is the Timer of the system clock.
+type system_timer struct {
+	// timer is the underlying timer.
+	timer *time.Timer
+}
+
+// C implements the Timer interface.
+func (t system_timer) C() <-chan time.Time {
+	return t.timer.C
+}
+
+// Stop implements the Timer interface.
+func (t system_timer) Stop() bool {
+	return t.timer.Stop()
+}
@@ -0,0 +1,134 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Fake is a Clock whose time only moves when told to. It is safe for concurrent
+// use.
+type Fake struct {
+	// now is the current time.
+	now time.Time
+
+	// timers are the timers that have not fired nor been stopped yet.
+	timers []*fake_timer
+
+	// mu guards the fields of the clock.
+	mu sync.Mutex
+}
+
+// NewFake is a function that creates a new Fake clock.
+//
+// Parameters:
+//   - now: The initial time of the clock.
+//
+// Returns:
+//   - *Fake: The new clock. Never returns nil.
+func NewFake(now time.Time) *Fake {
+	return &Fake{
+		now: now,
+	}
+}
+
+// Now implements the Clock interface.
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.now
+}
+
+// NewTimer implements the Clock interface.
+func (f *Fake) NewTimer(d time.Duration) Timer {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	t := &fake_timer{
+		clock: f,
+		at:    f.now.Add(d),
+		c:     make(chan time.Time, 1),
+	}
+
+	if d <= 0 {
+		t.c <- f.now
+	} else {
+		f.timers = append(f.timers, t)
+	}
+
+	return t
+}
+
+// Advance is a method of the Fake type. It moves the time of the clock forward and
+// fires the timers that are due.
+//
+// Parameters:
+//   - d: The duration to move forward by. Negative durations are a no-op.
+func (f *Fake) Advance(d time.Duration) {
+	if d < 0 {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.now = f.now.Add(d)
+
+	pending := f.timers[:0]
+
+	for _, t := range f.timers {
+		if t.at.After(f.now) {
+			pending = append(pending, t)
+		} else {
+			t.c <- f.now
+		}
+	}
+
+	clear(f.timers[len(pending):])
+	f.timers = pending
+}
+
+// Timers is a method of the Fake type. It returns the number of timers that have
+// not fired nor been stopped yet. It is useful to wait for a goroutine to start
+// waiting before advancing the clock.
+//
+// Returns:
+//   - int: The number of timers.
+func (f *Fake) Timers() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.timers)
+}
+
+// fake_timer is the Timer of a Fake clock.
+type fake_timer struct {
+	// clock is the clock that created the timer.
+	clock *Fake
+
+	// at is the time at which the timer fires.
+	at time.Time
+
+	// c is the channel of the timer.
+	c chan time.Time
+}
+
+// C implements the Timer interface.
+func (t *fake_timer) C() <-chan time.Time {
+	return t.c
+}
+
+// Stop implements the Timer interface.
+func (t *fake_timer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	for i, other := range t.clock.timers {
+		if other == t {
+			t.clock.timers = append(t.clock.timers[:i], t.clock.timers[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,137 @@
+// This command generates the specialized containers declared in a manifest.
+//
+// To use it, run the following command:
+//
+// //go:generate go run cmd/listlike-gen/main.go [ -manifest=<file> ] [ -check ]
+//
+// **Manifest**
+//
+// The manifest, "listlike.gen" by default, declares one container per line:
+//
+//	<kind> <name> <type> [safe] [limited] [g=<generics>] [o=<file>] [import=<path>,...]
+//
+// where <kind> is one of "stack", "queue" or "list", <name> is the exported name of the generated type and
+// <type> is the type of its elements. Everything after "//" on a line is a comment. For instance:
+//
+//	// Containers of the scheduler.
+//	queue JobQueue Job safe limited o=sched/containers.go
+//	stack IntStack int o=sched/containers.go
+//	list  PairList Pair[K,V] g=K/comparable,V/any
+//
+// The attributes are:
+//   - safe: The container is safe for concurrent use.
+//   - limited: The container supports a maximum capacity, through the WithCapacity option.
+//   - g: The type parameters of the type, as a list of <name>/<constraint> pairs separated by commas.
+//   - o: The file the type is written to, relative to the manifest. The name of the package is the name of
+//     its directory. Types with the same file are written together. The default is "<name>.go" in lower case.
+//   - import: The packages the element type needs.
+//
+// Each generated type embeds the container of the listlike module that matches its kind and attributes, and
+// comes with a New<name> constructor that takes the options of that container. When that container is an
+// interface, as for the safe stacks, the type also forwards Format, String and GoString to its dynamic value.
+//
+// **Flag: Check**
+//
+// This optional flag is used to only compare the generated files with their rendering. The command fails,
+// listing the offending files, if any of them is out of date, is missing, or was generated from the manifest
+// but is no longer declared in it; the latter are searched for in the whole tree of the manifest, apart from
+// the directories the go tool ignores. Otherwise, those files are written or removed.
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+
+	pkg "github.com/PlayerR9/listlike/cmd/listlike-gen/pkg"
+)
+
+var (
+	// ManifestFlag is the location of the manifest.
+	ManifestFlag *string
+
+	// CheckFlag tells whether the generated files are only checked.
+	CheckFlag *bool
+)
+
+func init() {
+	ManifestFlag = flag.String("manifest", "listlike.gen", "the location of the manifest.")
+
+	CheckFlag = flag.Bool("check", false, "whether to only check that the generated files are up to date. "+
+		"If set, nothing is written and the command fails if any file is stale.")
+}
+
+func main() {
+	flag.Parse()
+
+	// The outputs are relative to the manifest.
+	err := os.Chdir(filepath.Dir(*ManifestFlag))
+	if err != nil {
+		pkg.Logger.Fatal(err.Error())
+	}
+
+	source := filepath.Base(*ManifestFlag)
+
+	f, err := os.Open(source)
+	if err != nil {
+		pkg.Logger.Fatal(err.Error())
+	}
+
+	entries, err := pkg.ParseManifest(f)
+	f.Close()
+
+	if err != nil {
+		pkg.Logger.Fatalf("Could not parse %s: %s", source, err.Error())
+	}
+
+	files, err := pkg.Render(source, entries)
+	if err != nil {
+		pkg.Logger.Fatalf("Could not generate code: %s", err.Error())
+	}
+
+	reports, err := pkg.Compare(source, ".", files)
+	if err != nil {
+		pkg.Logger.Fatal(err.Error())
+	}
+
+	if *CheckFlag {
+		var stale int
+
+		for _, r := range reports {
+			if r.Status != pkg.UpToDate {
+				pkg.Logger.Printf("%s: %s", r.Path, r.Status)
+				stale++
+			}
+		}
+
+		if stale > 0 {
+			pkg.Logger.Fatalf("%d generated file(s) are stale; run listlike-gen without -check", stale)
+		}
+
+		return
+	}
+
+	for _, r := range reports {
+		switch r.Status {
+		case pkg.OutOfDate, pkg.Missing:
+			err := os.MkdirAll(filepath.Dir(r.Path), 0755)
+			if err != nil {
+				pkg.Logger.Fatal(err.Error())
+			}
+
+			err = os.WriteFile(r.Path, r.File.Data, 0644)
+			if err != nil {
+				pkg.Logger.Fatal(err.Error())
+			}
+
+			pkg.Logger.Printf("Successfully generated: %q", r.Path)
+		case pkg.Orphaned:
+			err := os.Remove(r.Path)
+			if err != nil {
+				pkg.Logger.Fatal(err.Error())
+			}
+
+			pkg.Logger.Printf("Removed orphaned file: %q", r.Path)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package pkg
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Status is the state of a generated file on disk.
+type Status int
+
+const (
+	// UpToDate means that the file matches its rendering.
+	UpToDate Status = iota
+
+	// OutOfDate means that the file differs from its rendering.
+	OutOfDate
+
+	// Missing means that the file does not exist.
+	Missing
+
+	// Orphaned means that the file was generated from the manifest but is no
+	// longer declared in it.
+	Orphaned
+)
+
+// String implements the fmt.Stringer interface.
+func (s Status) String() string {
+	switch s {
+	case UpToDate:
+		return "up to date"
+	case OutOfDate:
+		return "out of date"
+	case Missing:
+		return "missing"
+	case Orphaned:
+		return "orphaned"
+	default:
+		return "unknown"
+	}
+}
+
+// Report is the state of a generated file.
+type Report struct {
+	// File is the rendering of the file. Nil if the file is orphaned.
+	File *File
+
+	// Path is the location of the file.
+	Path string
+
+	// Status is the state of the file.
+	Status Status
+}
+
+// Compare compares the rendered files with the files on disk. The tree of the
+// manifest is also searched for files generated from it that it no longer
+// declares, including in directories that no longer receive any output.
+//
+// Parameters:
+//   - source: The name of the manifest.
+//   - root: The directory of the manifest, whose tree is searched.
+//   - files: The rendered files.
+//
+// Returns:
+//   - []Report: The state of each rendered file, in order, followed by the
+//     orphaned files in lexical order.
+//   - error: An error if the files could not be read.
+func Compare(source, root string, files []*File) ([]Report, error) {
+	reports := make([]Report, 0, len(files))
+
+	paths := make([]string, 0, len(files))
+
+	for _, f := range files {
+		paths = append(paths, filepath.Clean(f.Path))
+
+		data, err := os.ReadFile(f.Path)
+
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			reports = append(reports, Report{File: f, Path: f.Path, Status: Missing})
+		case err != nil:
+			return nil, err
+		case bytes.Equal(data, f.Data):
+			reports = append(reports, Report{File: f, Path: f.Path, Status: UpToDate})
+		default:
+			reports = append(reports, Report{File: f, Path: f.Path, Status: OutOfDate})
+		}
+	}
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if path != root && is_ignored_dir(d.Name()) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if filepath.Ext(path) != ".go" || slices.Contains(paths, filepath.Clean(path)) {
+			return nil
+		}
+
+		ok, err := is_generated(path, source)
+		if ok {
+			reports = append(reports, Report{Path: path, Status: Orphaned})
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+// is_ignored_dir checks whether the go tool ignores a directory, in which case
+// nothing generated in it can be built and it is not searched.
+//
+// Parameters:
+//   - name: The name of the directory.
+//
+// Returns:
+//   - bool: True if the directory is ignored, false otherwise.
+func is_ignored_dir(name string) bool {
+	return strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "testdata" || name == "vendor"
+}
+
+// is_generated checks whether a file was generated from the given manifest.
+//
+// Parameters:
+//   - path: The location of the file.
+//   - source: The name of the manifest.
+//
+// Returns:
+//   - bool: True if the first line of the file is the header of the manifest.
+//   - error: An error if the file could not be read.
+func is_generated(path, source string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	if !scanner.Scan() {
+		return false, scanner.Err()
+	}
+
+	return scanner.Text() == Header(source), nil
+}
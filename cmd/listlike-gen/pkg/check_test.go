@@ -0,0 +1,141 @@
+package pkg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestCompare checks the state reported for each kind of file on disk.
+func TestCompare(t *testing.T) {
+	const source = "containers.txt"
+
+	dir := t.TempDir()
+	header := Header(source) + "\n\npackage pkg\n"
+
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+
+		err := os.WriteFile(path, []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return path
+	}
+
+	files := []*File{
+		{Path: write("fresh.go", header+"// fresh\n"), Data: []byte(header + "// fresh\n")},
+		{Path: write("stale.go", header+"// old\n"), Data: []byte(header + "// new\n")},
+		{Path: filepath.Join(dir, "missing.go"), Data: []byte(header)},
+	}
+
+	orphan := write("orphan.go", header)
+
+	// Not generated from the manifest: no header, the header of another
+	// manifest, the header after the first line, or not a Go file.
+	write("hand.go", "package pkg\n")
+	write("other.go", Header("other.txt")+"\n\npackage pkg\n")
+	write("late.go", "// Hand written.\n"+header)
+	write("notes.txt", header)
+
+	reports, err := Compare(source, dir, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Report{
+		{File: files[0], Path: files[0].Path, Status: UpToDate},
+		{File: files[1], Path: files[1].Path, Status: OutOfDate},
+		{File: files[2], Path: files[2].Path, Status: Missing},
+		{Path: orphan, Status: Orphaned},
+	}
+
+	if len(reports) != len(want) {
+		t.Fatalf("got %d reports, want %d: %v", len(reports), len(want), reports)
+	}
+
+	for i, got := range reports {
+		if got != want[i] {
+			t.Errorf("report %d: got %v %s, want %v %s", i, got.Path, got.Status, want[i].Path, want[i].Status)
+		}
+	}
+}
+
+// TestCompareEmptyFile checks that an empty file in the directory of a rendered
+// file is not reported.
+func TestCompareEmptyFile(t *testing.T) {
+	dir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(dir, "empty.go"), nil, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := []*File{{Path: filepath.Join(dir, "a.go")}}
+
+	reports, err := Compare("containers.txt", dir, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(reports) != 1 || reports[0].Status != Missing {
+		t.Fatalf("got %v, want a single missing report", reports)
+	}
+}
+
+// TestCompareTree checks that the orphaned files are found anywhere in the tree of
+// the manifest, even in a directory that no longer receives any output, but not
+// in the directories that the go tool ignores.
+func TestCompareTree(t *testing.T) {
+	const source = "containers.txt"
+
+	root := t.TempDir()
+	header := Header(source) + "\n\npackage pkg\n"
+
+	write := func(name string) string {
+		path := filepath.Join(root, name)
+
+		err := os.MkdirAll(filepath.Dir(path), 0755)
+		if err == nil {
+			err = os.WriteFile(path, []byte(header), 0644)
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return path
+	}
+
+	files := []*File{{Path: write("a/kept.go"), Data: []byte(header)}}
+
+	want := []string{
+		write("a/b/nested.go"),
+		write("a/orphan.go"),
+		write("moved/old.go"),
+		write("top.go"),
+	}
+
+	write(".hidden/old.go")
+	write("_build/old.go")
+	write("moved/testdata/old.go")
+	write("vendor/example.com/old.go")
+
+	reports, err := Compare(source, root, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(reports) != 1+len(want) || reports[0].Status != UpToDate {
+		t.Fatalf("got %v, want the rendered file and %d orphans", reports, len(want))
+	}
+
+	for i, path := range want {
+		got := reports[1+i]
+
+		if got.Path != path || got.Status != Orphaned || got.File != nil {
+			t.Errorf("report %d: got %v %s, want %v orphaned", 1+i, got.Path, got.Status, path)
+		}
+	}
+}
@@ -0,0 +1,308 @@
+package pkg
+
+import (
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+
+	ggen "github.com/PlayerR9/go-generator/generator"
+)
+
+var (
+	// Logger is the logger to use.
+	Logger *log.Logger
+)
+
+func init() {
+	Logger = log.New(os.Stdout, "[listlike-gen]: ", log.LstdFlags)
+}
+
+// impl is the container a generated type is built on.
+type impl struct {
+	// pkg is the package of the container.
+	pkg string
+
+	// base is the name of the container type.
+	base string
+
+	// ctor is the name of the constructor of the container.
+	ctor string
+
+	// iface tells whether base is an interface, which is embedded by value.
+	iface bool
+
+	// safe tells whether the constructor must be asked for thread safety.
+	safe bool
+}
+
+// pick_impl returns the container a generated type is built on.
+//
+// Parameters:
+//   - e: The entry. Assumed to be non-nil and of a known kind.
+//
+// Returns:
+//   - impl: The container.
+func pick_impl(e *Entry) impl {
+	switch e.Kind {
+	case "stack":
+		switch {
+		case e.Safe:
+			return impl{pkg: "stack", base: "Stacker", ctor: "New", iface: true, safe: true}
+		case e.Limited:
+			return impl{pkg: "stack", base: "LimitedLinkedStack", ctor: "NewLimitedLinkedStack"}
+		default:
+			return impl{pkg: "stack", base: "LinkedStack", ctor: "NewLinkedStack"}
+		}
+	case "queue":
+		switch {
+		case e.Safe && e.Limited:
+			return impl{pkg: "queue", base: "LimitedSafeQueue", ctor: "NewLimitedSafeQueue"}
+		case e.Safe:
+			return impl{pkg: "queue", base: "SafeQueue", ctor: "NewSafeQueue"}
+		case e.Limited:
+			return impl{pkg: "queue", base: "LimitedLinkedQueue", ctor: "NewLimitedLinkedQueue"}
+		default:
+			return impl{pkg: "queue", base: "LinkedQueue", ctor: "NewLinkedQueue"}
+		}
+	default:
+		if e.Safe {
+			return impl{pkg: "list", base: "LimitedSafeList", ctor: "NewSafeList"}
+		}
+
+		return impl{pkg: "list", base: "LinkedList", ctor: "NewLinkedList"}
+	}
+}
+
+// TypeData is the generation data of a type.
+type TypeData struct {
+	// Entry is the entry of the type.
+	Entry *Entry
+
+	// TypeParams and TypeArgs are the type parameters and arguments of the type.
+	// Empty if it is not generic.
+	TypeParams, TypeArgs string
+
+	// Embedded is the embedded container, such as "*queue.LinkedQueue[int]".
+	Embedded string
+
+	// Forward is the name of the embedded field when it is an interface, whose
+	// dynamic value gets the formatting methods forwarded to it as they are not
+	// promoted. Empty otherwise.
+	Forward string
+
+	// Ctor is the call of the constructor of the container.
+	Ctor string
+}
+
+// FileData is the generation data of a file.
+type FileData struct {
+	PackageName string
+	Imports     []string
+
+	// Source is the name of the manifest.
+	Source string
+
+	// Types are the generation data of the types, in order.
+	Types []*TypeData
+}
+
+func (fd *FileData) SetPackageName(name string) {
+	fd.PackageName = name
+}
+
+var (
+	// Generator is the code Generator.
+	Generator *ggen.CodeGenerator[*FileData]
+)
+
+func init() {
+	tmp, err := ggen.NewCodeGeneratorFromTemplate[*FileData]("", templ)
+	if err != nil {
+		Logger.Fatalf("Could not initialize generator: %s", err.Error())
+	}
+
+	tmp.AddDoFunc(func(fd *FileData) error {
+		imports := []string{"github.com/PlayerR9/listlike/options"}
+
+		for _, td := range fd.Types {
+			e := td.Entry
+
+			params, args, err := type_params(e.Generics)
+			if err != nil {
+				return fmt.Errorf("line %d: %w", e.Line, err)
+			}
+
+			td.TypeParams = params
+			td.TypeArgs = args
+
+			im := pick_impl(e)
+
+			qualifier := im.pkg + "."
+			if fd.PackageName == im.pkg {
+				qualifier = ""
+			} else {
+				imports = append(imports, "github.com/PlayerR9/listlike/"+im.pkg)
+			}
+
+			td.Embedded = qualifier + im.base + "[" + e.DataType + "]"
+			if im.iface {
+				td.Forward = im.base
+				imports = append(imports, "fmt")
+			} else {
+				td.Embedded = "*" + td.Embedded
+			}
+
+			td.Ctor = qualifier + im.ctor + "[" + e.DataType + "](opts...)"
+			if im.safe {
+				td.Ctor = qualifier + im.ctor + "[" + e.DataType + "](append(slices.Clip(opts), options.WithThreadSafety(true))...)"
+				imports = append(imports, "slices")
+			}
+
+			imports = append(imports, e.Imports...)
+		}
+
+		fd.Imports = ggen.GetPackages(imports)
+
+		return nil
+	})
+
+	Generator = tmp
+}
+
+// Header returns the first line of the files generated from a manifest.
+//
+// Parameters:
+//   - source: The name of the manifest.
+//
+// Returns:
+//   - string: The first line, without the line break.
+func Header(source string) string {
+	return "// Code generated by listlike-gen from " + source + ". DO NOT EDIT."
+}
+
+// File is a generated file.
+type File struct {
+	// Path is the location of the file.
+	Path string
+
+	// Data is the content of the file.
+	Data []byte
+}
+
+// Render renders the files declared by the entries of a manifest. The entries
+// with the same output are written to the same file.
+//
+// Parameters:
+//   - source: The name of the manifest.
+//   - entries: The entries of the manifest.
+//
+// Returns:
+//   - []*File: The files, in the order of their first entry.
+//   - error: An error if a file could not be rendered.
+func Render(source string, entries []*Entry) ([]*File, error) {
+	var (
+		order  []string
+		groups = make(map[string]*FileData)
+	)
+
+	for _, e := range entries {
+		fd, ok := groups[e.Output]
+		if !ok {
+			fd = &FileData{
+				Source: source,
+			}
+
+			groups[e.Output] = fd
+			order = append(order, e.Output)
+		}
+
+		fd.Types = append(fd.Types, &TypeData{
+			Entry: e,
+		})
+	}
+
+	files := make([]*File, 0, len(order))
+
+	for _, output := range order {
+		o := new(ggen.OutputLocVal)
+
+		err := o.Set(output)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := Generator.Generate(o, output, groups[output])
+		if err != nil {
+			return nil, fmt.Errorf("could not generate %q: %w", output, err)
+		}
+
+		data, err := format.Source(res.Data)
+		if err != nil {
+			return nil, fmt.Errorf("could not format %q: %w", res.DestLoc, err)
+		}
+
+		files = append(files, &File{
+			Path: res.DestLoc,
+			Data: data,
+		})
+	}
+
+	return files, nil
+}
+
+const templ = `// Code generated by listlike-gen from {{ .Source }}. DO NOT EDIT.
+
+package {{ .PackageName }}
+
+import (
+{{- range .Imports }}
+	"{{ . }}"
+{{- end }}
+)
+{{ range .Types }}{{ $e := .Entry }}
+// {{ $e.Name }} is a{{ if $e.Safe }} thread-safe{{ end }}{{ if $e.Limited }} limited{{ end }} {{ $e.Kind }} of {{ $e.DataType }} values.
+type {{ $e.Name }}{{ .TypeParams }} struct {
+	{{ .Embedded }}
+}
+
+// New{{ $e.Name }} creates a new {{ $e.Name }}.
+//
+// Parameters:
+//   - opts: The options of the {{ $e.Kind }}.
+//
+// Returns:
+//   - *{{ $e.Name }}{{ .TypeArgs }}: A pointer to the newly created {{ $e.Name }}.
+//   - error: An error if the options are invalid.
+func New{{ $e.Name }}{{ .TypeParams }}(opts ...options.Option) (*{{ $e.Name }}{{ .TypeArgs }}, error) {
+	inner, err := {{ .Ctor }}
+	if err != nil {
+		return nil, err
+	}
+
+	return &{{ $e.Name }}{{ .TypeArgs }}{inner}, nil
+}
+{{- if .Forward }}
+
+// Format implements the fmt.Formatter interface.
+func (c *{{ $e.Name }}{{ .TypeArgs }}) Format(f fmt.State, verb rune) {
+	formatter, ok := c.{{ .Forward }}.(fmt.Formatter)
+	if ok {
+		formatter.Format(f, verb)
+		return
+	}
+
+	fmt.Fprintf(f, fmt.FormatString(f, verb), c.{{ .Forward }})
+}
+
+// String implements the fmt.Stringer interface.
+func (c *{{ $e.Name }}{{ .TypeArgs }}) String() string {
+	return fmt.Sprint(c.{{ .Forward }})
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (c *{{ $e.Name }}{{ .TypeArgs }}) GoString() string {
+	return c.{{ .Forward }}.GoString()
+}
+{{- end }}
+{{ end }}`
@@ -0,0 +1,76 @@
+package pkg
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestRenderForwarding checks that only the types embedding an interface get
+// the formatting methods, which are not promoted from its dynamic value.
+func TestRenderForwarding(t *testing.T) {
+	// The name of the package is the name of its directory.
+	dir := filepath.Join(t.TempDir(), "gen")
+
+	err := os.Mkdir(dir, 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = os.WriteFile(filepath.Join(dir, "doc.go"), []byte("package gen\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output := filepath.Join(dir, "containers.go")
+
+	manifest := "stack SafeInts int safe o=" + output + "\n" +
+		"stack Ints int o=" + output + "\n" +
+		"queue SafeStrings string safe o=" + output + "\n"
+
+	entries, err := ParseManifest(strings.NewReader(manifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := Render("listlike.gen", entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) != 1 {
+		t.Fatalf("got %d files, want 1", len(files))
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), "containers.go", files[0].Data, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	methods := make(map[string][]string)
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil {
+			continue
+		}
+
+		recv := fn.Recv.List[0].Type.(*ast.StarExpr).X.(*ast.Ident).Name
+		methods[recv] = append(methods[recv], fn.Name.Name)
+	}
+
+	if got := methods["SafeInts"]; !slices.Equal(got, []string{"Format", "String", "GoString"}) {
+		t.Errorf("SafeInts: got methods %v, want [Format String GoString]", got)
+	}
+
+	for _, name := range []string{"Ints", "SafeStrings"} {
+		if got := methods[name]; len(got) != 0 {
+			t.Errorf("%s: got methods %v, want none", name, got)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package pkg
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	ggen "github.com/PlayerR9/go-generator/generator"
+)
+
+// Entry is a container declared in a manifest.
+type Entry struct {
+	// Kind is the kind of the container; either "stack", "queue" or "list".
+	Kind string
+
+	// Name is the name of the generated type.
+	Name string
+
+	// DataType is the type of the elements.
+	DataType string
+
+	// Generics are the type parameters, such as "T/any,K/comparable". Empty if the
+	// type is not generic.
+	Generics string
+
+	// Safe tells whether the container is safe for concurrent use.
+	Safe bool
+
+	// Limited tells whether the container supports a maximum capacity.
+	Limited bool
+
+	// Output is the file the type is written to, relative to the manifest.
+	Output string
+
+	// Imports are the packages the data type needs.
+	Imports []string
+
+	// Line is the line of the entry in the manifest.
+	Line int
+}
+
+var (
+	// kinds are the supported kinds of containers.
+	kinds = []string{"list", "queue", "stack"}
+)
+
+// ParseManifest parses a manifest. Each non-empty line declares a container:
+//
+//	<kind> <name> <type> [safe] [limited] [g=<generics>] [o=<file>] [import=<path>,...]
+//
+// Everything after "//" on a line is a comment.
+//
+// Parameters:
+//   - r: The manifest.
+//
+// Returns:
+//   - []*Entry: The entries, in order.
+//   - error: An error if the manifest is invalid.
+func ParseManifest(r io.Reader) ([]*Entry, error) {
+	var entries []*Entry
+
+	names := make(map[string]int)
+
+	scanner := bufio.NewScanner(r)
+
+	for line := 1; scanner.Scan(); line++ {
+		text, _, _ := strings.Cut(scanner.Text(), "//")
+
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+
+		entry, err := parse_entry(fields)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		if prev, ok := names[entry.Name]; ok {
+			return nil, fmt.Errorf("line %d: %s is already declared at line %d", line, entry.Name, prev)
+		}
+
+		entry.Line = line
+		names[entry.Name] = line
+
+		entries = append(entries, entry)
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// parse_entry parses the fields of a line of a manifest.
+//
+// Parameters:
+//   - fields: The fields of the line. Assumed to be non-empty.
+//
+// Returns:
+//   - *Entry: The entry.
+//   - error: An error if the fields are invalid.
+func parse_entry(fields []string) (*Entry, error) {
+	if len(fields) < 3 {
+		return nil, fmt.Errorf("expected <kind> <name> <type>, got %q", strings.Join(fields, " "))
+	}
+
+	kind := fields[0]
+	if !slices.Contains(kinds, kind) {
+		return nil, fmt.Errorf("unknown kind %q: must be one of %s", kind, strings.Join(kinds, ", "))
+	}
+
+	err := ggen.IsValidVariableName(fields[1], nil, ggen.Exported)
+	if err != nil {
+		return nil, fmt.Errorf("invalid name %q: %w", fields[1], err)
+	}
+
+	entry := &Entry{
+		Kind:     kind,
+		Name:     fields[1],
+		DataType: fields[2],
+		Output:   strings.ToLower(fields[1]) + ".go",
+	}
+
+	for _, field := range fields[3:] {
+		key, value, ok := strings.Cut(field, "=")
+
+		switch {
+		case !ok && key == "safe":
+			entry.Safe = true
+		case !ok && key == "limited":
+			entry.Limited = true
+		case ok && key == "g":
+			entry.Generics = value
+		case ok && key == "o":
+			entry.Output = value
+		case ok && key == "import":
+			entry.Imports = append(entry.Imports, strings.Split(value, ",")...)
+		default:
+			return nil, fmt.Errorf("unknown attribute %q", field)
+		}
+	}
+
+	return entry, nil
+}
+
+// type_params returns the type parameters and the type arguments of generics.
+//
+// Parameters:
+//   - generics: The generics, such as "T/any,K/comparable".
+//
+// Returns:
+//   - string: The type parameters, such as "[T any, K comparable]".
+//   - string: The type arguments, such as "[T, K]".
+//   - error: An error if the generics are invalid.
+func type_params(generics string) (string, string, error) {
+	if generics == "" {
+		return "", "", nil
+	}
+
+	var params, args []string
+
+	for _, field := range strings.Split(generics, ",") {
+		name, constraint, ok := strings.Cut(field, "/")
+		if !ok || name == "" || constraint == "" {
+			return "", "", fmt.Errorf("invalid generic %q: expected <name>/<constraint>", field)
+		}
+
+		params = append(params, name+" "+constraint)
+		args = append(args, name)
+	}
+
+	return "[" + strings.Join(params, ", ") + "]", "[" + strings.Join(args, ", ") + "]", nil
+}
@@ -0,0 +1,94 @@
+package pkg
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestParseManifest checks the entries parsed from a valid manifest.
+func TestParseManifest(t *testing.T) {
+	const manifest = `// Containers of the scheduler.
+queue JobQueue Job safe limited o=sched/containers.go
+
+stack IntStack int // A comment.
+list  PairList Pair[K,V] g=K/comparable,V/any import=time,strings
+`
+
+	entries, err := ParseManifest(strings.NewReader(manifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Entry{
+		{Kind: "queue", Name: "JobQueue", DataType: "Job", Safe: true, Limited: true, Output: "sched/containers.go", Line: 2},
+		{Kind: "stack", Name: "IntStack", DataType: "int", Output: "intstack.go", Line: 4},
+		{Kind: "list", Name: "PairList", DataType: "Pair[K,V]", Generics: "K/comparable,V/any", Output: "pairlist.go", Imports: []string{"time", "strings"}, Line: 5},
+	}
+
+	if len(entries) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(entries), len(want))
+	}
+
+	for i, got := range entries {
+		w := want[i]
+
+		if got.Kind != w.Kind || got.Name != w.Name || got.DataType != w.DataType ||
+			got.Generics != w.Generics || got.Safe != w.Safe || got.Limited != w.Limited ||
+			got.Output != w.Output || got.Line != w.Line || !slices.Equal(got.Imports, w.Imports) {
+			t.Errorf("entry %d: got %+v, want %+v", i, *got, w)
+		}
+	}
+}
+
+// TestParseManifestErrors checks that invalid manifests are rejected with the line
+// of the offending entry.
+func TestParseManifestErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		want     string
+	}{
+		{"too few fields", "stack IntStack", "line 1: expected <kind> <name> <type>"},
+		{"unknown kind", "tree IntTree int", `line 1: unknown kind "tree"`},
+		{"unexported name", "stack intStack int", `line 1: invalid name "intStack"`},
+		{"name not an identifier", "stack 9Stack int", `line 1: invalid name "9Stack"`},
+		{"unknown attribute", "stack IntStack int fast", `line 1: unknown attribute "fast"`},
+		{"unknown key", "stack IntStack int x=1", `line 1: unknown attribute "x=1"`},
+		{"valued flag", "stack IntStack int safe=true", `line 1: unknown attribute "safe=true"`},
+		{"duplicate name", "stack IntStack int\n\nqueue IntStack int", "line 3: IntStack is already declared at line 1"},
+		{"duplicate after comment", "// IntStack\nstack IntStack int\nlist IntStack string // again", "line 3: IntStack is already declared at line 2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseManifest(strings.NewReader(tt.manifest))
+			if err == nil {
+				t.Fatalf("got no error, want %q", tt.want)
+			}
+
+			if !strings.HasPrefix(err.Error(), tt.want) {
+				t.Fatalf("got error %q, want %q", err.Error(), tt.want)
+			}
+		})
+	}
+}
+
+// TestTypeParams checks the parsing of the generics of an entry.
+func TestTypeParams(t *testing.T) {
+	params, args, err := type_params("K/comparable,V/any")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if params != "[K comparable, V any]" || args != "[K, V]" {
+		t.Fatalf("got %q and %q", params, args)
+	}
+
+	for _, generics := range []string{"K", "K/", "/any", "K/any,"} {
+		_, _, err := type_params(generics)
+		if err == nil {
+			t.Errorf("%q: got no error", generics)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+// Package golden holds linked stacks generated by cmd/stack for element types
+// that need more than a plain identifier: pointer, qualified, slice, map and
+// nested generic types; and for stacks with the equality and hashing methods of
+// the -eq and -hash flags. The files are the expected output of the generator and
+// are regenerated by go generate at the root of the module; since the package is
+// built with the rest of the module, they must also compile.
+//
+// To only check that they are up to date, run the generate directives of the
+// root of the module with the -diff flag, or the tests of cmd/stack/pkg; which
+// rewrite the files when given the -update flag.
+package golden
+
+import (
+	"hash/fnv"
+)
+
+// Pair is a key-value pair, used as a generic element type.
+type Pair[K comparable, V any] struct {
+	// Key is the key of the pair.
+	Key K
+
+	// Value is the value of the pair.
+	Value V
+}
+
+// HashBytes hashes a byte slice with FNV-1a, consistently with bytes.Equal.
+//
+// Parameters:
+//   - b: The byte slice.
+//
+// Returns:
+//   - uint64: The hash.
+func HashBytes(b []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(b)
+
+	return h.Sum64()
+}
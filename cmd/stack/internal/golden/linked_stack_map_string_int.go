@@ -0,0 +1,306 @@
+// Code generated with go generate. DO NOT EDIT.
+package golden
+
+import (
+	"fmt"
+	"github.com/PlayerR9/iterators/simple"
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+)
+
+// stack_node_map_string_int is a node in the linked stack.
+type stack_node_map_string_int struct {
+	value map[string]int
+	next *stack_node_map_string_int
+}
+
+// MapStringIntStack is a stack of map[string]int values implemented without a maximum capacity
+// and using a linked list.
+type MapStringIntStack struct {
+	front *stack_node_map_string_int
+	size int
+
+	// free is the first node of the pool of free nodes.
+	free *stack_node_map_string_int
+
+	// free_size is the number of free nodes.
+	free_size int
+
+	// free_limit is the maximum number of free nodes. 0 disables pooling.
+	free_limit int
+}
+
+// NewMapStringIntStack creates a new linked stack.
+//
+// Parameters:
+//   - opts: The options of the stack. Supported options are WithInitialValues, which
+//     are pushed in order so that the last one is on top, and WithNodePool.
+//
+// Returns:
+//   - *MapStringIntStack: A pointer to the newly created stack.
+//   - error: An error if the options are invalid.
+func NewMapStringIntStack(opts ...options.Option) (*MapStringIntStack, error) {
+	settings, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = settings.Only("MapStringIntStack", "WithInitialValues", "WithNodePool")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[map[string]int](settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &MapStringIntStack{
+		free_limit: settings.NodePool,
+	}
+
+	s.PushMany(values)
+
+	return s, nil
+}
+
+// getNode returns a node holding the value, reusing a free node if there is one.
+func (s *MapStringIntStack) getNode(value map[string]int) *stack_node_map_string_int {
+	if s.free == nil {
+		return &stack_node_map_string_int{
+			value: value,
+		}
+	}
+
+	node := s.free
+
+	s.free = node.next
+	s.free_size--
+
+	node.value = value
+	node.next = nil
+
+	return node
+}
+
+// putNode zeroes the node and keeps it for reuse unless the pool is full.
+func (s *MapStringIntStack) putNode(node *stack_node_map_string_int) {
+	if s.free_size >= s.free_limit {
+		return
+	}
+
+	node.value = nil
+	node.next = s.free
+
+	s.free = node
+	s.free_size++
+}
+
+// Push implements the stack.Stacker interface.
+//
+// Always returns true.
+func (s *MapStringIntStack) Push(value map[string]int) bool {
+	node := s.getNode(value)
+
+	if s.front != nil {
+		node.next = s.front
+	}
+
+	s.front = node
+	s.size++
+
+	return true
+}
+
+// PushMany implements the stack.Stacker interface.
+//
+// Always returns the number of values pushed onto the stack.
+func (s *MapStringIntStack) PushMany(values []map[string]int) int {
+	if len(values) == 0 {
+		return 0
+	}
+
+	for _, value := range values {
+		node := s.getNode(value)
+		node.next = s.front
+
+		s.front = node
+	}
+
+	s.size += len(values)
+	
+	return len(values)
+}
+
+// Pop implements the stack.Stacker interface.
+func (s *MapStringIntStack) Pop() (map[string]int, bool) {
+	if s.front == nil {
+		return nil, false
+	}
+
+	to_remove := s.front
+	s.front = s.front.next
+
+	s.size--
+
+	value := to_remove.value
+	to_remove.next = nil
+
+	s.putNode(to_remove)
+
+	return value, true
+}
+
+// Peek implements the stack.Stacker interface.
+func (s *MapStringIntStack) Peek() (map[string]int, bool) {
+	if s.front == nil {
+		return nil, false
+	}
+
+	return s.front.value, true
+}
+
+// IsEmpty implements the stack.Stacker interface.
+func (s *MapStringIntStack) IsEmpty() bool {
+	return s.front == nil
+}
+
+// Size implements the stack.Stacker interface.
+func (s *MapStringIntStack) Size() int {
+	return s.size
+}
+
+// Iterator implements the stack.Stacker interface.
+func (s *MapStringIntStack) Iterator() simple.Iterater[map[string]int] {
+	var builder simple.Builder[map[string]int]
+
+	for node := s.front; node != nil; node = node.next {
+		builder.Add(node.value)
+	}
+
+	return builder.Build()
+}
+
+// Clear implements the stack.Stacker interface.
+func (s *MapStringIntStack) Clear() {
+	if s.front == nil {
+		return
+	}
+
+	for node := s.front; node != nil; {
+		next := node.next
+
+		node.next = nil
+		s.putNode(node)
+
+		node = next
+	}
+
+	s.front = nil
+	s.size = 0
+}
+
+// layout returns the description of the stack used to format it.
+func (s *MapStringIntStack) layout() display.Container[map[string]int] {
+	var opts []string
+
+	if s.free_limit > 0 {
+		opts = append(opts, display.Option("WithNodePool", s.free_limit))
+	}
+
+	values := make([]map[string]int, s.size)
+
+	i := s.size
+	for node := s.front; node != nil; node = node.next {
+		i--
+		values[i] = node.value
+	}
+
+	return display.Container[map[string]int]{
+		Pkg:      "golden",
+		Name:     "MapStringIntStack",
+		Options:  opts,
+		Capacity: -1,
+		Marker:   display.Top,
+		Values:   values,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (s *MapStringIntStack) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, s.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (s *MapStringIntStack) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString implements the stack.Stacker interface.
+func (s *MapStringIntStack) GoString() string {
+	return s.layout().GoSyntax()
+}
+
+// Slice implements the stack.Stacker interface.
+//
+// The 0th element is the top of the stack.
+func (s *MapStringIntStack) Slice() []map[string]int {
+	slice := make([]map[string]int, 0, s.size)
+
+	for node := s.front; node != nil; node = node.next {
+		slice = append(slice, node.value)
+	}
+
+	return slice
+}
+
+// Capacity implements the stack.Stacker interface.
+//
+// Always returns -1.
+func (s *MapStringIntStack) Capacity() int {
+	return -1
+}
+
+// IsFull implements the stack.Stacker interface.
+//
+// Always returns false.
+func (s *MapStringIntStack) IsFull() bool {
+	return false
+}
+
+// Copy is a method that returns a deep copy of the stack.
+//
+// Returns:
+//   - *MapStringIntStack: A pointer to the newly created stack. Never returns nil.
+func (s *MapStringIntStack) Copy() *MapStringIntStack {
+	if s.front == nil {
+		return &MapStringIntStack{
+			free_limit: s.free_limit,
+		}
+	}
+
+	s_copy := &MapStringIntStack{
+		size: s.size,
+		free_limit: s.free_limit,
+	}
+
+	node_copy := &stack_node_map_string_int{
+		value: s.front.value,
+	}
+
+	s_copy.front = node_copy
+
+	prev := node_copy
+
+	for node := s.front.next; node != nil; node = node.next {
+		node_copy := &stack_node_map_string_int{
+			value: node.value,
+		}
+
+		prev.next = node_copy
+
+		prev = node_copy
+	}
+
+	return s_copy
+}
\ No newline at end of file
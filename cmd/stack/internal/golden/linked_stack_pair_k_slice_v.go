@@ -0,0 +1,307 @@
+// Code generated with go generate. DO NOT EDIT.
+package golden
+
+import (
+	"fmt"
+	"github.com/PlayerR9/iterators/simple"
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+)
+
+// stack_node_Pair_K_slice_V is a node in the linked stack.
+type stack_node_Pair_K_slice_V[K comparable, V any] struct {
+	value Pair[K,[]V]
+	next *stack_node_Pair_K_slice_V[K, V]
+}
+
+// PairKSliceVStack is a stack of Pair[K,[]V] values implemented without a maximum capacity
+// and using a linked list.
+type PairKSliceVStack[K comparable, V any] struct {
+	front *stack_node_Pair_K_slice_V[K, V]
+	size int
+
+	// free is the first node of the pool of free nodes.
+	free *stack_node_Pair_K_slice_V[K, V]
+
+	// free_size is the number of free nodes.
+	free_size int
+
+	// free_limit is the maximum number of free nodes. 0 disables pooling.
+	free_limit int
+}
+
+// NewPairKSliceVStack creates a new linked stack.
+//
+// Parameters:
+//   - opts: The options of the stack. Supported options are WithInitialValues, which
+//     are pushed in order so that the last one is on top, and WithNodePool.
+//
+// Returns:
+//   - *PairKSliceVStack[K, V]: A pointer to the newly created stack.
+//   - error: An error if the options are invalid.
+func NewPairKSliceVStack[K comparable, V any](opts ...options.Option) (*PairKSliceVStack[K, V], error) {
+	settings, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = settings.Only("PairKSliceVStack", "WithInitialValues", "WithNodePool")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[Pair[K,[]V]](settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &PairKSliceVStack[K, V]{
+		free_limit: settings.NodePool,
+	}
+
+	s.PushMany(values)
+
+	return s, nil
+}
+
+// getNode returns a node holding the value, reusing a free node if there is one.
+func (s *PairKSliceVStack[K, V]) getNode(value Pair[K,[]V]) *stack_node_Pair_K_slice_V[K, V] {
+	if s.free == nil {
+		return &stack_node_Pair_K_slice_V[K, V]{
+			value: value,
+		}
+	}
+
+	node := s.free
+
+	s.free = node.next
+	s.free_size--
+
+	node.value = value
+	node.next = nil
+
+	return node
+}
+
+// putNode zeroes the node and keeps it for reuse unless the pool is full.
+func (s *PairKSliceVStack[K, V]) putNode(node *stack_node_Pair_K_slice_V[K, V]) {
+	if s.free_size >= s.free_limit {
+		return
+	}
+
+	node.value = *new(Pair[K,[]V])
+	node.next = s.free
+
+	s.free = node
+	s.free_size++
+}
+
+// Push implements the stack.Stacker interface.
+//
+// Always returns true.
+func (s *PairKSliceVStack[K, V]) Push(value Pair[K,[]V]) bool {
+	node := s.getNode(value)
+
+	if s.front != nil {
+		node.next = s.front
+	}
+
+	s.front = node
+	s.size++
+
+	return true
+}
+
+// PushMany implements the stack.Stacker interface.
+//
+// Always returns the number of values pushed onto the stack.
+func (s *PairKSliceVStack[K, V]) PushMany(values []Pair[K,[]V]) int {
+	if len(values) == 0 {
+		return 0
+	}
+
+	for _, value := range values {
+		node := s.getNode(value)
+		node.next = s.front
+
+		s.front = node
+	}
+
+	s.size += len(values)
+	
+	return len(values)
+}
+
+// Pop implements the stack.Stacker interface.
+func (s *PairKSliceVStack[K, V]) Pop() (Pair[K,[]V], bool) {
+	if s.front == nil {
+		return *new(Pair[K,[]V]), false
+	}
+
+	to_remove := s.front
+	s.front = s.front.next
+
+	s.size--
+
+	value := to_remove.value
+	to_remove.next = nil
+
+	s.putNode(to_remove)
+
+	return value, true
+}
+
+// Peek implements the stack.Stacker interface.
+func (s *PairKSliceVStack[K, V]) Peek() (Pair[K,[]V], bool) {
+	if s.front == nil {
+		return *new(Pair[K,[]V]), false
+	}
+
+	return s.front.value, true
+}
+
+// IsEmpty implements the stack.Stacker interface.
+func (s *PairKSliceVStack[K, V]) IsEmpty() bool {
+	return s.front == nil
+}
+
+// Size implements the stack.Stacker interface.
+func (s *PairKSliceVStack[K, V]) Size() int {
+	return s.size
+}
+
+// Iterator implements the stack.Stacker interface.
+func (s *PairKSliceVStack[K, V]) Iterator() simple.Iterater[Pair[K,[]V]] {
+	var builder simple.Builder[Pair[K,[]V]]
+
+	for node := s.front; node != nil; node = node.next {
+		builder.Add(node.value)
+	}
+
+	return builder.Build()
+}
+
+// Clear implements the stack.Stacker interface.
+func (s *PairKSliceVStack[K, V]) Clear() {
+	if s.front == nil {
+		return
+	}
+
+	for node := s.front; node != nil; {
+		next := node.next
+
+		node.next = nil
+		s.putNode(node)
+
+		node = next
+	}
+
+	s.front = nil
+	s.size = 0
+}
+
+// layout returns the description of the stack used to format it.
+func (s *PairKSliceVStack[K, V]) layout() display.Container[Pair[K,[]V]] {
+	var opts []string
+
+	if s.free_limit > 0 {
+		opts = append(opts, display.Option("WithNodePool", s.free_limit))
+	}
+
+	values := make([]Pair[K,[]V], s.size)
+
+	i := s.size
+	for node := s.front; node != nil; node = node.next {
+		i--
+		values[i] = node.value
+	}
+
+	return display.Container[Pair[K,[]V]]{
+		Pkg:      "golden",
+		Name:     "PairKSliceVStack",
+		TypeArgs: []string{display.TypeName[K](), display.TypeName[V]()},
+		Options:  opts,
+		Capacity: -1,
+		Marker:   display.Top,
+		Values:   values,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (s *PairKSliceVStack[K, V]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, s.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (s *PairKSliceVStack[K, V]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString implements the stack.Stacker interface.
+func (s *PairKSliceVStack[K, V]) GoString() string {
+	return s.layout().GoSyntax()
+}
+
+// Slice implements the stack.Stacker interface.
+//
+// The 0th element is the top of the stack.
+func (s *PairKSliceVStack[K, V]) Slice() []Pair[K,[]V] {
+	slice := make([]Pair[K,[]V], 0, s.size)
+
+	for node := s.front; node != nil; node = node.next {
+		slice = append(slice, node.value)
+	}
+
+	return slice
+}
+
+// Capacity implements the stack.Stacker interface.
+//
+// Always returns -1.
+func (s *PairKSliceVStack[K, V]) Capacity() int {
+	return -1
+}
+
+// IsFull implements the stack.Stacker interface.
+//
+// Always returns false.
+func (s *PairKSliceVStack[K, V]) IsFull() bool {
+	return false
+}
+
+// Copy is a method that returns a deep copy of the stack.
+//
+// Returns:
+//   - *PairKSliceVStack[K, V]: A pointer to the newly created stack. Never returns nil.
+func (s *PairKSliceVStack[K, V]) Copy() *PairKSliceVStack[K, V] {
+	if s.front == nil {
+		return &PairKSliceVStack[K, V]{
+			free_limit: s.free_limit,
+		}
+	}
+
+	s_copy := &PairKSliceVStack[K, V]{
+		size: s.size,
+		free_limit: s.free_limit,
+	}
+
+	node_copy := &stack_node_Pair_K_slice_V[K, V]{
+		value: s.front.value,
+	}
+
+	s_copy.front = node_copy
+
+	prev := node_copy
+
+	for node := s.front.next; node != nil; node = node.next {
+		node_copy := &stack_node_Pair_K_slice_V[K, V]{
+			value: node.value,
+		}
+
+		prev.next = node_copy
+
+		prev = node_copy
+	}
+
+	return s_copy
+}
\ No newline at end of file
@@ -0,0 +1,307 @@
+// Code generated with go generate. DO NOT EDIT.
+package golden
+
+import (
+	"fmt"
+	"github.com/PlayerR9/iterators/simple"
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+	"go/ast"
+)
+
+// stack_node_ptr_ast_Node is a node in the linked stack.
+type stack_node_ptr_ast_Node struct {
+	value *ast.Node
+	next *stack_node_ptr_ast_Node
+}
+
+// PtrAstNodeStack is a stack of *ast.Node values implemented without a maximum capacity
+// and using a linked list.
+type PtrAstNodeStack struct {
+	front *stack_node_ptr_ast_Node
+	size int
+
+	// free is the first node of the pool of free nodes.
+	free *stack_node_ptr_ast_Node
+
+	// free_size is the number of free nodes.
+	free_size int
+
+	// free_limit is the maximum number of free nodes. 0 disables pooling.
+	free_limit int
+}
+
+// NewPtrAstNodeStack creates a new linked stack.
+//
+// Parameters:
+//   - opts: The options of the stack. Supported options are WithInitialValues, which
+//     are pushed in order so that the last one is on top, and WithNodePool.
+//
+// Returns:
+//   - *PtrAstNodeStack: A pointer to the newly created stack.
+//   - error: An error if the options are invalid.
+func NewPtrAstNodeStack(opts ...options.Option) (*PtrAstNodeStack, error) {
+	settings, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = settings.Only("PtrAstNodeStack", "WithInitialValues", "WithNodePool")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[*ast.Node](settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &PtrAstNodeStack{
+		free_limit: settings.NodePool,
+	}
+
+	s.PushMany(values)
+
+	return s, nil
+}
+
+// getNode returns a node holding the value, reusing a free node if there is one.
+func (s *PtrAstNodeStack) getNode(value *ast.Node) *stack_node_ptr_ast_Node {
+	if s.free == nil {
+		return &stack_node_ptr_ast_Node{
+			value: value,
+		}
+	}
+
+	node := s.free
+
+	s.free = node.next
+	s.free_size--
+
+	node.value = value
+	node.next = nil
+
+	return node
+}
+
+// putNode zeroes the node and keeps it for reuse unless the pool is full.
+func (s *PtrAstNodeStack) putNode(node *stack_node_ptr_ast_Node) {
+	if s.free_size >= s.free_limit {
+		return
+	}
+
+	node.value = nil
+	node.next = s.free
+
+	s.free = node
+	s.free_size++
+}
+
+// Push implements the stack.Stacker interface.
+//
+// Always returns true.
+func (s *PtrAstNodeStack) Push(value *ast.Node) bool {
+	node := s.getNode(value)
+
+	if s.front != nil {
+		node.next = s.front
+	}
+
+	s.front = node
+	s.size++
+
+	return true
+}
+
+// PushMany implements the stack.Stacker interface.
+//
+// Always returns the number of values pushed onto the stack.
+func (s *PtrAstNodeStack) PushMany(values []*ast.Node) int {
+	if len(values) == 0 {
+		return 0
+	}
+
+	for _, value := range values {
+		node := s.getNode(value)
+		node.next = s.front
+
+		s.front = node
+	}
+
+	s.size += len(values)
+	
+	return len(values)
+}
+
+// Pop implements the stack.Stacker interface.
+func (s *PtrAstNodeStack) Pop() (*ast.Node, bool) {
+	if s.front == nil {
+		return nil, false
+	}
+
+	to_remove := s.front
+	s.front = s.front.next
+
+	s.size--
+
+	value := to_remove.value
+	to_remove.next = nil
+
+	s.putNode(to_remove)
+
+	return value, true
+}
+
+// Peek implements the stack.Stacker interface.
+func (s *PtrAstNodeStack) Peek() (*ast.Node, bool) {
+	if s.front == nil {
+		return nil, false
+	}
+
+	return s.front.value, true
+}
+
+// IsEmpty implements the stack.Stacker interface.
+func (s *PtrAstNodeStack) IsEmpty() bool {
+	return s.front == nil
+}
+
+// Size implements the stack.Stacker interface.
+func (s *PtrAstNodeStack) Size() int {
+	return s.size
+}
+
+// Iterator implements the stack.Stacker interface.
+func (s *PtrAstNodeStack) Iterator() simple.Iterater[*ast.Node] {
+	var builder simple.Builder[*ast.Node]
+
+	for node := s.front; node != nil; node = node.next {
+		builder.Add(node.value)
+	}
+
+	return builder.Build()
+}
+
+// Clear implements the stack.Stacker interface.
+func (s *PtrAstNodeStack) Clear() {
+	if s.front == nil {
+		return
+	}
+
+	for node := s.front; node != nil; {
+		next := node.next
+
+		node.next = nil
+		s.putNode(node)
+
+		node = next
+	}
+
+	s.front = nil
+	s.size = 0
+}
+
+// layout returns the description of the stack used to format it.
+func (s *PtrAstNodeStack) layout() display.Container[*ast.Node] {
+	var opts []string
+
+	if s.free_limit > 0 {
+		opts = append(opts, display.Option("WithNodePool", s.free_limit))
+	}
+
+	values := make([]*ast.Node, s.size)
+
+	i := s.size
+	for node := s.front; node != nil; node = node.next {
+		i--
+		values[i] = node.value
+	}
+
+	return display.Container[*ast.Node]{
+		Pkg:      "golden",
+		Name:     "PtrAstNodeStack",
+		Options:  opts,
+		Capacity: -1,
+		Marker:   display.Top,
+		Values:   values,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (s *PtrAstNodeStack) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, s.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (s *PtrAstNodeStack) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString implements the stack.Stacker interface.
+func (s *PtrAstNodeStack) GoString() string {
+	return s.layout().GoSyntax()
+}
+
+// Slice implements the stack.Stacker interface.
+//
+// The 0th element is the top of the stack.
+func (s *PtrAstNodeStack) Slice() []*ast.Node {
+	slice := make([]*ast.Node, 0, s.size)
+
+	for node := s.front; node != nil; node = node.next {
+		slice = append(slice, node.value)
+	}
+
+	return slice
+}
+
+// Capacity implements the stack.Stacker interface.
+//
+// Always returns -1.
+func (s *PtrAstNodeStack) Capacity() int {
+	return -1
+}
+
+// IsFull implements the stack.Stacker interface.
+//
+// Always returns false.
+func (s *PtrAstNodeStack) IsFull() bool {
+	return false
+}
+
+// Copy is a method that returns a deep copy of the stack.
+//
+// Returns:
+//   - *PtrAstNodeStack: A pointer to the newly created stack. Never returns nil.
+func (s *PtrAstNodeStack) Copy() *PtrAstNodeStack {
+	if s.front == nil {
+		return &PtrAstNodeStack{
+			free_limit: s.free_limit,
+		}
+	}
+
+	s_copy := &PtrAstNodeStack{
+		size: s.size,
+		free_limit: s.free_limit,
+	}
+
+	node_copy := &stack_node_ptr_ast_Node{
+		value: s.front.value,
+	}
+
+	s_copy.front = node_copy
+
+	prev := node_copy
+
+	for node := s.front.next; node != nil; node = node.next {
+		node_copy := &stack_node_ptr_ast_Node{
+			value: node.value,
+		}
+
+		prev.next = node_copy
+
+		prev = node_copy
+	}
+
+	return s_copy
+}
\ No newline at end of file
@@ -0,0 +1,384 @@
+// Code generated with go generate. DO NOT EDIT.
+package golden
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/PlayerR9/iterators/simple"
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+)
+
+// stack_eq_node_slice_byte is a node in the linked stack.
+type stack_eq_node_slice_byte struct {
+	value []byte
+	next *stack_eq_node_slice_byte
+}
+
+// SliceByteEqStack is a stack of []byte values implemented without a maximum capacity
+// and using a linked list.
+type SliceByteEqStack struct {
+	front *stack_eq_node_slice_byte
+	size int
+
+	// free is the first node of the pool of free nodes.
+	free *stack_eq_node_slice_byte
+
+	// free_size is the number of free nodes.
+	free_size int
+
+	// free_limit is the maximum number of free nodes. 0 disables pooling.
+	free_limit int
+}
+
+// NewSliceByteEqStack creates a new linked stack.
+//
+// Parameters:
+//   - opts: The options of the stack. Supported options are WithInitialValues, which
+//     are pushed in order so that the last one is on top, and WithNodePool.
+//
+// Returns:
+//   - *SliceByteEqStack: A pointer to the newly created stack.
+//   - error: An error if the options are invalid.
+func NewSliceByteEqStack(opts ...options.Option) (*SliceByteEqStack, error) {
+	settings, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = settings.Only("SliceByteEqStack", "WithInitialValues", "WithNodePool")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[[]byte](settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &SliceByteEqStack{
+		free_limit: settings.NodePool,
+	}
+
+	s.PushMany(values)
+
+	return s, nil
+}
+
+// getNode returns a node holding the value, reusing a free node if there is one.
+func (s *SliceByteEqStack) getNode(value []byte) *stack_eq_node_slice_byte {
+	if s.free == nil {
+		return &stack_eq_node_slice_byte{
+			value: value,
+		}
+	}
+
+	node := s.free
+
+	s.free = node.next
+	s.free_size--
+
+	node.value = value
+	node.next = nil
+
+	return node
+}
+
+// putNode zeroes the node and keeps it for reuse unless the pool is full.
+func (s *SliceByteEqStack) putNode(node *stack_eq_node_slice_byte) {
+	if s.free_size >= s.free_limit {
+		return
+	}
+
+	node.value = nil
+	node.next = s.free
+
+	s.free = node
+	s.free_size++
+}
+
+// Push implements the stack.Stacker interface.
+//
+// Always returns true.
+func (s *SliceByteEqStack) Push(value []byte) bool {
+	node := s.getNode(value)
+
+	if s.front != nil {
+		node.next = s.front
+	}
+
+	s.front = node
+	s.size++
+
+	return true
+}
+
+// PushMany implements the stack.Stacker interface.
+//
+// Always returns the number of values pushed onto the stack.
+func (s *SliceByteEqStack) PushMany(values [][]byte) int {
+	if len(values) == 0 {
+		return 0
+	}
+
+	for _, value := range values {
+		node := s.getNode(value)
+		node.next = s.front
+
+		s.front = node
+	}
+
+	s.size += len(values)
+	
+	return len(values)
+}
+
+// Pop implements the stack.Stacker interface.
+func (s *SliceByteEqStack) Pop() ([]byte, bool) {
+	if s.front == nil {
+		return nil, false
+	}
+
+	to_remove := s.front
+	s.front = s.front.next
+
+	s.size--
+
+	value := to_remove.value
+	to_remove.next = nil
+
+	s.putNode(to_remove)
+
+	return value, true
+}
+
+// Peek implements the stack.Stacker interface.
+func (s *SliceByteEqStack) Peek() ([]byte, bool) {
+	if s.front == nil {
+		return nil, false
+	}
+
+	return s.front.value, true
+}
+
+// hash returns the hash of the value of the node.
+func (node *stack_eq_node_slice_byte) hash() uint64 {
+	return HashBytes(node.value)
+}
+
+// IndexOf returns the position of the first occurrence of the value, counting from
+// the top of the stack.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - int: The position of the value, 0 being the top. -1 if the value is not in
+//     the stack.
+func (s *SliceByteEqStack) IndexOf(value []byte) int {
+	var i int
+
+	for node := s.front; node != nil; node = node.next {
+		if bytes.Equal(node.value, value) {
+			return i
+		}
+
+		i++
+	}
+
+	return -1
+}
+
+// Contains checks whether the stack holds the value.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - bool: True if the value is in the stack, false otherwise.
+func (s *SliceByteEqStack) Contains(value []byte) bool {
+	return s.IndexOf(value) != -1
+}
+
+// Equal checks whether the stack holds the same values as another one, in the
+// same order.
+//
+// Parameters:
+//   - other: The other stack.
+//
+// Returns:
+//   - bool: True if both stacks hold equal values in the same order, false otherwise.
+func (s *SliceByteEqStack) Equal(other *SliceByteEqStack) bool {
+	if other == nil || s.size != other.size {
+		return false
+	}
+
+	for n1, n2 := s.front, other.front; n1 != nil; n1, n2 = n1.next, n2.next {
+		if !bytes.Equal(n1.value, n2.value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Hash returns a hash of the values of the stack. Equal stacks have the same hash.
+//
+// Returns:
+//   - uint64: The hash.
+func (s *SliceByteEqStack) Hash() uint64 {
+	// FNV-1a, over the hashes of the values.
+	h := uint64(14695981039346656037)
+
+	for node := s.front; node != nil; node = node.next {
+		h ^= node.hash()
+		h *= 1099511628211
+	}
+
+	return h
+}
+
+// IsEmpty implements the stack.Stacker interface.
+func (s *SliceByteEqStack) IsEmpty() bool {
+	return s.front == nil
+}
+
+// Size implements the stack.Stacker interface.
+func (s *SliceByteEqStack) Size() int {
+	return s.size
+}
+
+// Iterator implements the stack.Stacker interface.
+func (s *SliceByteEqStack) Iterator() simple.Iterater[[]byte] {
+	var builder simple.Builder[[]byte]
+
+	for node := s.front; node != nil; node = node.next {
+		builder.Add(node.value)
+	}
+
+	return builder.Build()
+}
+
+// Clear implements the stack.Stacker interface.
+func (s *SliceByteEqStack) Clear() {
+	if s.front == nil {
+		return
+	}
+
+	for node := s.front; node != nil; {
+		next := node.next
+
+		node.next = nil
+		s.putNode(node)
+
+		node = next
+	}
+
+	s.front = nil
+	s.size = 0
+}
+
+// layout returns the description of the stack used to format it.
+func (s *SliceByteEqStack) layout() display.Container[[]byte] {
+	var opts []string
+
+	if s.free_limit > 0 {
+		opts = append(opts, display.Option("WithNodePool", s.free_limit))
+	}
+
+	values := make([][]byte, s.size)
+
+	i := s.size
+	for node := s.front; node != nil; node = node.next {
+		i--
+		values[i] = node.value
+	}
+
+	return display.Container[[]byte]{
+		Pkg:      "golden",
+		Name:     "SliceByteEqStack",
+		Options:  opts,
+		Capacity: -1,
+		Marker:   display.Top,
+		Values:   values,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (s *SliceByteEqStack) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, s.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (s *SliceByteEqStack) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString implements the stack.Stacker interface.
+func (s *SliceByteEqStack) GoString() string {
+	return s.layout().GoSyntax()
+}
+
+// Slice implements the stack.Stacker interface.
+//
+// The 0th element is the top of the stack.
+func (s *SliceByteEqStack) Slice() [][]byte {
+	slice := make([][]byte, 0, s.size)
+
+	for node := s.front; node != nil; node = node.next {
+		slice = append(slice, node.value)
+	}
+
+	return slice
+}
+
+// Capacity implements the stack.Stacker interface.
+//
+// Always returns -1.
+func (s *SliceByteEqStack) Capacity() int {
+	return -1
+}
+
+// IsFull implements the stack.Stacker interface.
+//
+// Always returns false.
+func (s *SliceByteEqStack) IsFull() bool {
+	return false
+}
+
+// Copy is a method that returns a deep copy of the stack.
+//
+// Returns:
+//   - *SliceByteEqStack: A pointer to the newly created stack. Never returns nil.
+func (s *SliceByteEqStack) Copy() *SliceByteEqStack {
+	if s.front == nil {
+		return &SliceByteEqStack{
+			free_limit: s.free_limit,
+		}
+	}
+
+	s_copy := &SliceByteEqStack{
+		size: s.size,
+		free_limit: s.free_limit,
+	}
+
+	node_copy := &stack_eq_node_slice_byte{
+		value: s.front.value,
+	}
+
+	s_copy.front = node_copy
+
+	prev := node_copy
+
+	for node := s.front.next; node != nil; node = node.next {
+		node_copy := &stack_eq_node_slice_byte{
+			value: node.value,
+		}
+
+		prev.next = node_copy
+
+		prev = node_copy
+	}
+
+	return s_copy
+}
\ No newline at end of file
@@ -2,7 +2,7 @@
 //
 // To use it, run the following command:
 //
-// //go:generate go run stack/cmd -name=<type_name> -type=<type> [ -g=<generics> ] [ -o=<output_file> ]
+// //go:generate go run stack/cmd -name=<type_name> -type=<type>[,<type>...] [ -g=<generics> ] [ -track=<min|max> ] [ -eq=<comparable|func> [ -hash=<func> ] ] [ -combine ] [ -import=<path>[,<path>...] ] [ -o=<output_file> ]
 //
 // **Flag: Type Name**
 //
@@ -10,11 +10,20 @@
 // of "Linked<DataType>Stack" will be used instead; where <DataType> is the data type of the linked stack. Otherwise,
 // it must be a valid Go identifier and starting with an upper case letter.
 //
+// Any "<DataType>" in the name is replaced by the words of the data type, each with its first letter in upper
+// case. For instance, "-name=<DataType>Stack -type=int" names the linked stack "IntStack" while "*ast.Node",
+// "[]byte", "map[string]int" and "Pair[K,V]" give "PtrAstNodeStack", "SliceByteStack", "MapStringIntStack" and
+// "PairKVStack". When several types are given, the name
+// must either be unset or contain "<DataType>".
+//
 // **Flag: Type**
 //
 // The "type" flag is used to specify the type of the linked stack contains. Because it doesn't make
 // a lot of sense to have a linked stack without a type, this flag must be set.
 //
+// Several types can be given, separated by commas and without spaces, to generate one linked stack per type
+// in a single invocation; like so: "-type=int,string".
+//
 // For instance, running the following command:
 //
 // //go:generate go run stack/cmd -name=Stack -type=string
@@ -28,6 +37,10 @@
 // Also, it is possible to specify generics by following the value with the generics between square brackets;
 // like so: "MyType[T,C]"
 //
+// Pointer, slice, array, map and channel types, qualified types such as "time.Duration", and instantiated
+// generic types are supported and can be nested; like so: "-type=*ast.Node,map[string]Pair[K,[]V]". The commas
+// between square brackets do not separate types.
+//
 // **Flag: Generics**
 //
 // This optional flag is used to specify the type(s) of the generics. However, this only applies if at least one
@@ -50,39 +63,129 @@
 //	   // stack of MyType[T]
 //	}
 //
+// **Flag: Track**
+//
+// This optional flag is used to make the linked stack track its smallest ("min") or largest ("max") value,
+// which is then answered in O(1) time by a Min or Max method. The data type must support the "<=" and ">="
+// operators. If the type name flag is not set, the default name becomes "Linked<DataType>MinStack" or
+// "Linked<DataType>MaxStack".
+//
+// **Flag: Equality and Hash**
+//
+// This optional flag is used to give the linked stack value-level operations: Contains, IndexOf, Equal, which
+// compares it with another stack of the same type, and Hash, which is the same for equal stacks. Its value is
+// either "comparable", to compare values with the "==" operator, or the name of a "func(a, b T) bool" function;
+// like so: "-eq=bytes.Equal".
+//
+// The "hash" flag names the "func(T) uint64" function that hashes a value, consistently with the equality. It
+// can be omitted only if the equality flag is "comparable" and the data type is a predeclared type such as
+// int, float64 or string. The packages of both functions are imported as those of the data type.
+//
+// **Flag: Combine**
+//
+// This optional flag is used to write the linked stacks of all the types to the single file given by the
+// output file flag, or "linkedstacks.go" if not set. Otherwise, one file is written per type.
+//
+// **Flag: Check and Diff**
+//
+// These optional flags are used to only compare the output files with their rendering, without writing
+// anything. The command fails if any output file is missing or differs from its rendering; with -diff, it
+// also prints a unified diff from the file on disk to its rendering. For instance:
+//
+// go run cmd/stack/main.go -name=IntStack -type=int -o=stack/linked_stack_int.go -diff
+//
+// **Flag: Import**
+//
+// This optional flag is used to specify the import paths of the packages used by the data types, separated
+// by commas. Each package qualifier of a data type is imported from the path whose last element is equal to
+// it, or by its name if there is none; so "time.Duration" needs no import path but "*ast.Node" needs
+// "-import=go/ast".
+//
 // **Flag: Output File**
 //
 // This optional flag is used to specify the output file. If not specified, the output will be written to
 // standard output, that is, the file "<type_name>_stack.go" in the root of the current directory.
+//
+// Any "<type>" in the output file is replaced by the words of the data type in lower case, separated by
+// underscores; such as "ptr_ast_node" for "*ast.Node". When several types are written
+// to separate files, the output file must either be unset or contain "<type>"; like so:
+//
+// //go:generate go run stack/cmd -name=<DataType>Stack -type=int,string -o=stack/linked_stack_<type>.go
 package main
 
 import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
 	ggen "github.com/PlayerR9/go-generator/generator"
 	pkg "github.com/PlayerR9/listlike/cmd/stack/pkg"
 )
 
 func main() {
-	data_type, type_name, err := pkg.ParseFlags()
+	data_types, type_names, err := pkg.ParseFlags()
 	if err != nil {
 		pkg.Logger.Fatalf("Could not parse flags: %s", err.Error())
 	}
 
-	g := &pkg.GenData{
-		DataType:  data_type,
-		TypeName:  type_name,
-		Generics:  pkg.GenericsFlag.String(),
-		ZeroValue: ggen.ZeroValueOf(data_type, nil),
-	}
-
-	res, err := pkg.Generator.Generate(pkg.OutputLocFlag, type_name+"_linkedstack.go", g)
+	files, err := pkg.Render(data_types, type_names)
 	if err != nil {
 		pkg.Logger.Fatalf("Could not generate code: %s", err.Error())
 	}
 
-	dest, err := res.WriteFile("")
-	if err != nil {
+	for _, res := range files {
+		emit(res)
+	}
+
+	exit()
+}
+
+// stale is the number of output files found to differ from their rendering.
+var stale int
+
+// emit writes the generated code to its destination or, with -check or -diff,
+// compares it with the destination. It exits on failure.
+//
+// Parameters:
+//   - res: The generated code. Assumed to be non-nil.
+func emit(res *ggen.Generated) {
+	if !*pkg.CheckFlag && !*pkg.DiffFlag {
+		dest, err := res.WriteFile("")
+		if err != nil {
+			pkg.Logger.Fatal(err.Error())
+		}
+
+		pkg.Logger.Printf("Successfully generated: %q", dest)
+
+		return
+	}
+
+	data, err := os.ReadFile(res.DestLoc)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
 		pkg.Logger.Fatal(err.Error())
 	}
 
-	pkg.Logger.Printf("Successfully generated: %q", dest)
+	if err == nil && string(data) == string(res.Data) {
+		return
+	}
+
+	stale++
+
+	if err != nil {
+		pkg.Logger.Printf("%s: missing", res.DestLoc)
+	} else {
+		pkg.Logger.Printf("%s: out of date", res.DestLoc)
+	}
+
+	if *pkg.DiffFlag {
+		fmt.Print(pkg.Diff("a/"+res.DestLoc, "b/"+res.DestLoc, string(data), string(res.Data)))
+	}
+}
+
+// exit exits with a non-zero status if -check or -diff found stale files.
+func exit() {
+	if stale > 0 {
+		pkg.Logger.Fatalf("%d generated file(s) are stale; run go generate", stale)
+	}
 }
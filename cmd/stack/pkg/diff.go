@@ -0,0 +1,301 @@
+package pkg
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// diff_context is the number of unchanged lines shown around each change.
+	diff_context int = 3
+
+	// diff_max_cost is the number of edits past which the lines that remain
+	// different are replaced as a whole instead of searched for a shorter diff.
+	diff_max_cost int = 1000
+)
+
+// diff_edit is an operation of an edit script.
+type diff_edit struct {
+	// op is ' ' for a kept line, '-' for a deleted one and '+' for an inserted one.
+	op byte
+
+	// line is the line, including its line break if it has one.
+	line string
+}
+
+// split_lines splits a text into lines, keeping their line breaks.
+//
+// Parameters:
+//   - text: The text.
+//
+// Returns:
+//   - []string: The lines. Nil if text is empty.
+func split_lines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	lines := strings.SplitAfter(text, "\n")
+
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// myers returns an edit script that turns a into b. The lines that a and b
+// share at their start and end are kept, and the shortest edit script of the
+// rest is found with the algorithm of Eugene W. Myers.
+//
+// The search needs O(d²) memory for an edit script of d edits, so it gives up
+// past diff_max_cost edits: the rest is then replaced as a whole, which is still
+// a valid, if longer, edit script.
+//
+// Parameters:
+//   - a: The old lines.
+//   - b: The new lines.
+//
+// Returns:
+//   - []diff_edit: The edit script.
+func myers(a, b []string) []diff_edit {
+	var prefix int
+
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	var suffix int
+
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	edits := make([]diff_edit, 0, len(a)+len(b)-prefix-suffix)
+
+	for _, line := range a[:prefix] {
+		edits = append(edits, diff_edit{op: ' ', line: line})
+	}
+
+	mid_a, mid_b := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+
+	middle, ok := myers_search(mid_a, mid_b)
+	if !ok {
+		middle = middle[:0]
+
+		for _, line := range mid_a {
+			middle = append(middle, diff_edit{op: '-', line: line})
+		}
+
+		for _, line := range mid_b {
+			middle = append(middle, diff_edit{op: '+', line: line})
+		}
+	}
+
+	edits = append(edits, middle...)
+
+	for _, line := range a[len(a)-suffix:] {
+		edits = append(edits, diff_edit{op: ' ', line: line})
+	}
+
+	return edits
+}
+
+// myers_search returns the shortest edit script that turns a into b, if it has
+// at most diff_max_cost edits.
+//
+// Parameters:
+//   - a: The old lines.
+//   - b: The new lines.
+//
+// Returns:
+//   - []diff_edit: The edit script. Nil if it was not found.
+//   - bool: True if the edit script was found, false if it is too long.
+func myers_search(a, b []string) ([]diff_edit, bool) {
+	n, m := len(a), len(b)
+	offset := n + m + 1
+
+	v := make([]int, 2*offset+1)
+
+	// trace[d] holds v[offset-d : offset+d+1] as it was before the d-th step;
+	// those are the only diagonals that the backtracking reads at that step.
+	var trace [][]int
+
+search:
+	for d := 0; ; d++ {
+		if d > diff_max_cost {
+			return nil, false
+		}
+
+		trace = append(trace, slices.Clone(v[offset-d:offset+d+1]))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+
+			y := x - k
+
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	var edits []diff_edit
+
+	x, y := n, m
+
+	for d := len(trace) - 1; d > 0; d-- {
+		v := trace[d]
+		k := x - y
+
+		var prev_k int
+
+		if k == -d || (k != d && v[d+k-1] < v[d+k+1]) {
+			prev_k = k + 1
+		} else {
+			prev_k = k - 1
+		}
+
+		prev_x := v[d+prev_k]
+		prev_y := prev_x - prev_k
+
+		for x > prev_x && y > prev_y {
+			edits = append(edits, diff_edit{op: ' ', line: a[x-1]})
+			x--
+			y--
+		}
+
+		if x == prev_x {
+			edits = append(edits, diff_edit{op: '+', line: b[y-1]})
+		} else {
+			edits = append(edits, diff_edit{op: '-', line: a[x-1]})
+		}
+
+		x, y = prev_x, prev_y
+	}
+
+	// The first step starts at the origin and only follows a snake.
+	for x > 0 {
+		edits = append(edits, diff_edit{op: ' ', line: a[x-1]})
+		x--
+	}
+
+	slices.Reverse(edits)
+
+	return edits, true
+}
+
+// hunk_range formats the range of a hunk in the unified format.
+//
+// Parameters:
+//   - start: The index of the first line of the hunk.
+//   - count: The number of lines of the hunk.
+//
+// Returns:
+//   - string: The range, such as "3,7".
+func hunk_range(start, count int) string {
+	if count == 0 {
+		// An empty range refers to the line before it.
+		return strconv.Itoa(start) + ",0"
+	}
+
+	if count == 1 {
+		return strconv.Itoa(start + 1)
+	}
+
+	return strconv.Itoa(start+1) + "," + strconv.Itoa(count)
+}
+
+// Diff returns the unified diff between two texts.
+//
+// Parameters:
+//   - old_name: The name of the old text, used in the header.
+//   - new_name: The name of the new text, used in the header.
+//   - old_text: The old text.
+//   - new_text: The new text.
+//
+// Returns:
+//   - string: The unified diff. Empty if the texts are equal.
+func Diff(old_name, new_name, old_text, new_text string) string {
+	if old_text == new_text {
+		return ""
+	}
+
+	edits := myers(split_lines(old_text), split_lines(new_text))
+
+	// a_at and b_at are the indices, in the old and new lines, of each edit.
+	a_at := make([]int, len(edits)+1)
+	b_at := make([]int, len(edits)+1)
+
+	for i, e := range edits {
+		a_at[i+1] = a_at[i]
+		b_at[i+1] = b_at[i]
+
+		if e.op != '+' {
+			a_at[i+1]++
+		}
+
+		if e.op != '-' {
+			b_at[i+1]++
+		}
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString("--- " + old_name + "\n")
+	builder.WriteString("+++ " + new_name + "\n")
+
+	for i := 0; i < len(edits); {
+		if edits[i].op == ' ' {
+			i++
+			continue
+		}
+
+		start := max(i-diff_context, 0)
+
+		// Extend the hunk while the next change is close enough for the contexts
+		// to overlap.
+		end := i
+
+		for j := i; j < len(edits) && j <= end+2*diff_context; j++ {
+			if edits[j].op != ' ' {
+				end = j
+			}
+		}
+
+		end = min(end+diff_context+1, len(edits))
+
+		builder.WriteString("@@ -")
+		builder.WriteString(hunk_range(a_at[start], a_at[end]-a_at[start]))
+		builder.WriteString(" +")
+		builder.WriteString(hunk_range(b_at[start], b_at[end]-b_at[start]))
+		builder.WriteString(" @@\n")
+
+		for _, e := range edits[start:end] {
+			builder.WriteByte(e.op)
+			builder.WriteString(e.line)
+
+			if !strings.HasSuffix(e.line, "\n") {
+				builder.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+
+		i = end
+	}
+
+	return builder.String()
+}
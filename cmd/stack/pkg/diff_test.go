@@ -0,0 +1,200 @@
+package pkg
+
+import (
+	"math/rand"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// random_text returns a text of up to n lines drawn from a few distinct ones, so
+// that the texts have lines in common. The last line break may be missing.
+func random_text(rng *rand.Rand, n int) string {
+	var builder strings.Builder
+
+	lines := rng.Intn(n + 1)
+
+	for i := 0; i < lines; i++ {
+		builder.WriteString("line " + strconv.Itoa(rng.Intn(4)) + "\n")
+	}
+
+	text := builder.String()
+
+	if text != "" && rng.Intn(4) == 0 {
+		text = strings.TrimSuffix(text, "\n")
+	}
+
+	return text
+}
+
+// apply_patch applies a unified diff to a text with the patch command, refusing
+// any fuzz, and returns the patched text.
+func apply_patch(t *testing.T, old_text, diff string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	old_path := filepath.Join(dir, "old")
+	new_path := filepath.Join(dir, "new")
+
+	err := os.WriteFile(old_path, []byte(old_text), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command("patch", "--quiet", "--force", "--fuzz=0", "-o", new_path, old_path)
+	cmd.Stdin = strings.NewReader(diff)
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("patch failed: %v\n%s\ndiff:\n%s", err, out, diff)
+	}
+
+	data, err := os.ReadFile(new_path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(data)
+}
+
+// changed_lines returns the number of lines that a diff deletes or inserts.
+func changed_lines(diff string) int {
+	var count int
+
+	for _, line := range strings.Split(diff, "\n") {
+		if strings.HasPrefix(line, "---") || strings.HasPrefix(line, "+++") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "-") || strings.HasPrefix(line, "+") {
+			count++
+		}
+	}
+
+	return count
+}
+
+// lcs returns the length of the longest common subsequence of two lists of lines.
+func lcs(a, b []string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+
+	for i := range a {
+		for j := range b {
+			if a[i] == b[j] {
+				curr[j+1] = prev[j] + 1
+			} else {
+				curr[j+1] = max(prev[j+1], curr[j])
+			}
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+// TestDiffFormat pins the output of a small diff.
+func TestDiffFormat(t *testing.T) {
+	old_text := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\n"
+	new_text := "a\nb\nC\nd\ne\nf\ng\nh\ni\nj\nk"
+
+	want := "--- a/x.go\n+++ b/x.go\n" +
+		"@@ -1,6 +1,6 @@\n a\n b\n-c\n+C\n d\n e\n f\n" +
+		"@@ -8,3 +8,4 @@\n h\n i\n j\n+k\n\\ No newline at end of file\n"
+
+	if got := Diff("a/x.go", "b/x.go", old_text, new_text); got != want {
+		t.Fatalf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	if got := Diff("a/x.go", "b/x.go", old_text, old_text); got != "" {
+		t.Fatalf("got %q for equal texts, want no diff", got)
+	}
+}
+
+// TestDiffApplies checks that random diffs apply cleanly with patch and are as
+// short as possible.
+func TestDiffApplies(t *testing.T) {
+	if _, err := exec.LookPath("patch"); err != nil {
+		t.Skip("the patch command is not available")
+	}
+
+	rng := rand.New(rand.NewSource(1))
+
+	for trial := 0; trial < 200; trial++ {
+		old_text := random_text(rng, 20)
+		new_text := random_text(rng, 20)
+
+		diff := Diff("a/f", "b/f", old_text, new_text)
+		if diff == "" {
+			if old_text != new_text {
+				t.Fatalf("no diff between %q and %q", old_text, new_text)
+			}
+
+			continue
+		}
+
+		if got := apply_patch(t, old_text, diff); got != new_text {
+			t.Fatalf("patching %q gave %q, want %q\ndiff:\n%s", old_text, got, new_text, diff)
+		}
+
+		a, b := split_lines(old_text), split_lines(new_text)
+
+		if got, want := changed_lines(diff), len(a)+len(b)-2*lcs(a, b); got != want {
+			t.Fatalf("got %d changed lines, want %d\ndiff:\n%s", got, want, diff)
+		}
+	}
+}
+
+// TestDiffMaxCost checks that texts too different to search replace their
+// differing lines as a whole, and that the result still applies.
+func TestDiffMaxCost(t *testing.T) {
+	var a, b strings.Builder
+
+	a.WriteString("same\n")
+	b.WriteString("same\n")
+
+	for i := 0; i < diff_max_cost; i++ {
+		a.WriteString("old " + strconv.Itoa(i) + "\n")
+		b.WriteString("new " + strconv.Itoa(i) + "\n")
+
+		if i%100 == 0 {
+			a.WriteString("shared\n")
+			b.WriteString("shared\n")
+		}
+	}
+
+	a.WriteString("end\n")
+	b.WriteString("end\n")
+
+	old_text, new_text := a.String(), b.String()
+
+	edits := myers(split_lines(old_text), split_lines(new_text))
+
+	var kept int
+
+	for _, e := range edits {
+		if e.op == ' ' {
+			kept++
+		}
+	}
+
+	// Only the common first and last lines are kept.
+	if kept != 2 || edits[0].line != "same\n" || edits[len(edits)-1].line != "end\n" {
+		t.Fatalf("got %d kept lines, want the first and last ones", kept)
+	}
+
+	if _, err := exec.LookPath("patch"); err != nil {
+		return
+	}
+
+	diff := Diff("a/f", "b/f", old_text, new_text)
+
+	if got := apply_patch(t, old_text, diff); got != new_text {
+		t.Fatal("the whole replacement did not apply")
+	}
+}
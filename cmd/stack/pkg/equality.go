@@ -0,0 +1,202 @@
+package pkg
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"slices"
+	"strings"
+)
+
+const (
+	// EqComparable is the value of the -eq flag that compares values with the
+	// == operator.
+	EqComparable string = "comparable"
+)
+
+// hash_bodies are the bodies of the hash method of the nodes, for the data types
+// that can be hashed without a -hash function, followed by the packages they use.
+var hash_bodies map[string][]string
+
+func init() {
+	const (
+		int_body = "return uint64(node.value)"
+
+		bool_body = `if node.value {
+		return 1
+	}
+
+	return 0`
+
+		float_body = `v := float64(node.value)
+
+	// -0 and +0 are equal.
+	if v == 0 {
+		v = 0
+	}
+
+	return math.Float64bits(v)`
+
+		complex_body = `re, im := float64(real(node.value)), float64(imag(node.value))
+
+	// -0 and +0 are equal.
+	if re == 0 {
+		re = 0
+	}
+
+	if im == 0 {
+		im = 0
+	}
+
+	return math.Float64bits(re)*1099511628211 ^ math.Float64bits(im)`
+
+		string_body = `// FNV-1a.
+	h := uint64(14695981039346656037)
+
+	for i := 0; i < len(node.value); i++ {
+		h ^= uint64(node.value[i])
+		h *= 1099511628211
+	}
+
+	return h`
+	)
+
+	hash_bodies = map[string][]string{
+		"bool":       {bool_body},
+		"float32":    {float_body, "math"},
+		"float64":    {float_body, "math"},
+		"complex64":  {complex_body, "math"},
+		"complex128": {complex_body, "math"},
+		"string":     {string_body},
+	}
+
+	for _, data_type := range []string{
+		"byte", "int", "int8", "int16", "int32", "int64", "rune",
+		"uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+	} {
+		hash_bodies[data_type] = []string{int_body}
+	}
+}
+
+// func_qualifier returns the package qualifier of a function name.
+//
+// Parameters:
+//   - name: The function name, such as "bytes.Equal".
+//
+// Returns:
+//   - string: The qualifier. Empty if the name is not qualified.
+//   - error: An error if the name is not a valid function name.
+func func_qualifier(name string) (string, error) {
+	qualifier, ident, ok := strings.Cut(name, ".")
+	if !ok {
+		qualifier, ident = "", name
+	} else if !token.IsIdentifier(qualifier) {
+		return "", fmt.Errorf("invalid package qualifier of %q", name)
+	}
+
+	if !token.IsIdentifier(ident) {
+		return "", fmt.Errorf("invalid function %q", name)
+	}
+
+	if qualifier != "" && !token.IsExported(ident) {
+		return "", fmt.Errorf("function %q is not exported", name)
+	}
+
+	return qualifier, nil
+}
+
+// fix_equality fills in the equality and hashing data of a stack, according to
+// the -eq and -hash flags.
+//
+// Parameters:
+//   - gd: The generation data. Assumed to be non-nil.
+//
+// Returns:
+//   - []string: The packages used by the functions.
+//   - error: An error if the flags are invalid for the data type.
+func fix_equality(gd *GenData) ([]string, error) {
+	if EqFlag == nil || *EqFlag == "" {
+		if HashFlag != nil && *HashFlag != "" {
+			return nil, errors.New("the -hash flag requires the -eq flag")
+		}
+
+		return nil, nil
+	}
+
+	var qualifiers, deps []string
+
+	gd.Eq = true
+
+	if *EqFlag != EqComparable {
+		q, err := func_qualifier(*EqFlag)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -eq flag: %w", err)
+		}
+
+		if q != "" {
+			qualifiers = append(qualifiers, q)
+		}
+
+		gd.EqFunc = *EqFlag
+	}
+
+	switch {
+	case HashFlag != nil && *HashFlag != "":
+		q, err := func_qualifier(*HashFlag)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -hash flag: %w", err)
+		}
+
+		if q != "" && !slices.Contains(qualifiers, q) {
+			qualifiers = append(qualifiers, q)
+		}
+
+		gd.HashBody = "return " + *HashFlag + "(node.value)"
+	case gd.EqFunc == "":
+		body, ok := hash_bodies[gd.DataType]
+		if !ok {
+			return nil, fmt.Errorf("the -hash flag is required to hash values of type %q", gd.DataType)
+		}
+
+		gd.HashBody = body[0]
+		deps = append(deps, body[1:]...)
+	default:
+		return nil, errors.New("the -hash flag is required when the -eq flag names a function")
+	}
+
+	deps = append(deps, resolve_imports(qualifiers, import_paths())...)
+
+	return deps, nil
+}
+
+// Equals returns the expression that checks whether two values are equal.
+//
+// Parameters:
+//   - a: The expression of the first value.
+//   - b: The expression of the second value.
+//
+// Returns:
+//   - string: The expression, such as "a == b" or "bytes.Equal(a, b)".
+func (g *GenData) Equals(a, b string) string {
+	if g.EqFunc == "" {
+		return a + " == " + b
+	}
+
+	return g.EqFunc + "(" + a + ", " + b + ")"
+}
+
+// NotEquals returns the expression that checks whether two values differ.
+//
+// Parameters:
+//   - a: The expression of the first value.
+//   - b: The expression of the second value.
+//
+// Returns:
+//   - string: The expression, such as "a != b" or "!bytes.Equal(a, b)".
+func (g *GenData) NotEquals(a, b string) string {
+	if g.EqFunc == "" {
+		return a + " != " + b
+	}
+
+	return "!" + g.Equals(a, b)
+}
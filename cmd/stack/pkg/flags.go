@@ -3,28 +3,246 @@ package pkg
 import (
 	"errors"
 	"flag"
+	"fmt"
+	"slices"
+	"strings"
 
+	gcers "github.com/PlayerR9/go-commons/errors"
+	gcint "github.com/PlayerR9/go-commons/ints"
 	ggen "github.com/PlayerR9/go-generator/generator"
 )
 
 var (
 	OutputLocFlag *ggen.OutputLocVal
 
-	TypeListFlag *ggen.TypeListVal
+	TypeListFlag *TypeListVal
 
 	GenericsFlag *ggen.GenericsSignVal
 
 	// TypeName is the name of the linked stack.
 	TypeName *string
+
+	// TrackFlag is the extremum tracked by the linked stack, if any.
+	TrackFlag *string
+
+	// CombineFlag tells whether the linked stacks of several types are written to
+	// a single file.
+	CombineFlag *bool
+
+	// CheckFlag tells whether the output files are only compared with their
+	// rendering instead of being written.
+	CheckFlag *bool
+
+	// DiffFlag tells whether the differences found by -check are printed as a
+	// unified diff. It implies -check.
+	DiffFlag *bool
+
+	// ImportFlag is the comma-separated list of the import paths of the packages
+	// used by the data types.
+	ImportFlag *string
+
+	// EqFlag is how the values of the linked stack are compared, if they are;
+	// either "comparable" or the name of a function.
+	EqFlag *string
+
+	// HashFlag is the name of the function that hashes a value, if any.
+	HashFlag *string
+
+	// output_pattern is the value of the -o flag as given on the command line.
+	output_pattern string
 )
 
+const (
+	// NamePlaceholder is replaced, in the -name flag, by the data type with its
+	// first letter in upper case.
+	NamePlaceholder string = "<DataType>"
+
+	// FilePlaceholder is replaced, in the -o flag, by the data type in lower case.
+	FilePlaceholder string = "<type>"
+)
+
+// TypeListVal is the value of the -type flag. Unlike *ggen.TypeListVal, it does
+// not split a data type on the commas between its square brackets, so that types
+// such as "Pair[K,V]" or "map[string]Pair[K,V]" can be given.
+type TypeListVal struct {
+	// types are the data types, in order.
+	types []string
+
+	// infos are the information derived from the data types, in order.
+	infos []*type_info
+}
+
+// String implements the flag.Value interface.
+func (s TypeListVal) String() string {
+	return strings.Join(s.types, ",")
+}
+
+// Set implements the flag.Value interface.
+func (s *TypeListVal) Set(value string) error {
+	types := split_types(value)
+
+	infos := make([]*type_info, 0, len(types))
+
+	for _, data_type := range types {
+		info, err := parse_type(data_type)
+		if err != nil {
+			return err
+		}
+
+		infos = append(infos, info)
+	}
+
+	s.types = types
+	s.infos = infos
+
+	return nil
+}
+
+// Type returns the data type at the given index.
+//
+// Parameters:
+//   - idx: The index of the data type.
+//
+// Returns:
+//   - string: The data type.
+//   - error: An error if the index is out of bounds.
+func (s TypeListVal) Type(idx int) (string, error) {
+	if idx < 0 || idx >= len(s.types) {
+		return "", gcers.NewErrInvalidParameter("idx", gcint.NewErrOutOfBounds(idx, 0, len(s.types)))
+	}
+
+	return s.types[idx], nil
+}
+
+// Generics returns the type parameters used by the data types; that is, their
+// identifiers made of a single upper case letter.
+//
+// Returns:
+//   - []rune: The type parameters, in order of first appearance.
+func (s TypeListVal) Generics() []rune {
+	var generics []rune
+
+	for _, info := range s.infos {
+		for _, r := range info.generics {
+			if !slices.Contains(generics, r) {
+				generics = append(generics, r)
+			}
+		}
+	}
+
+	return generics
+}
+
+// info returns the information derived from a data type of the list.
+//
+// Parameters:
+//   - data_type: The data type.
+//
+// Returns:
+//   - *type_info: The information.
+//   - error: An error if the data type is invalid.
+func (s TypeListVal) info(data_type string) (*type_info, error) {
+	idx := slices.Index(s.types, data_type)
+	if idx != -1 {
+		return s.infos[idx], nil
+	}
+
+	return parse_type(data_type)
+}
+
+// type_info_of returns the information derived from a data type.
+//
+// Parameters:
+//   - data_type: The data type.
+//
+// Returns:
+//   - *type_info: The information.
+//   - error: An error if the data type is invalid.
+func type_info_of(data_type string) (*type_info, error) {
+	if TypeListFlag == nil {
+		return parse_type(data_type)
+	}
+
+	return TypeListFlag.info(data_type)
+}
+
+// import_paths returns the paths given with the -import flag.
+//
+// Returns:
+//   - []string: The import paths, in order.
+func import_paths() []string {
+	if ImportFlag == nil || *ImportFlag == "" {
+		return nil
+	}
+
+	var paths []string
+
+	for _, p := range strings.Split(*ImportFlag, ",") {
+		if p != "" {
+			paths = append(paths, p)
+		}
+	}
+
+	return paths
+}
+
 func init() {
+	register_flags()
+}
+
+// register_flags defines the flags of the command on flag.CommandLine.
+func register_flags() {
 	OutputLocFlag = ggen.NewOutputFlag("<type>__linkedstack.go", false)
-	TypeListFlag = ggen.NewTypeListFlag("type", true, 1, "The data type of the linked stack.")
-	GenericsFlag = ggen.NewGenericsSignFlag("g", false, 1)
+	TypeListFlag = new(TypeListVal)
+	flag.Var(TypeListFlag, "type", "The data types of the linked stacks, separated by commas. It must be set.")
+
+	GenericsFlag = ggen.NewGenericsSignFlag("g", false, -1)
+
+	TypeName = flag.String("name", "", "the name of the linked stack. Must be a valid Go identifier, in which "+
+		"'<DataType>' is replaced by the data type. It must contain '<DataType>' if several types are given. "+
+		"If not set, the default name of 'Linked<DataType>Stack' will be used instead.")
+
+	TrackFlag = flag.String("track", "", "the extremum tracked by the linked stack; either 'min' or 'max'. "+
+		"If set, the data type must be ordered and the stack gets a Min or Max method.")
+
+	CombineFlag = flag.Bool("combine", false, "whether the linked stacks of several types are written to the "+
+		"single file given by -o. If not set, one file is written per type and -o, if set, must contain '<type>'.")
 
-	TypeName = flag.String("name", "", "the name of the linked stack. Must be a valid Go identifier. If not set, "+
-		"the default name of 'Linked<DataType>Stack' will be used instead.")
+	CheckFlag = flag.Bool("check", false, "whether to only compare the output files with their rendering. If set, "+
+		"nothing is written and the command fails if any file is missing or differs.")
+
+	DiffFlag = flag.Bool("diff", false, "like -check, but also prints a unified diff of the files that differ.")
+
+	ImportFlag = flag.String("import", "", "the import paths of the packages used by the data types, separated by "+
+		"commas. A package qualifier that matches none of them is imported by its name, as for 'time'.")
+
+	EqFlag = flag.String("eq", "", "how values are compared; either 'comparable', to use the == operator, or the "+
+		"name of a 'func(a, b T) bool' function. If set, the stack gets Contains, IndexOf, Equal and Hash methods.")
+
+	HashFlag = flag.String("hash", "", "the name of a 'func(T) uint64' function that hashes a value consistently "+
+		"with -eq. It is required unless -eq is 'comparable' and the data type is a predeclared type.")
+}
+
+// fix_track returns the name of the method that answers the tracked extremum.
+//
+// Returns:
+//   - string: "Min", "Max" or the empty string if no extremum is tracked.
+//   - error: An error if the -track flag is invalid.
+func fix_track() (string, error) {
+	if TrackFlag == nil {
+		return "", nil
+	}
+
+	switch *TrackFlag {
+	case "":
+		return "", nil
+	case "min":
+		return "Min", nil
+	case "max":
+		return "Max", nil
+	default:
+		return "", fmt.Errorf("invalid -track flag %q: must be either min or max", *TrackFlag)
+	}
 }
 
 func fix_type_name(data_type string) (string, error) {
@@ -33,7 +251,26 @@ func fix_type_name(data_type string) (string, error) {
 	}
 
 	type_name := *TypeName
+
+	if type_name != "" && !strings.Contains(type_name, NamePlaceholder) {
+		err := ggen.IsValidVariableName(type_name, nil, ggen.Exported)
+		if err != nil {
+			return "", err
+		}
+
+		return type_name, nil
+	}
+
+	info, err := type_info_of(data_type)
+	if err != nil {
+		return "", err
+	}
+
+	data_type = info.ExportedName()
+
 	if type_name != "" {
+		type_name = strings.ReplaceAll(type_name, NamePlaceholder, data_type)
+
 		err := ggen.IsValidVariableName(type_name, nil, ggen.Exported)
 		if err != nil {
 			return "", err
@@ -42,33 +279,113 @@ func fix_type_name(data_type string) (string, error) {
 		return type_name, nil
 	}
 
-	data_type, err := ggen.FixVariableName(data_type, nil, ggen.Exported)
+	track, err := fix_track()
 	if err != nil {
 		return "", err
 	}
 
-	type_name = "Linked" + data_type + "Stack"
+	type_name = "Linked" + data_type + track + "Stack"
 
 	return type_name, nil
 }
 
-func ParseFlags() (string, string, error) {
+// OutputLoc returns the location of the output file of a data type.
+//
+// Parameters:
+//   - data_type: The data type.
+//
+// Returns:
+//   - string: The value of the -o flag, in which '<type>' is replaced by the data
+//     type in lower case, with its words separated by underscores; such as
+//     "ptr_ast_node" for "*ast.Node". Empty if the flag is not set.
+//   - error: An error if the data type is invalid.
+func OutputLoc(data_type string) (string, error) {
+	if !strings.Contains(output_pattern, FilePlaceholder) {
+		return output_pattern, nil
+	}
+
+	info, err := type_info_of(data_type)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.ReplaceAll(output_pattern, FilePlaceholder, info.FileName()), nil
+}
+
+// ParseFlags parses the flags.
+//
+// Returns:
+//   - []string: The data types, in order.
+//   - []string: The names of the linked stacks, one per data type.
+//   - error: An error if the flags are invalid.
+func ParseFlags() ([]string, []string, error) {
 	ggen.ParseFlags()
 
+	return check_flags()
+}
+
+// check_flags checks the parsed flags.
+//
+// Returns:
+//   - []string: The data types, in order.
+//   - []string: The names of the linked stacks, one per data type.
+//   - error: An error if the flags are invalid.
+func check_flags() ([]string, []string, error) {
+	output_pattern = OutputLocFlag.Loc()
+
 	err := ggen.AlignGenerics(GenericsFlag, TypeListFlag)
 	if err != nil {
-		return "", "", err
+		return nil, nil, err
 	}
 
-	data_type, err := TypeListFlag.Type(0)
-	if err != nil {
-		return "", "", err
+	var data_types []string
+
+	for i := 0; ; i++ {
+		data_type, err := TypeListFlag.Type(i)
+		if err != nil {
+			break
+		}
+
+		if slices.Contains(data_types, data_type) {
+			return nil, nil, fmt.Errorf("type %q is given more than once", data_type)
+		}
+
+		data_types = append(data_types, data_type)
+	}
+
+	if len(data_types) == 0 {
+		return nil, nil, errors.New("the -type flag is required")
 	}
 
-	type_name, err := fix_type_name(data_type)
+	_, err = fix_track()
 	if err != nil {
-		return "", "", err
+		return nil, nil, err
+	}
+
+	if len(data_types) > 1 {
+		if *TypeName != "" && !strings.Contains(*TypeName, NamePlaceholder) {
+			return nil, nil, fmt.Errorf("the -name flag must contain %q when several types are given", NamePlaceholder)
+		}
+
+		if !*CombineFlag && output_pattern != "" && !strings.Contains(output_pattern, FilePlaceholder) {
+			return nil, nil, fmt.Errorf("the -o flag must contain %q when several types are written to separate files", FilePlaceholder)
+		}
+	}
+
+	type_names := make([]string, 0, len(data_types))
+
+	for _, data_type := range data_types {
+		type_name, err := fix_type_name(data_type)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if slices.Contains(type_names, type_name) {
+			return nil, nil, fmt.Errorf("type %q gives the name %q of another type", data_type, type_name)
+		}
+
+		type_names = append(type_names, type_name)
 	}
 
-	return data_type, type_name, nil
+	return data_types, type_names, nil
 }
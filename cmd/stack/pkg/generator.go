@@ -20,33 +20,103 @@ func init() {
 type GenData struct {
 	PackageName  string
 	Dependencies []string
-	StringFunc   string
 
 	TypeName   string
 	TypeSig    string
+
+	// TypeArgs are the Go expressions of the type arguments of the stack, as
+	// written when it is formatted. Empty if the stack is not generic.
+	TypeArgs string
+
 	HelperSig  string
 	HelperName string
 	Generics   string
 	DataType   string
 	ZeroValue  string
+
+	// Track is the name of the method that answers the tracked extremum; either
+	// "Min", "Max" or the empty string if no extremum is tracked.
+	Track string
+
+	// TrackOp is the operator that, applied to the result of cmp.Compare and 0,
+	// tells whether a value is at least as extreme as another.
+	TrackOp string
+
+	// Eq tells whether the stack gets the Contains, IndexOf, Equal and Hash
+	// methods.
+	Eq bool
+
+	// EqFunc is the function that compares two values. Empty if they are compared
+	// with the == operator.
+	EqFunc string
+
+	// HashBody is the body of the method that hashes the value of a node.
+	HashBody string
+
+	// eq_deps are the packages used by the equality and hashing methods.
+	eq_deps []string
 }
 
 func (g *GenData) SetPackageName(name string) {
 	g.PackageName = name
 }
 
-var (
-	// Generator is the code Generator.
-	Generator *ggen.CodeGenerator[*GenData]
-)
+// helper_prefix returns the prefix of the name of the node type. Tracking stacks
+// get their own node type, as their nodes have an extra field, and so do stacks
+// with equality methods, as their nodes have a hash method.
+//
+// Parameters:
+//   - gd: The generation data. Assumed to be non-nil.
+//
+// Returns:
+//   - string: The prefix.
+func helper_prefix(gd *GenData) string {
+	prefix := "stack_"
 
-func init() {
-	tmp, err := ggen.NewCodeGeneratorFromTemplate[*GenData]("", templ)
-	if err != nil {
-		Logger.Fatalf("Could not initialize generator: %s", err.Error())
+	if gd.Track != "" {
+		prefix += strings.ToLower(gd.Track) + "_"
+	}
+
+	if gd.Eq {
+		prefix += "eq_"
 	}
 
-	tmp.AddDoFunc(func(t *GenData) error {
+	return prefix + "node_"
+}
+
+// prepare_funcs are the functions that fill in the generation data of a stack, in
+// order.
+var prepare_funcs = []ggen.DoFunc[*GenData]{
+	func(gd *GenData) error {
+		track, err := fix_track()
+		if err != nil {
+			return err
+		}
+
+		gd.Track = track
+
+		switch track {
+		case "Min":
+			gd.TrackOp = "<="
+		case "Max":
+			gd.TrackOp = ">="
+		}
+
+		return nil
+	},
+
+	func(gd *GenData) error {
+		deps, err := fix_equality(gd)
+		if err != nil {
+			return err
+		}
+
+		gd.eq_deps = deps
+
+		return nil
+	},
+
+	func(t *GenData) error {
 		sig, err := ggen.MakeTypeSign(GenericsFlag, t.TypeName, "")
 		if err != nil {
 			return err
@@ -54,13 +124,37 @@ func init() {
 
 		t.TypeSig = sig
 
+		if GenericsFlag == nil {
+			return nil
+		}
+
+		letters := strings.Trim(GenericsFlag.Signature(), "[]")
+		if letters == "" {
+			return nil
+		}
+
+		var args []string
+
+		for _, letter := range strings.Split(letters, ", ") {
+			args = append(args, "display.TypeName["+letter+"]()")
+		}
+
+		t.TypeArgs = strings.Join(args, ", ")
+
 		return nil
-	})
+	},
 
-	tmp.AddDoFunc(func(gd *GenData) error {
-		data_type := strings.TrimPrefix(gd.DataType, "*")
+	func(gd *GenData) error {
+		info, err := type_info_of(gd.DataType)
+		if err != nil {
+			return err
+		}
+
+		// The node type is named after the words of the data type, so that types
+		// such as "*ast.Node" or "[]byte" give valid identifiers.
+		gd.HelperName = helper_prefix(gd) + info.HelperName()
 
-		sig, err := ggen.MakeTypeSign(GenericsFlag, "stack_node_", data_type)
+		sig, err := ggen.MakeTypeSign(GenericsFlag, gd.HelperName, "")
 		if err != nil {
 			return err
 		}
@@ -68,32 +162,179 @@ func init() {
 		gd.HelperSig = sig
 
 		return nil
-	})
+	},
 
-	tmp.AddDoFunc(func(gd *GenData) error {
-		data_type := strings.TrimPrefix(gd.DataType, "*")
+	func(gd *GenData) error {
+		info, err := type_info_of(gd.DataType)
+		if err != nil {
+			return err
+		}
 
-		gd.HelperName = "stack_node_" + data_type
+		deps := resolve_imports(info.qualifiers, import_paths())
+		deps = append(deps, gd.eq_deps...)
+
+		if gd.Track != "" {
+			deps = append(deps, "cmp")
+		}
+		deps = append(deps, "fmt", "github.com/PlayerR9/iterators/simple", "github.com/PlayerR9/listlike/display", "github.com/PlayerR9/listlike/options")
+
+		gd.Dependencies = ggen.GetPackages(deps)
 
 		return nil
-	})
+	},
+}
 
-	tmp.AddDoFunc(func(gd *GenData) error {
-		f_call, deps := ggen.GetStringFnCall("node.value", gd.DataType, nil)
+// prepare fills in the generation data of a stack.
+//
+// Parameters:
+//   - gd: The generation data. Assumed to be non-nil.
+//
+// Returns:
+//   - error: An error if the data is invalid.
+func prepare(gd *GenData) error {
+	for _, f := range prepare_funcs {
+		err := f(gd)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
-		gd.StringFunc = f_call
+// BatchData is the generation data of a file holding several stacks.
+type BatchData struct {
+	PackageName  string
+	Dependencies []string
 
-		deps = append(deps, "strconv", "strings", "github.com/PlayerR9/iterators/simple")
+	// Stacks are the generation data of the stacks, in order.
+	Stacks []*GenData
+}
 
-		gd.Dependencies = ggen.GetPackages(deps)
+func (b *BatchData) SetPackageName(name string) {
+	b.PackageName = name
+
+	for _, gd := range b.Stacks {
+		gd.SetPackageName(name)
+	}
+}
+
+var (
+	// Generator is the code Generator.
+	Generator *ggen.CodeGenerator[*GenData]
+
+	// BatchGenerator is the code generator of a file holding several stacks.
+	BatchGenerator *ggen.CodeGenerator[*BatchData]
+)
+
+func init() {
+	tmp, err := ggen.NewCodeGeneratorFromTemplate[*GenData]("", templ)
+	if err != nil {
+		Logger.Fatalf("Could not initialize generator: %s", err.Error())
+	}
+
+	tmp.AddDoFunc(prepare)
+
+	Generator = tmp
+
+	batch, err := ggen.NewCodeGeneratorFromTemplate[*BatchData]("", batch_templ)
+	if err != nil {
+		Logger.Fatalf("Could not initialize batch generator: %s", err.Error())
+	}
+
+	batch.AddDoFunc(func(b *BatchData) error {
+		var deps []string
+
+		for _, gd := range b.Stacks {
+			err := prepare(gd)
+			if err != nil {
+				return err
+			}
+
+			deps = append(deps, gd.Dependencies...)
+		}
+
+		b.Dependencies = ggen.GetPackages(deps)
 
 		return nil
 	})
 
-	Generator = tmp
+	BatchGenerator = batch
+}
+
+// Render renders the linked stacks of the parsed flags.
+//
+// Parameters:
+//   - data_types: The data types, in order.
+//   - type_names: The names of the linked stacks, one per data type.
+//
+// Returns:
+//   - []*ggen.Generated: The generated files; a single one with the -combine
+//     flag, one per data type otherwise.
+//   - error: An error if the code could not be generated.
+func Render(data_types, type_names []string) ([]*ggen.Generated, error) {
+	stacks := make([]*GenData, 0, len(data_types))
+
+	for i, data_type := range data_types {
+		stacks = append(stacks, &GenData{
+			DataType:  data_type,
+			TypeName:  type_names[i],
+			Generics:  GenericsFlag.String(),
+			ZeroValue: ggen.ZeroValueOf(data_type, nil),
+		})
+	}
+
+	if *CombineFlag {
+		b := &BatchData{
+			Stacks: stacks,
+		}
+
+		res, err := BatchGenerator.Generate(OutputLocFlag, "linkedstacks.go", b)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*ggen.Generated{res}, nil
+	}
+
+	files := make([]*ggen.Generated, 0, len(stacks))
+
+	for _, g := range stacks {
+		// The output location is set again for each type, as Generate replaces an
+		// unset location with the default one.
+		loc, err := OutputLoc(g.DataType)
+		if err != nil {
+			return nil, err
+		}
+
+		err = OutputLocFlag.Set(loc)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := Generator.Generate(OutputLocFlag, g.TypeName+"_linkedstack.go", g)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, res)
+	}
+
+	return files, nil
 }
 
-const templ = `// Code generated with go generate. DO NOT EDIT.
+const (
+	// templ is the template of a file holding one stack.
+	templ = header_templ + `{{ template "stack" . }}` + stack_templ
+
+	// batch_templ is the template of a file holding several stacks.
+	batch_templ = header_templ + `{{ range $index, $data := .Stacks }}{{ if $index }}
+
+{{ end }}{{ template "stack" $data }}{{ end }}` + stack_templ
+)
+
+// header_templ is the template of the package clause and of the imports.
+const header_templ = `// Code generated with go generate. DO NOT EDIT.
 package {{ .PackageName }}
 
 import ({{ range $index, $dep := .Dependencies }}
@@ -101,36 +342,117 @@ import ({{ range $index, $dep := .Dependencies }}
 	{{- end }}
 )
 
-// {{ .HelperName }} is a node in the linked stack.
+`
+
+// stack_templ defines the template of a stack.
+const stack_templ = `{{ define "stack" }}// {{ .HelperName }} is a node in the linked stack.
 type {{ .HelperName }}{{ .Generics }} struct {
 	value {{ .DataType }}
+	{{- if .Track }}
+
+	// extremum is the {{ if eq .Track "Min" }}smallest{{ else }}largest{{ end }} value of this node and of the nodes below it.
+	extremum {{ .DataType }}
+	{{- end }}
 	next *{{ .HelperSig }}
 }
 
 // {{ .TypeName }} is a stack of {{ .DataType }} values implemented without a maximum capacity
 // and using a linked list.
+{{- if .Track }}
+//
+// Each node also remembers the {{ if eq .Track "Min" }}smallest{{ else }}largest{{ end }} value below it, so that {{ .Track }} runs in O(1) time.
+{{- end }}
 type {{ .TypeName }}{{ .Generics }} struct {
 	front *{{ .HelperSig }}
 	size int
+
+	// free is the first node of the pool of free nodes.
+	free *{{ .HelperSig }}
+
+	// free_size is the number of free nodes.
+	free_size int
+
+	// free_limit is the maximum number of free nodes. 0 disables pooling.
+	free_limit int
 }
 
 // New{{ .TypeName }} creates a new linked stack.
 //
+// Parameters:
+//   - opts: The options of the stack. Supported options are WithInitialValues, which
+//     are pushed in order so that the last one is on top, and WithNodePool.
+//
 // Returns:
-//   - *{{ .TypeSig }}: A pointer to the newly created stack. Never returns nil.
-func New{{ .TypeName }}{{ .Generics }}() *{{ .TypeSig }} {
-	return &{{ .TypeSig }}{
-		size: 0,
+//   - *{{ .TypeSig }}: A pointer to the newly created stack.
+//   - error: An error if the options are invalid.
+func New{{ .TypeName }}{{ .Generics }}(opts ...options.Option) (*{{ .TypeSig }}, error) {
+	settings, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = settings.Only("{{ .TypeName }}", "WithInitialValues", "WithNodePool")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[{{ .DataType }}](settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &{{ .TypeSig }}{
+		free_limit: settings.NodePool,
 	}
+
+	s.PushMany(values)
+
+	return s, nil
+}
+
+// getNode returns a node holding the value, reusing a free node if there is one.
+func (s *{{ .TypeSig }}) getNode(value {{ .DataType }}) *{{ .HelperSig }} {
+	if s.free == nil {
+		return &{{ .HelperSig }}{
+			value: value,
+		}
+	}
+
+	node := s.free
+
+	s.free = node.next
+	s.free_size--
+
+	node.value = value
+	node.next = nil
+
+	return node
+}
+
+// putNode zeroes the node and keeps it for reuse unless the pool is full.
+func (s *{{ .TypeSig }}) putNode(node *{{ .HelperSig }}) {
+	if s.free_size >= s.free_limit {
+		return
+	}
+
+	node.value = {{ .ZeroValue }}
+	{{- if .Track }}
+	node.extremum = {{ .ZeroValue }}
+	{{- end }}
+	node.next = s.free
+
+	s.free = node
+	s.free_size++
 }
 
 // Push implements the stack.Stacker interface.
 //
 // Always returns true.
 func (s *{{ .TypeSig }}) Push(value {{ .DataType }}) bool {
-	node := &{{ .HelperSig }}{
-		value: value,
-	}
+	node := s.getNode(value)
+	{{- if .Track }}
+	s.track(node)
+	{{- end }}
 
 	if s.front != nil {
 		node.next = s.front
@@ -150,21 +472,12 @@ func (s *{{ .TypeSig }}) PushMany(values []{{ .DataType }}) int {
 		return 0
 	}
 
-	node := &{{ .HelperSig }}{
-		value: values[0],
-	}
-
-	if s.front != nil {
+	for _, value := range values {
+		node := s.getNode(value)
+		{{- if .Track }}
+		s.track(node)
+		{{- end }}
 		node.next = s.front
-	}
-
-	s.front = node
-
-	for i := 1; i < len(values); i++ {
-		node := &{{ .HelperSig }}{
-			value: values[i],
-			next:  s.front,
-		}
 
 		s.front = node
 	}
@@ -184,9 +497,13 @@ func (s *{{ .TypeSig }}) Pop() ({{ .DataType }}, bool) {
 	s.front = s.front.next
 
 	s.size--
+
+	value := to_remove.value
 	to_remove.next = nil
 
-	return to_remove.value, true
+	s.putNode(to_remove)
+
+	return value, true
 }
 
 // Peek implements the stack.Stacker interface.
@@ -198,6 +515,111 @@ func (s *{{ .TypeSig }}) Peek() ({{ .DataType }}, bool) {
 	return s.front.value, true
 }
 
+{{ if .Track -}}
+// track sets the extremum of the node that is about to be pushed. The values are
+// compared with cmp.Compare, which orders NaN before any other value, as MinStack
+// does.
+func (s *{{ .TypeSig }}) track(node *{{ .HelperSig }}) {
+	if s.front == nil || cmp.Compare(node.value, s.front.extremum) {{ .TrackOp }} 0 {
+		node.extremum = node.value
+	} else {
+		node.extremum = s.front.extremum
+	}
+}
+
+// {{ .Track }} returns the {{ if eq .Track "Min" }}smallest{{ else }}largest{{ end }} value of the stack without removing it.
+//
+// Returns:
+//   - {{ .DataType }}: The {{ if eq .Track "Min" }}smallest{{ else }}largest{{ end }} value.
+//   - bool: True if the stack is not empty, false otherwise.
+func (s *{{ .TypeSig }}) {{ .Track }}() ({{ .DataType }}, bool) {
+	if s.front == nil {
+		return {{ .ZeroValue }}, false
+	}
+
+	return s.front.extremum, true
+}
+
+{{ end -}}
+{{ if .Eq -}}
+// hash returns the hash of the value of the node.
+func (node *{{ .HelperSig }}) hash() uint64 {
+	{{ .HashBody }}
+}
+
+// IndexOf returns the position of the first occurrence of the value, counting from
+// the top of the stack.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - int: The position of the value, 0 being the top. -1 if the value is not in
+//     the stack.
+func (s *{{ .TypeSig }}) IndexOf(value {{ .DataType }}) int {
+	var i int
+
+	for node := s.front; node != nil; node = node.next {
+		if {{ .Equals "node.value" "value" }} {
+			return i
+		}
+
+		i++
+	}
+
+	return -1
+}
+
+// Contains checks whether the stack holds the value.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - bool: True if the value is in the stack, false otherwise.
+func (s *{{ .TypeSig }}) Contains(value {{ .DataType }}) bool {
+	return s.IndexOf(value) != -1
+}
+
+// Equal checks whether the stack holds the same values as another one, in the
+// same order.
+//
+// Parameters:
+//   - other: The other stack.
+//
+// Returns:
+//   - bool: True if both stacks hold equal values in the same order, false otherwise.
+func (s *{{ .TypeSig }}) Equal(other *{{ .TypeSig }}) bool {
+	if other == nil || s.size != other.size {
+		return false
+	}
+
+	for n1, n2 := s.front, other.front; n1 != nil; n1, n2 = n1.next, n2.next {
+		if {{ .NotEquals "n1.value" "n2.value" }} {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Hash returns a hash of the values of the stack. Equal stacks have the same hash.
+//
+// Returns:
+//   - uint64: The hash.
+func (s *{{ .TypeSig }}) Hash() uint64 {
+	// FNV-1a, over the hashes of the values.
+	h := uint64(14695981039346656037)
+
+	for node := s.front; node != nil; node = node.next {
+		h ^= node.hash()
+		h *= 1099511628211
+	}
+
+	return h
+}
+
+{{ end -}}
 // IsEmpty implements the stack.Stacker interface.
 func (s *{{ .TypeSig }}) IsEmpty() bool {
 	return s.front == nil
@@ -225,35 +647,72 @@ func (s *{{ .TypeSig }}) Clear() {
 		return
 	}
 
-	prev := s.front
+	for node := s.front; node != nil; {
+		next := node.next
 
-	for node := s.front.next; node != nil; node = node.next {
-		prev = node
-		prev.next = nil
-	}
+		node.next = nil
+		s.putNode(node)
 
-	prev.next = nil
+		node = next
+	}
 
 	s.front = nil
 	s.size = 0
 }
 
-// GoString implements the stack.Stacker interface.
-func (s *{{ .TypeSig }}) GoString() string {
-	values := make([]string, 0, s.size)
+// layout returns the description of the stack used to format it.
+func (s *{{ .TypeSig }}) layout() display.Container[{{ .DataType }}] {
+	var opts []string
+
+	if s.free_limit > 0 {
+		opts = append(opts, display.Option("WithNodePool", s.free_limit))
+	}
+	{{- if .Track }}
+
+	var fields []display.Field
+
+	if s.front != nil {
+		fields = append(fields, display.Field{Name: "{{ if eq .Track "Min" }}min{{ else }}max{{ end }}", Value: s.front.extremum})
+	}
+	{{- end }}
+
+	values := make([]{{ .DataType }}, s.size)
+
+	i := s.size
 	for node := s.front; node != nil; node = node.next {
-		values = append(values, {{ .StringFunc }})
+		i--
+		values[i] = node.value
 	}
 
-	var builder strings.Builder
+	return display.Container[{{ .DataType }}]{
+		Pkg:      "{{ .PackageName }}",
+		Name:     "{{ .TypeName }}",
+		{{- if .TypeArgs }}
+		TypeArgs: []string{ {{- .TypeArgs -}} },
+		{{- end }}
+		Options:  opts,
+		Capacity: -1,
+		Marker:   display.Top,
+		{{- if .Track }}
+		Fields:   fields,
+		{{- end }}
+		Values:   values,
+	}
+}
 
-	builder.WriteString("{{ .TypeSig }}[size=")
-	builder.WriteString(strconv.Itoa(s.size))
-	builder.WriteString(", values=[")
-	builder.WriteString(strings.Join(values, ", "))
-	builder.WriteString(" →]]")
+// Format implements the fmt.Formatter interface.
+func (s *{{ .TypeSig }}) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, s.layout())
+}
 
-	return builder.String()
+// String implements the fmt.Stringer interface.
+func (s *{{ .TypeSig }}) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString implements the stack.Stacker interface.
+func (s *{{ .TypeSig }}) GoString() string {
+	return s.layout().GoSyntax()
 }
 
 // Slice implements the stack.Stacker interface.
@@ -289,15 +748,21 @@ func (s *{{ .TypeSig }}) IsFull() bool {
 //   - *{{ .TypeSig }}: A pointer to the newly created stack. Never returns nil.
 func (s *{{ .TypeSig }}) Copy() *{{ .TypeSig }} {
 	if s.front == nil {
-		return &{{ .TypeSig }}{}
+		return &{{ .TypeSig }}{
+			free_limit: s.free_limit,
+		}
 	}
 
 	s_copy := &{{ .TypeSig }}{
 		size: s.size,
+		free_limit: s.free_limit,
 	}
 
 	node_copy := &{{ .HelperSig }}{
 		value: s.front.value,
+		{{- if .Track }}
+		extremum: s.front.extremum,
+		{{- end }}
 	}
 
 	s_copy.front = node_copy
@@ -307,6 +772,9 @@ func (s *{{ .TypeSig }}) Copy() *{{ .TypeSig }} {
 	for node := s.front.next; node != nil; node = node.next {
 		node_copy := &{{ .HelperSig }}{
 			value: node.value,
+			{{- if .Track }}
+			extremum: node.extremum,
+			{{- end }}
 		}
 
 		prev.next = node_copy
@@ -315,4 +783,4 @@ func (s *{{ .TypeSig }}) Copy() *{{ .TypeSig }} {
 	}
 
 	return s_copy
-}`
+}{{ end }}`
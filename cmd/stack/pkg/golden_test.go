@@ -0,0 +1,103 @@
+package pkg
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// update tells whether the golden files are rewritten with the rendered code.
+var update = flag.Bool("update", false, "rewrite the golden files of cmd/stack/internal/golden")
+
+// module_root is the root of the module, relative to the directory of the package.
+const module_root = "../../.."
+
+// golden_cases are the flags of the generate directives of the golden files, as
+// given in generate.go at the root of the module.
+var golden_cases = []struct {
+	name string
+	args []string
+}{
+	{"pointer, qualified, slice and map", []string{"-name=<DataType>Stack", "-type=*ast.Node,time.Duration,[]byte,map[string]int", "-import=go/ast", "-o=cmd/stack/internal/golden/linked_stack_<type>.go"}},
+	{"generic", []string{"-name=<DataType>Stack", "-type=Pair[K,[]V],map[K]*Pair[K,V]", "-g=K/comparable,V/any", "-o=cmd/stack/internal/golden/linked_stack_<type>.go"}},
+	{"comparable", []string{"-name=<DataType>EqStack", "-type=int,float64,string", "-eq=comparable", "-o=cmd/stack/internal/golden/linked_stack_<type>_eq.go"}},
+	{"equality function", []string{"-name=<DataType>EqStack", "-type=[]byte", "-eq=bytes.Equal", "-hash=HashBytes", "-o=cmd/stack/internal/golden/linked_stack_<type>_eq.go"}},
+}
+
+// parse_args parses the flags of the command from the given arguments, on a new
+// flag.CommandLine that is restored at the end of the test.
+//
+// Parameters:
+//   - t: The test.
+//   - args: The arguments of the command.
+//
+// Returns:
+//   - []string: The data types, in order.
+//   - []string: The names of the linked stacks, one per data type.
+func parse_args(t *testing.T, args []string) ([]string, []string) {
+	t.Helper()
+
+	prev := flag.CommandLine
+
+	t.Cleanup(func() {
+		flag.CommandLine = prev
+	})
+
+	flag.CommandLine = flag.NewFlagSet("stack", flag.ContinueOnError)
+	register_flags()
+
+	err := flag.CommandLine.Parse(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data_types, type_names, err := check_flags()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data_types, type_names
+}
+
+// TestGolden checks that the files of cmd/stack/internal/golden are the rendering
+// of their generate directives. Run with -update to rewrite them.
+func TestGolden(t *testing.T) {
+	for _, tt := range golden_cases {
+		t.Run(tt.name, func(t *testing.T) {
+			data_types, type_names := parse_args(t, tt.args)
+
+			files, err := Render(data_types, type_names)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(files) != len(data_types) {
+				t.Fatalf("got %d files, want %d", len(files), len(data_types))
+			}
+
+			for _, res := range files {
+				path := filepath.Join(module_root, res.DestLoc)
+
+				if *update {
+					err := os.WriteFile(path, res.Data, 0644)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					continue
+				}
+
+				want, err := os.ReadFile(path)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !bytes.Equal(res.Data, want) {
+					t.Errorf("%s is out of date; run go test with -update\n%s", res.DestLoc, Diff("a/"+res.DestLoc, "b/"+res.DestLoc, string(want), string(res.Data)))
+				}
+			}
+		})
+	}
+}
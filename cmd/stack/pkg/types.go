@@ -0,0 +1,426 @@
+package pkg
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// type_word is a word of the name derived from a data type.
+type type_word struct {
+	// text is the text of the word.
+	text string
+
+	// keyword tells whether the word stands for a part of the syntax, such as
+	// "ptr" for "*", rather than for an identifier of the data type.
+	keyword bool
+}
+
+// type_info is the information derived from a data type.
+type type_info struct {
+	// words are the words of the name of the data type, in order. For instance,
+	// "*ast.Node" gives "ptr", "ast" and "Node".
+	words []type_word
+
+	// qualifiers are the package qualifiers used by the data type, in order of
+	// first appearance.
+	qualifiers []string
+
+	// generics are the type parameters used by the data type, in order of first
+	// appearance.
+	generics []rune
+}
+
+// ExportedName returns the exported identifier of the data type. Each word is
+// written with its first letter in upper case; for instance, "*ast.Node" gives
+// "PtrAstNode" and "map[string]int" gives "MapStringInt".
+//
+// Returns:
+//   - string: The identifier.
+func (ti *type_info) ExportedName() string {
+	var builder strings.Builder
+
+	for _, w := range ti.words {
+		r, size := utf8.DecodeRuneInString(w.text)
+
+		builder.WriteRune(unicode.ToUpper(r))
+		builder.WriteString(w.text[size:])
+	}
+
+	return builder.String()
+}
+
+// HelperName returns the suffix of the unexported identifiers of the data type.
+// The words are joined by underscores and the identifiers keep their case; for
+// instance, "*ast.Node" gives "ptr_ast_Node" while "int" stays "int".
+//
+// Returns:
+//   - string: The suffix.
+func (ti *type_info) HelperName() string {
+	texts := make([]string, 0, len(ti.words))
+
+	for _, w := range ti.words {
+		texts = append(texts, w.text)
+	}
+
+	return strings.Join(texts, "_")
+}
+
+// FileName returns the form of the data type used in file names; that is, its
+// helper name in lower case.
+//
+// Returns:
+//   - string: The form of the data type.
+func (ti *type_info) FileName() string {
+	return strings.ToLower(ti.HelperName())
+}
+
+// type_token is a token of a data type.
+type type_token struct {
+	// text is the text of the token.
+	text string
+
+	// ident tells whether the token is an identifier or a number.
+	ident bool
+}
+
+// lex_type splits a data type into tokens.
+//
+// Parameters:
+//   - data_type: The data type.
+//
+// Returns:
+//   - []type_token: The tokens.
+//   - error: An error if the data type contains an unsupported character.
+func lex_type(data_type string) ([]type_token, error) {
+	var tokens []type_token
+
+	for i := 0; i < len(data_type); {
+		r, size := utf8.DecodeRuneInString(data_type[i:])
+
+		switch {
+		case r == '*' || r == '[' || r == ']' || r == ',' || r == '.':
+			tokens = append(tokens, type_token{text: string(r)})
+			i += size
+		case r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r):
+			j := i + size
+
+			for j < len(data_type) {
+				r, size := utf8.DecodeRuneInString(data_type[j:])
+				if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+					break
+				}
+
+				j += size
+			}
+
+			tokens = append(tokens, type_token{text: data_type[i:j], ident: true})
+			i = j
+		default:
+			return nil, fmt.Errorf("unsupported character %q", r)
+		}
+	}
+
+	return tokens, nil
+}
+
+// type_parser is the parser of a data type.
+type type_parser struct {
+	// tokens are the tokens of the data type.
+	tokens []type_token
+
+	// pos is the position of the next token.
+	pos int
+
+	// info is the information derived so far.
+	info *type_info
+}
+
+// peek returns the text of the next token.
+//
+// Returns:
+//   - string: The text. Empty if there are no more tokens.
+func (p *type_parser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+
+	return p.tokens[p.pos].text
+}
+
+// expect consumes the next token if it has the given text.
+//
+// Parameters:
+//   - text: The expected text.
+//
+// Returns:
+//   - error: An error if the next token does not have the given text.
+func (p *type_parser) expect(text string) error {
+	if p.peek() != text {
+		if p.pos >= len(p.tokens) {
+			return fmt.Errorf("expected %q, got end of type", text)
+		}
+
+		return fmt.Errorf("expected %q, got %q", text, p.peek())
+	}
+
+	p.pos++
+
+	return nil
+}
+
+// ident consumes the next token if it is an identifier.
+//
+// Returns:
+//   - string: The identifier.
+//   - error: An error if the next token is not an identifier.
+func (p *type_parser) ident() (string, error) {
+	if p.pos >= len(p.tokens) {
+		return "", errors.New("expected an identifier, got end of type")
+	}
+
+	tk := p.tokens[p.pos]
+
+	r, _ := utf8.DecodeRuneInString(tk.text)
+	if !tk.ident || unicode.IsDigit(r) {
+		return "", fmt.Errorf("expected an identifier, got %q", tk.text)
+	}
+
+	p.pos++
+
+	return tk.text, nil
+}
+
+// add_word adds a word to the derived information.
+//
+// Parameters:
+//   - text: The text of the word.
+//   - keyword: Whether the word stands for a part of the syntax.
+func (p *type_parser) add_word(text string, keyword bool) {
+	p.info.words = append(p.info.words, type_word{text: text, keyword: keyword})
+}
+
+// parse parses a type.
+//
+// Returns:
+//   - error: An error if the type is invalid.
+func (p *type_parser) parse() error {
+	switch p.peek() {
+	case "":
+		return errors.New("expected a type, got end of type")
+	case "*":
+		p.pos++
+		p.add_word("ptr", true)
+
+		return p.parse()
+	case "[":
+		p.pos++
+
+		if p.peek() == "]" {
+			p.pos++
+			p.add_word("slice", true)
+
+			return p.parse()
+		}
+
+		length := p.peek()
+
+		r, _ := utf8.DecodeRuneInString(length)
+		if !unicode.IsDigit(r) {
+			return fmt.Errorf("expected the length of an array, got %q", length)
+		}
+
+		p.pos++
+
+		err := p.expect("]")
+		if err != nil {
+			return err
+		}
+
+		p.add_word("array", true)
+		p.add_word(length, true)
+
+		return p.parse()
+	case "map":
+		p.pos++
+		p.add_word("map", true)
+
+		err := p.expect("[")
+		if err != nil {
+			return err
+		}
+
+		err = p.parse()
+		if err != nil {
+			return err
+		}
+
+		err = p.expect("]")
+		if err != nil {
+			return err
+		}
+
+		return p.parse()
+	case "chan":
+		p.pos++
+		p.add_word("chan", true)
+
+		return p.parse()
+	}
+
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+
+	if p.peek() == "." {
+		p.pos++
+
+		if !slices.Contains(p.info.qualifiers, name) {
+			p.info.qualifiers = append(p.info.qualifiers, name)
+		}
+
+		p.add_word(name, false)
+
+		name, err = p.ident()
+		if err != nil {
+			return err
+		}
+	} else if r, size := utf8.DecodeRuneInString(name); size == len(name) && unicode.IsUpper(r) {
+		if !slices.Contains(p.info.generics, r) {
+			p.info.generics = append(p.info.generics, r)
+		}
+	}
+
+	p.add_word(name, false)
+
+	if p.peek() != "[" {
+		return nil
+	}
+
+	// Type arguments.
+	p.pos++
+
+	for {
+		err := p.parse()
+		if err != nil {
+			return err
+		}
+
+		if p.peek() != "," {
+			break
+		}
+
+		p.pos++
+	}
+
+	return p.expect("]")
+}
+
+// parse_type parses a data type. Pointer, slice, array, map and channel types,
+// qualified types and instantiated generic types are supported, and can be nested.
+//
+// Parameters:
+//   - data_type: The data type.
+//
+// Returns:
+//   - *type_info: The information derived from the data type.
+//   - error: An error if the data type is invalid or not supported.
+func parse_type(data_type string) (*type_info, error) {
+	tokens, err := lex_type(data_type)
+	if err != nil {
+		return nil, fmt.Errorf("invalid type %q: %w", data_type, err)
+	}
+
+	p := &type_parser{
+		tokens: tokens,
+		info:   new(type_info),
+	}
+
+	err = p.parse()
+	if err == nil && p.pos < len(tokens) {
+		err = fmt.Errorf("unexpected %q", p.peek())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid type %q: %w", data_type, err)
+	}
+
+	return p.info, nil
+}
+
+// split_types splits a list of data types on the commas that are not inside
+// square brackets.
+//
+// Parameters:
+//   - list: The list of data types.
+//
+// Returns:
+//   - []string: The non-empty data types, in order.
+func split_types(list string) []string {
+	var (
+		types []string
+		depth int
+		start int
+	)
+
+	for i := 0; i <= len(list); i++ {
+		if i < len(list) {
+			switch list[i] {
+			case '[':
+				depth++
+				continue
+			case ']':
+				depth--
+				continue
+			case ',':
+				if depth > 0 {
+					continue
+				}
+			default:
+				continue
+			}
+		}
+
+		if i > start {
+			types = append(types, list[start:i])
+		}
+
+		start = i + 1
+	}
+
+	return types
+}
+
+// resolve_imports returns the import paths of the package qualifiers used by a
+// data type. A qualifier resolves to the given path whose last element is equal
+// to it or, if there is none, to itself; as for "time" or "strings".
+//
+// Parameters:
+//   - qualifiers: The package qualifiers.
+//   - paths: The import paths given with the -import flag.
+//
+// Returns:
+//   - []string: The import paths, one per qualifier.
+func resolve_imports(qualifiers, paths []string) []string {
+	imports := make([]string, 0, len(qualifiers))
+
+	for _, q := range qualifiers {
+		resolved := q
+
+		for _, p := range paths {
+			if path.Base(p) == q {
+				resolved = p
+				break
+			}
+		}
+
+		imports = append(imports, resolved)
+	}
+
+	return imports
+}
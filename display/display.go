@@ -0,0 +1,412 @@
+// Package display implements the fmt.Formatter of the containers of this module,
+// so that they are all printed alike. It is also used by the code generated by
+// cmd/stack.
+//
+// The verbs are:
+//   - %v: The compact form, which only lists the values; such as "[1, 2, 3 →]".
+//   - %+v: The detailed form, which also gives the size, the capacity and any
+//     other field of the container; such as
+//     "ArrayStack{size=3, capacity=unbounded, values=[1, 2, 3 →]}".
+//   - %#v: The Go syntax of an expression that rebuilds the container. A function
+//     has no Go syntax; so, for a container that holds a function given by the
+//     user, such as a comparison function, it is the detailed form with all the
+//     values in Go syntax instead.
+//
+// Any other verb gives the compact form, with the values formatted with that verb.
+// The compact and detailed forms list at most DefaultLimit values, or as many as
+// the precision if one is given; such as "%.5v".
+package display
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Marker tells how the values of a container are listed.
+type Marker int
+
+const (
+	// Plain lists the values in order, without marker, as for lists.
+	Plain Marker = iota
+
+	// Top lists the values from the bottom to the top, followed by "→" which
+	// points at the top, as for stacks. The values left out are the bottom ones.
+	Top
+
+	// Front lists the values from the front to the back, preceded by "←" which
+	// points at the front, as for queues. The values left out are the back ones.
+	Front
+)
+
+const (
+	// DefaultLimit is the number of values listed by the compact and detailed forms
+	// when no precision is given.
+	DefaultLimit int = 32
+)
+
+// Field is an additional field of the detailed form of a container.
+type Field struct {
+	// Name is the name of the field.
+	Name string
+
+	// Value is the value of the field. It is formatted like the values of the
+	// container.
+	Value any
+}
+
+// Container is the description of a container.
+type Container[T any] struct {
+	// Pkg is the name of the package of the container, such as "stack".
+	Pkg string
+
+	// Name is the name of the type of the container, such as "ArrayStack".
+	Name string
+
+	// Label is the name given by the detailed form. Empty means Name. It differs
+	// from Name for the containers that wrap another one.
+	Label string
+
+	// TypeArgs are the type arguments of the type of the container, such as
+	// "int". Empty if the type is not generic.
+	TypeArgs []string
+
+	// Iface is the name of the interface returned by the constructor, such as
+	// "Stacker". Empty if the constructor returns a pointer to the type.
+	Iface string
+
+	// Ctor is the name of the constructor. Empty means "New" followed by the name
+	// of the type.
+	Ctor string
+
+	// CtorTypeArgs are the type arguments of the constructor, if they differ from
+	// TypeArgs; such as "int" for a constructor of a type whose type arguments are
+	// "int, int".
+	CtorTypeArgs []string
+
+	// NoError tells whether the constructor returns no error.
+	NoError bool
+
+	// Method is the name of the method called on the rebuilt container to get the
+	// described value, such as "Snapshot". Empty means the container itself; else,
+	// Name and Iface describe the value returned by the method.
+	Method string
+
+	// Args are the Go syntax of the arguments of the constructor that precede its
+	// options, such as "5".
+	Args []string
+
+	// Opaque tells whether the container holds a function given by the user. As
+	// a function has no Go syntax, the container cannot be rebuilt; GoSyntax then
+	// gives the detailed form, with all the values in Go syntax.
+	Opaque bool
+
+	// Options are the Go syntax of the options of the constructor, such as
+	// "options.WithCapacity(5)". The initial values are added after them.
+	Options []string
+
+	// Insert is the name of the method that adds a value to the container. If set,
+	// the values are added by calling it, with the arguments given by GoArgs,
+	// rather than through the WithInitialValues option.
+	Insert string
+
+	// GoArgs returns the Go syntax of the arguments of Insert for a value.
+	GoArgs func(value T) string
+
+	// Size is the number of values of the container. If it is larger than the
+	// number of values, the values that are not given are left out.
+	Size int
+
+	// Capacity is the maximum number of values of the container. -1 if it is
+	// unbounded.
+	Capacity int
+
+	// Marker tells how the values are listed.
+	Marker Marker
+
+	// Fields are the additional fields of the detailed form, in order.
+	Fields []Field
+
+	// Values are the values of the container, in the order in which they are
+	// listed; which is also the order in which they are given to WithInitialValues.
+	Values []T
+}
+
+// TypeName returns the Go syntax of a type, such as "int" or "time.Duration".
+//
+// Returns:
+//   - string: The Go syntax of the type.
+func TypeName[T any]() string {
+	return reflect.TypeFor[T]().String()
+}
+
+// Option returns the Go syntax of an option of the options package.
+//
+// Parameters:
+//   - name: The name of the option, such as "WithCapacity".
+//   - args: The arguments of the option, written with the %#v verb.
+//
+// Returns:
+//   - string: The Go syntax, such as "options.WithCapacity(5)".
+func Option(name string, args ...any) string {
+	values := make([]string, 0, len(args))
+
+	for _, arg := range args {
+		values = append(values, fmt.Sprintf("%#v", arg))
+	}
+
+	return "options." + name + "(" + strings.Join(values, ", ") + ")"
+}
+
+// Format implements the fmt.Formatter interface of a container.
+//
+// Parameters:
+//   - f: The state of the formatter.
+//   - verb: The verb.
+//   - c: The description of the container.
+func Format[T any](f fmt.State, verb rune, c Container[T]) {
+	if verb == 'v' && f.Flag('#') {
+		_, _ = f.Write([]byte(c.GoSyntax()))
+		return
+	}
+
+	limit, ok := f.Precision()
+	if !ok {
+		limit = DefaultLimit
+	}
+
+	elem := elem_format(f, verb)
+
+	if verb != 'v' || !f.Flag('+') {
+		_, _ = f.Write([]byte(c.list(elem, limit)))
+		return
+	}
+
+	_, _ = f.Write([]byte(c.detailed(elem, limit)))
+}
+
+// detailed returns the detailed form of the container.
+//
+// Parameters:
+//   - elem: The format of the values.
+//   - limit: The maximum number of values to list.
+//
+// Returns:
+//   - string: The detailed form, such as
+//     "ArrayStack{size=3, capacity=unbounded, values=[1, 2, 3 →]}".
+func (c Container[T]) detailed(elem string, limit int) string {
+	var builder strings.Builder
+
+	if c.Label != "" {
+		builder.WriteString(c.Label)
+	} else {
+		builder.WriteString(c.Name)
+	}
+
+	builder.WriteString("{size=")
+	builder.WriteString(strconv.Itoa(c.size()))
+	builder.WriteString(", capacity=")
+
+	if c.Capacity < 0 {
+		builder.WriteString("unbounded")
+	} else {
+		builder.WriteString(strconv.Itoa(c.Capacity))
+	}
+
+	for _, field := range c.Fields {
+		builder.WriteString(", ")
+		builder.WriteString(field.Name)
+		builder.WriteRune('=')
+		builder.WriteString(fmt.Sprintf(elem, field.Value))
+	}
+
+	builder.WriteString(", values=")
+	builder.WriteString(c.list(elem, limit))
+	builder.WriteRune('}')
+
+	return builder.String()
+}
+
+// elem_format returns the format of the values for a verb; that is, the verb with
+// the flags of the state but without its width and precision.
+//
+// Parameters:
+//   - f: The state of the formatter.
+//   - verb: The verb.
+//
+// Returns:
+//   - string: The format, such as "%+v".
+func elem_format(f fmt.State, verb rune) string {
+	var builder strings.Builder
+
+	builder.WriteRune('%')
+
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			builder.WriteRune(flag)
+		}
+	}
+
+	builder.WriteRune(verb)
+
+	return builder.String()
+}
+
+// size returns the number of values of the container.
+//
+// Returns:
+//   - int: The number of values.
+func (c Container[T]) size() int {
+	return max(c.Size, len(c.Values))
+}
+
+// list returns the listing of the values of the container.
+//
+// Parameters:
+//   - elem: The format of the values.
+//   - limit: The maximum number of values to list.
+//
+// Returns:
+//   - string: The listing, such as "[1, 2, 3 →]".
+func (c Container[T]) list(elem string, limit int) string {
+	values := c.Values
+	left_out := c.size() - len(values)
+
+	if limit < len(values) {
+		left_out += len(values) - limit
+
+		if c.Marker == Top {
+			values = values[len(values)-limit:]
+		} else {
+			values = values[:limit]
+		}
+	}
+
+	items := make([]string, 0, len(values)+1)
+
+	for _, value := range values {
+		items = append(items, fmt.Sprintf(elem, value))
+	}
+
+	if left_out > 0 {
+		more := "… " + strconv.Itoa(left_out) + " more"
+
+		if c.Marker == Top {
+			items = append([]string{more}, items...)
+		} else {
+			items = append(items, more)
+		}
+	}
+
+	joined := strings.Join(items, ", ")
+
+	switch c.Marker {
+	case Top:
+		if joined == "" {
+			return "[→]"
+		}
+
+		return "[" + joined + " →]"
+	case Front:
+		if joined == "" {
+			return "[←]"
+		}
+
+		return "[← " + joined + "]"
+	default:
+		return "[" + joined + "]"
+	}
+}
+
+// GoSyntax returns the Go syntax of an expression that rebuilds the container;
+// such as:
+//
+//	func() *stack.ArrayStack[int] { c, _ := stack.NewArrayStack[int](options.WithInitialValues[int](1, 2)); return c }()
+//
+// If the container is opaque, it returns the detailed form with all the values in
+// Go syntax instead; such as:
+//
+//	MonotonicStack{size=2, capacity=unbounded, values=[1, 2 →]}
+//
+// Returns:
+//   - string: The Go syntax, or the detailed form if the container is opaque.
+func (c Container[T]) GoSyntax() string {
+	if c.Opaque {
+		return c.detailed("%#v", c.size())
+	}
+
+	var type_args string
+
+	if len(c.TypeArgs) > 0 {
+		type_args = "[" + strings.Join(c.TypeArgs, ", ") + "]"
+	}
+
+	result := "*" + c.Pkg + "." + c.Name + type_args
+	if c.Iface != "" {
+		result = c.Pkg + "." + c.Iface + type_args
+	}
+
+	ctor := c.Ctor
+	if ctor == "" {
+		ctor = "New" + c.Name
+	}
+
+	ctor_type_args := type_args
+	if len(c.CtorTypeArgs) > 0 {
+		ctor_type_args = "[" + strings.Join(c.CtorTypeArgs, ", ") + "]"
+	}
+
+	args := append(c.Args[:len(c.Args):len(c.Args)], c.Options...)
+
+	if c.Insert == "" && len(c.Values) > 0 {
+		values := make([]string, 0, len(c.Values))
+
+		for _, value := range c.Values {
+			values = append(values, fmt.Sprintf("%#v", value))
+		}
+
+		args = append(args, "options.WithInitialValues["+TypeName[T]()+"]("+strings.Join(values, ", ")+")")
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString("func() ")
+	builder.WriteString(result)
+
+	if c.NoError {
+		builder.WriteString(" { c := ")
+	} else {
+		builder.WriteString(" { c, _ := ")
+	}
+
+	builder.WriteString(c.Pkg)
+	builder.WriteRune('.')
+	builder.WriteString(ctor)
+	builder.WriteString(ctor_type_args)
+	builder.WriteRune('(')
+	builder.WriteString(strings.Join(args, ", "))
+	builder.WriteString("); ")
+
+	if c.Insert != "" {
+		for _, value := range c.Values {
+			builder.WriteString("c.")
+			builder.WriteString(c.Insert)
+			builder.WriteRune('(')
+			builder.WriteString(c.GoArgs(value))
+			builder.WriteString("); ")
+		}
+	}
+
+	builder.WriteString("return c")
+
+	if c.Method != "" {
+		builder.WriteRune('.')
+		builder.WriteString(c.Method)
+		builder.WriteString("()")
+	}
+
+	builder.WriteString(" }()")
+
+	return builder.String()
+}
@@ -0,0 +1,59 @@
+package display
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestGoSyntax checks the Go syntax of containers.
+func TestGoSyntax(t *testing.T) {
+	tests := []struct {
+		name string
+		c    Container[int]
+		want string
+	}{
+		{
+			name: "options",
+			c: Container[int]{
+				Pkg: "stack", Name: "ArrayStack", TypeArgs: []string{"int"},
+				Options: []string{Option("WithCapacity", 5)}, Capacity: 5, Marker: Top, Values: []int{1, 2},
+			},
+			want: "func() *stack.ArrayStack[int] { c, _ := stack.NewArrayStack[int](options.WithCapacity(5), options.WithInitialValues[int](1, 2)); return c }()",
+		},
+		{
+			name: "constructor type arguments",
+			c: Container[int]{
+				Pkg: "queue", Name: "UniqueQueue", TypeArgs: []string{"int", "int"}, CtorTypeArgs: []string{"int"},
+				Capacity: -1, Marker: Front, Values: []int{1},
+			},
+			want: "func() *queue.UniqueQueue[int, int] { c, _ := queue.NewUniqueQueue[int](options.WithInitialValues[int](1)); return c }()",
+		},
+		{
+			name: "insert",
+			c: Container[int]{
+				Pkg: "list", Name: "SkipList", TypeArgs: []string{"int", "int"}, NoError: true, Insert: "Insert",
+				GoArgs: func(value int) string { return fmt.Sprintf("%#v, %#v", value, -value) }, Capacity: -1, Values: []int{1, 2},
+			},
+			want: "func() *list.SkipList[int, int] { c := list.NewSkipList[int, int](); c.Insert(1, -1); c.Insert(2, -2); return c }()",
+		},
+		{
+			name: "opaque",
+			c: Container[int]{
+				Pkg: "stack", Name: "MinStack", TypeArgs: []string{"int"}, Opaque: true,
+				Capacity: -1, Marker: Top, Fields: []Field{{Name: "min", Value: 1}}, Values: make([]int, DefaultLimit+1),
+				Size: DefaultLimit + 2,
+			},
+			want: fmt.Sprintf("MinStack{size=%d, capacity=unbounded, min=1, values=[… 1 more, %s0 →]}", DefaultLimit+2, strings.Repeat("0, ", DefaultLimit)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.c.GoSyntax()
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package fn
+
+import (
+	"github.com/PlayerR9/listlike/list"
+	"github.com/PlayerR9/listlike/options"
+	"github.com/PlayerR9/listlike/queue"
+	"github.com/PlayerR9/listlike/stack"
+)
+
+// Builder is a function that creates a new container holding the given values.
+//
+// The values are given in the order in which they should be yielded back by the
+// container; that is, the first value is the top of a stack or the front of a
+// queue or a list. Thus, passing a builder of the same kind as the source preserves
+// the logical order of the source.
+//
+// Parameters:
+//   - values: The values of the container.
+//
+// Returns:
+//   - C: The newly created container.
+type Builder[T, C any] func(values []T) C
+
+// ToArrayStack is a Builder that creates an ArrayStack whose top is the first value.
+//
+// Parameters:
+//   - values: The values of the stack.
+//
+// Returns:
+//   - *stack.ArrayStack[T]: The new stack. Never returns nil.
+func ToArrayStack[T any](values []T) *stack.ArrayStack[T] {
+	s, _ := stack.NewArrayStack[T]() // No options, so no error.
+
+	for i := len(values) - 1; i >= 0; i-- {
+		s.Push(values[i])
+	}
+
+	return s
+}
+
+// ToLinkedStack is a Builder that creates a LinkedStack whose top is the first value.
+//
+// Parameters:
+//   - values: The values of the stack.
+//
+// Returns:
+//   - *stack.LinkedStack[T]: The new stack. Never returns nil.
+func ToLinkedStack[T any](values []T) *stack.LinkedStack[T] {
+	s, _ := stack.NewLinkedStack[T]() // No options, so no error.
+
+	for i := len(values) - 1; i >= 0; i-- {
+		s.Push(values[i])
+	}
+
+	return s
+}
+
+// ToArrayQueue is a Builder that creates an ArrayQueue whose front is the first value.
+//
+// Parameters:
+//   - values: The values of the queue.
+//
+// Returns:
+//   - *queue.ArrayQueue[T]: The new queue. Never returns nil.
+func ToArrayQueue[T any](values []T) *queue.ArrayQueue[T] {
+	q, _ := queue.NewArrayQueue[T](options.WithInitialValues(values...)) // Cannot fail.
+
+	return q
+}
+
+// ToLinkedQueue is a Builder that creates a LinkedQueue whose front is the first value.
+//
+// Parameters:
+//   - values: The values of the queue.
+//
+// Returns:
+//   - *queue.LinkedQueue[T]: The new queue. Never returns nil.
+func ToLinkedQueue[T any](values []T) *queue.LinkedQueue[T] {
+	q, _ := queue.NewLinkedQueue[T](options.WithInitialValues(values...)) // Cannot fail.
+
+	return q
+}
+
+// ToSafeQueue is a Builder that creates a SafeQueue whose front is the first value.
+//
+// Parameters:
+//   - values: The values of the queue.
+//
+// Returns:
+//   - *queue.SafeQueue[T]: The new queue. Never returns nil.
+func ToSafeQueue[T any](values []T) *queue.SafeQueue[T] {
+	q, _ := queue.NewSafeQueue[T](options.WithInitialValues(values...)) // Cannot fail.
+
+	return q
+}
+
+// ToArrayList is a Builder that creates an ArrayList whose front is the first value.
+//
+// Parameters:
+//   - values: The values of the list.
+//
+// Returns:
+//   - *list.ArrayList[T]: The new list. Never returns nil.
+func ToArrayList[T any](values []T) *list.ArrayList[T] {
+	l, _ := list.NewArrayList[T](options.WithInitialValues(values...)) // Cannot fail.
+
+	return l
+}
+
+// ToLinkedList is a Builder that creates a LinkedList whose front is the first value.
+//
+// Parameters:
+//   - values: The values of the list.
+//
+// Returns:
+//   - *list.LinkedList[T]: The new list. Never returns nil.
+func ToLinkedList[T any](values []T) *list.LinkedList[T] {
+	l, _ := list.NewLinkedList[T](options.WithInitialValues(values...)) // Cannot fail.
+
+	return l
+}
+
+// ToSafeList is a Builder that creates a thread-safe list whose front is the first
+// value.
+//
+// Parameters:
+//   - values: The values of the list.
+//
+// Returns:
+//   - *list.LimitedSafeList[T]: The new list. Never returns nil.
+func ToSafeList[T any](values []T) *list.LimitedSafeList[T] {
+	l, _ := list.NewSafeList[T](options.WithInitialValues(values...)) // Cannot fail.
+
+	return l
+}
+
+// ToSlice is a Builder that returns the values as they are.
+//
+// Parameters:
+//   - values: The values.
+//
+// Returns:
+//   - []T: The values.
+func ToSlice[T any](values []T) []T {
+	return values
+}
@@ -0,0 +1,160 @@
+package fn
+
+import (
+	itrs "github.com/PlayerR9/iterators/simple"
+	"github.com/PlayerR9/listlike/list"
+)
+
+// Iterable is an interface that defines the source of the functions of this package.
+//
+// Every stack and queue of this module implements it. Stacks are iterated from the
+// top to the bottom whereas queues are iterated from the front to the back; that is,
+// in the order in which their elements would be removed.
+type Iterable[T any] interface {
+	// Iterator returns an iterator over the elements of the container.
+	//
+	// Returns:
+	//   - itrs.Iterater[T]: An iterator over the elements. Never returns nil.
+	Iterator() itrs.Iterater[T]
+}
+
+// Pair is a pair of values produced by the Zip function.
+type Pair[T, U any] struct {
+	// First is the value taken from the first source.
+	First T
+
+	// Second is the value taken from the second source.
+	Second U
+}
+
+// iterator_source is an Iterable that yields the values of an already existing
+// iterator.
+type iterator_source[T any] struct {
+	// iter is the underlying iterator.
+	iter itrs.Iterater[T]
+}
+
+// Iterator implements the Iterable interface.
+//
+// The underlying iterator is restarted and drained into a fresh iterator, so that
+// the iterators returned by several calls do not interfere with each other.
+func (s *iterator_source[T]) Iterator() itrs.Iterater[T] {
+	s.iter.Restart()
+
+	var values []T
+
+	for {
+		value, err := s.iter.Consume()
+		if err != nil {
+			break
+		}
+
+		values = append(values, value)
+	}
+
+	return itrs.NewSimpleIterator(values)
+}
+
+// FromIterator is a function that turns an iterator into an Iterable.
+//
+// Parameters:
+//   - iter: The iterator to wrap.
+//
+// Returns:
+//   - Iterable[T]: The iterable. Nil if the iterator is nil.
+func FromIterator[T any](iter itrs.Iterater[T]) Iterable[T] {
+	if iter == nil {
+		return nil
+	}
+
+	return &iterator_source[T]{
+		iter: iter,
+	}
+}
+
+// list_source is an Iterable that yields the values of a list from the front to
+// the back.
+type list_source[T any] struct {
+	// list is the underlying list.
+	list list.Lister[T]
+}
+
+// Iterator implements the Iterable interface.
+func (s *list_source[T]) Iterator() itrs.Iterater[T] {
+	return itrs.NewSimpleIterator(s.list.Slice())
+}
+
+// FromList is a function that turns a list into an Iterable. The values are
+// iterated from the front to the back of the list.
+//
+// This is needed because the lists of this module do not share a common iterator
+// type.
+//
+// Parameters:
+//   - l: The list to wrap.
+//
+// Returns:
+//   - Iterable[T]: The iterable. Nil if the list is nil.
+func FromList[T any](l list.Lister[T]) Iterable[T] {
+	if l == nil {
+		return nil
+	}
+
+	return &list_source[T]{
+		list: l,
+	}
+}
+
+// FromSlice is a function that turns a slice into an Iterable. The values are
+// iterated from the first to the last one.
+//
+// Parameters:
+//   - values: The values to iterate over.
+//
+// Returns:
+//   - Iterable[T]: The iterable. Never returns nil.
+func FromSlice[T any](values []T) Iterable[T] {
+	return &slice_source[T]{
+		values: values,
+	}
+}
+
+// slice_source is an Iterable that yields the values of a slice, in order.
+type slice_source[T any] struct {
+	// values are the values to iterate over.
+	values []T
+}
+
+// Iterator implements the Iterable interface.
+//
+// Every call returns a new iterator.
+func (s *slice_source[T]) Iterator() itrs.Iterater[T] {
+	return itrs.NewSimpleIterator(s.values)
+}
+
+// for_each calls f for every element of src, in order, until f returns false.
+//
+// Parameters:
+//   - src: The source to iterate over.
+//   - f: The function to call. It returns false to stop the iteration.
+func for_each[T any](src Iterable[T], f func(value T) bool) {
+	if src == nil {
+		return
+	}
+
+	iter := src.Iterator()
+	if iter == nil {
+		return
+	}
+
+	for {
+		value, err := iter.Consume()
+		if err != nil {
+			return
+		}
+
+		if !f(value) {
+			return
+		}
+	}
+}
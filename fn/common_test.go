@@ -0,0 +1,47 @@
+package fn
+
+import (
+	"slices"
+	"testing"
+
+	itrs "github.com/PlayerR9/iterators/simple"
+)
+
+// TestZipSameSource checks that zipping an iterable with itself pairs each value
+// with itself, which requires independent iterators.
+func TestZipSameSource(t *testing.T) {
+	values := []int{1, 2, 3}
+
+	tests := []struct {
+		name string
+		src  Iterable[int]
+	}{
+		{"FromSlice", FromSlice(values)},
+		{"FromIterator", FromIterator[int](itrs.NewSimpleIterator(values))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pairs := Zip(tt.src, tt.src, ToSlice[Pair[int, int]])
+
+			want := []Pair[int, int]{{1, 1}, {2, 2}, {3, 3}}
+			if !slices.Equal(pairs, want) {
+				t.Fatalf("got %v, want %v", pairs, want)
+			}
+		})
+	}
+}
+
+// TestFromIteratorReusable checks that an iterable built from an iterator can be
+// walked several times.
+func TestFromIteratorReusable(t *testing.T) {
+	src := FromIterator[int](itrs.NewSimpleIterator([]int{1, 2, 3}))
+
+	for i := 0; i < 2; i++ {
+		got := Map(src, func(v int) int { return v }, ToSlice[int])
+
+		if !slices.Equal(got, []int{1, 2, 3}) {
+			t.Fatalf("walk %d: got %v, want [1 2 3]", i, got)
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package fn
+
+import (
+	gcers "github.com/PlayerR9/go-commons/errors"
+	gcint "github.com/PlayerR9/go-commons/ints"
+)
+
+// Map is a function that applies f to every element of src and collects the results,
+// in the same order, into a new container.
+//
+// Parameters:
+//   - src: The source of the elements.
+//   - f: The function to apply to each element.
+//   - into: The builder of the resulting container.
+//
+// Returns:
+//   - C: The new container.
+func Map[T, U, C any](src Iterable[T], f func(value T) U, into Builder[U, C]) C {
+	var values []U
+
+	for_each(src, func(value T) bool {
+		values = append(values, f(value))
+
+		return true
+	})
+
+	return into(values)
+}
+
+// Filter is a function that collects, in the same order, the elements of src that
+// satisfy the predicate into a new container.
+//
+// Parameters:
+//   - src: The source of the elements.
+//   - pred: The predicate that the elements must satisfy.
+//   - into: The builder of the resulting container.
+//
+// Returns:
+//   - C: The new container.
+func Filter[T, C any](src Iterable[T], pred func(value T) bool, into Builder[T, C]) C {
+	var values []T
+
+	for_each(src, func(value T) bool {
+		if pred(value) {
+			values = append(values, value)
+		}
+
+		return true
+	})
+
+	return into(values)
+}
+
+// Reduce is a function that folds the elements of src, in order, into a single
+// value.
+//
+// Parameters:
+//   - src: The source of the elements.
+//   - initial: The initial value of the accumulator.
+//   - f: The function that combines the accumulator with an element.
+//
+// Returns:
+//   - A: The final value of the accumulator. initial if src is empty.
+func Reduce[T, A any](src Iterable[T], initial A, f func(acc A, value T) A) A {
+	acc := initial
+
+	for_each(src, func(value T) bool {
+		acc = f(acc, value)
+
+		return true
+	})
+
+	return acc
+}
+
+// Any is a function that checks whether at least one element of src satisfies the
+// predicate. The iteration stops at the first element that does.
+//
+// Parameters:
+//   - src: The source of the elements.
+//   - pred: The predicate to check.
+//
+// Returns:
+//   - bool: True if an element satisfies the predicate, false otherwise.
+func Any[T any](src Iterable[T], pred func(value T) bool) bool {
+	var found bool
+
+	for_each(src, func(value T) bool {
+		found = pred(value)
+
+		return !found
+	})
+
+	return found
+}
+
+// All is a function that checks whether every element of src satisfies the
+// predicate. The iteration stops at the first element that does not.
+//
+// Parameters:
+//   - src: The source of the elements.
+//   - pred: The predicate to check.
+//
+// Returns:
+//   - bool: True if all elements satisfy the predicate, false otherwise. True if
+//     src is empty.
+func All[T any](src Iterable[T], pred func(value T) bool) bool {
+	ok := true
+
+	for_each(src, func(value T) bool {
+		ok = pred(value)
+
+		return ok
+	})
+
+	return ok
+}
+
+// Find is a function that returns the first element of src that satisfies the
+// predicate.
+//
+// Parameters:
+//   - src: The source of the elements.
+//   - pred: The predicate to check.
+//
+// Returns:
+//   - T: The first element that satisfies the predicate. The zero value if none.
+//   - bool: True if such an element was found, false otherwise.
+func Find[T any](src Iterable[T], pred func(value T) bool) (T, bool) {
+	var (
+		elem  T
+		found bool
+	)
+
+	for_each(src, func(value T) bool {
+		if !pred(value) {
+			return true
+		}
+
+		elem = value
+		found = true
+
+		return false
+	})
+
+	return elem, found
+}
+
+// Partition is a function that splits the elements of src into the ones that satisfy
+// the predicate and the ones that do not. Both containers preserve the order of src.
+//
+// Parameters:
+//   - src: The source of the elements.
+//   - pred: The predicate to check.
+//   - into: The builder of the resulting containers.
+//
+// Returns:
+//   - C: The container of the elements that satisfy the predicate.
+//   - C: The container of the elements that do not satisfy the predicate.
+func Partition[T, C any](src Iterable[T], pred func(value T) bool, into Builder[T, C]) (C, C) {
+	var matched, unmatched []T
+
+	for_each(src, func(value T) bool {
+		if pred(value) {
+			matched = append(matched, value)
+		} else {
+			unmatched = append(unmatched, value)
+		}
+
+		return true
+	})
+
+	return into(matched), into(unmatched)
+}
+
+// Chunk is a function that splits the elements of src into consecutive containers
+// of at most size elements each. Only the last chunk may hold fewer elements.
+//
+// Parameters:
+//   - src: The source of the elements.
+//   - size: The maximum number of elements of each chunk.
+//   - into: The builder of the chunks.
+//
+// Returns:
+//   - []C: The chunks, in order. Nil if src is empty.
+//   - error: An error of type *errors.ErrInvalidParameter if size is not positive.
+func Chunk[T, C any](src Iterable[T], size int, into Builder[T, C]) ([]C, error) {
+	if size <= 0 {
+		return nil, gcers.NewErrInvalidParameter("size", gcint.NewErrGT(0))
+	}
+
+	var (
+		chunks  []C
+		current []T
+	)
+
+	for_each(src, func(value T) bool {
+		current = append(current, value)
+
+		if len(current) == size {
+			chunks = append(chunks, into(current))
+			current = nil
+		}
+
+		return true
+	})
+
+	if len(current) > 0 {
+		chunks = append(chunks, into(current))
+	}
+
+	return chunks, nil
+}
+
+// Zip is a function that pairs up the elements of two sources, in order. The
+// resulting container is as long as the shortest of the two sources.
+//
+// Parameters:
+//   - first: The source of the first values of the pairs.
+//   - second: The source of the second values of the pairs.
+//   - into: The builder of the resulting container.
+//
+// Returns:
+//   - C: The container of the pairs.
+func Zip[T, U, C any](first Iterable[T], second Iterable[U], into Builder[Pair[T, U], C]) C {
+	if first == nil || second == nil {
+		return into(nil)
+	}
+
+	iter1 := first.Iterator()
+	iter2 := second.Iterator()
+
+	if iter1 == nil || iter2 == nil {
+		return into(nil)
+	}
+
+	var pairs []Pair[T, U]
+
+	for {
+		v1, err := iter1.Consume()
+		if err != nil {
+			break
+		}
+
+		v2, err := iter2.Consume()
+		if err != nil {
+			break
+		}
+
+		pairs = append(pairs, Pair[T, U]{
+			First:  v1,
+			Second: v2,
+		})
+	}
+
+	return into(pairs)
+}
+
+// GroupBy is a function that groups the elements of src by the key returned by the
+// key function. Each group preserves the order of src.
+//
+// Parameters:
+//   - src: The source of the elements.
+//   - key: The function that returns the key of an element.
+//   - into: The builder of the groups.
+//
+// Returns:
+//   - map[K]C: The groups, indexed by their key. Never returns nil.
+func GroupBy[T any, K comparable, C any](src Iterable[T], key func(value T) K, into Builder[T, C]) map[K]C {
+	groups := make(map[K][]T)
+
+	for_each(src, func(value T) bool {
+		k := key(value)
+		groups[k] = append(groups[k], value)
+
+		return true
+	})
+
+	result := make(map[K]C, len(groups))
+
+	for k, values := range groups {
+		result[k] = into(values)
+	}
+
+	return result
+}
@@ -0,0 +1,183 @@
+package fn
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/PlayerR9/listlike/options"
+	"github.com/PlayerR9/listlike/stack"
+)
+
+// is_even checks whether the value is even.
+func is_even(value int) bool {
+	return value%2 == 0
+}
+
+// TestMapFilterReduce checks that the results keep the order of the source, and
+// that an empty source gives an empty result.
+func TestMapFilterReduce(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		mapped   []string
+		filtered []int
+		sum      int
+	}{
+		{"empty", nil, nil, nil, 10},
+		{"one", []int{3}, []string{"3"}, nil, 13},
+		{"many", []int{4, 1, 2, 4}, []string{"4", "1", "2", "4"}, []int{4, 2, 4}, 21},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := FromSlice(tt.values)
+
+			mapped := Map(src, strconv.Itoa, ToSlice[string])
+			if len(mapped) != len(tt.mapped) || !slices.Equal(mapped, tt.mapped) {
+				t.Errorf("Map: got %q, want %q", mapped, tt.mapped)
+			}
+
+			filtered := Filter(src, is_even, ToSlice[int])
+			if !slices.Equal(filtered, tt.filtered) {
+				t.Errorf("Filter: got %v, want %v", filtered, tt.filtered)
+			}
+
+			sum := Reduce(src, 10, func(acc, value int) int { return acc + value })
+			if sum != tt.sum {
+				t.Errorf("Reduce: got %d, want %d", sum, tt.sum)
+			}
+		})
+	}
+}
+
+// TestReduceOrder checks that Reduce folds the elements from the first to the
+// last, and that a stack is walked from the top to the bottom.
+func TestReduceOrder(t *testing.T) {
+	concat := func(acc string, value int) string { return acc + strconv.Itoa(value) }
+
+	if got := Reduce(FromSlice([]int{1, 2, 3}), "", concat); got != "123" {
+		t.Fatalf("got %q, want \"123\"", got)
+	}
+
+	s, err := stack.NewArrayStack[int](options.WithInitialValues[int](1, 2, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := Reduce[int](s, "", concat); got != "321" {
+		t.Fatalf("got %q from a stack, want \"321\"", got)
+	}
+
+	// The builder puts the first result on top, which keeps the order of s.
+	mapped := Map[int](s, func(v int) int { return v * 10 }, ToArrayStack[int])
+	if got := mapped.Slice(); !slices.Equal(got, []int{10, 20, 30}) {
+		t.Fatalf("got %v, want [10 20 30]", got)
+	}
+}
+
+// TestPartition checks that each element goes to exactly one side, in order.
+func TestPartition(t *testing.T) {
+	even, odd := Partition(FromSlice([]int{1, 2, 3, 4, 6, 5}), is_even, ToSlice[int])
+
+	if !slices.Equal(even, []int{2, 4, 6}) || !slices.Equal(odd, []int{1, 3, 5}) {
+		t.Fatalf("got %v and %v, want [2 4 6] and [1 3 5]", even, odd)
+	}
+
+	even, odd = Partition(FromSlice[int](nil), is_even, ToSlice[int])
+	if len(even) != 0 || len(odd) != 0 {
+		t.Fatalf("got %v and %v from an empty source", even, odd)
+	}
+}
+
+// TestChunk checks the sizes of the chunks, including the last one, and the
+// invalid sizes.
+func TestChunk(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5}
+
+	tests := []struct {
+		size int
+		want [][]int
+	}{
+		{1, [][]int{{1}, {2}, {3}, {4}, {5}}},
+		{2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{5, [][]int{{1, 2, 3, 4, 5}}},
+		{7, [][]int{{1, 2, 3, 4, 5}}},
+	}
+
+	for _, tt := range tests {
+		got, err := Chunk(FromSlice(values), tt.size, ToSlice[int])
+		if err != nil {
+			t.Fatalf("size %d: %v", tt.size, err)
+		}
+
+		if !slices.EqualFunc(got, tt.want, slices.Equal[[]int]) {
+			t.Fatalf("size %d: got %v, want %v", tt.size, got, tt.want)
+		}
+	}
+
+	got, err := Chunk(FromSlice[int](nil), 2, ToSlice[int])
+	if err != nil || got != nil {
+		t.Fatalf("got %v, %v from an empty source, want nil", got, err)
+	}
+
+	for _, size := range []int{0, -3} {
+		if _, err := Chunk(FromSlice(values), size, ToSlice[int]); err == nil {
+			t.Fatalf("size %d: got no error", size)
+		}
+	}
+}
+
+// TestZip checks that the pairs stop at the shortest source.
+func TestZip(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  []int
+		second []string
+		want   []Pair[int, string]
+	}{
+		{"empty", nil, []string{"a"}, nil},
+		{"same length", []int{1, 2}, []string{"a", "b"}, []Pair[int, string]{{1, "a"}, {2, "b"}}},
+		{"shorter first", []int{1}, []string{"a", "b"}, []Pair[int, string]{{1, "a"}}},
+		{"shorter second", []int{1, 2, 3}, []string{"a"}, []Pair[int, string]{{1, "a"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Zip(FromSlice(tt.first), FromSlice(tt.second), ToSlice[Pair[int, string]])
+
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestGroupBy checks that every group keeps the order of the source, and that an
+// empty source gives an empty map.
+func TestGroupBy(t *testing.T) {
+	words := []string{"ab", "c", "de", "f", "ghi", "jk"}
+
+	groups := GroupBy(FromSlice(words), func(w string) int { return len(w) }, ToSlice[string])
+
+	want := map[int][]string{
+		1: {"c", "f"},
+		2: {"ab", "de", "jk"},
+		3: {"ghi"},
+	}
+
+	if len(groups) != len(want) {
+		t.Fatalf("got %v, want %v", groups, want)
+	}
+
+	for k, group := range want {
+		if !slices.Equal(groups[k], group) {
+			t.Fatalf("group %d: got %v, want %v", k, groups[k], group)
+		}
+	}
+
+	empty := GroupBy(FromSlice[string](nil), func(w string) int { return len(w) }, ToSlice[string])
+	if empty == nil || len(empty) != 0 {
+		t.Fatalf("got %v from an empty source, want an empty map", empty)
+	}
+}
@@ -0,0 +1,134 @@
+package fn
+
+import (
+	"cmp"
+
+	"github.com/PlayerR9/listlike/queue"
+
+	gcers "github.com/PlayerR9/go-commons/errors"
+	gcint "github.com/PlayerR9/go-commons/ints"
+)
+
+// SlidingWindowMax is a function that returns the largest element of every window
+// of size consecutive elements of src, in order. It runs in O(n) time using a
+// queue.MonotonicDeque.
+//
+// Parameters:
+//   - src: The source of the elements.
+//   - size: The number of elements of each window.
+//   - into: The builder of the resulting container.
+//
+// Returns:
+//   - C: The maxima; one per window, that is, n - size + 1 of them for n
+//     elements. Empty if src has fewer than size elements.
+//   - error: An error of type *errors.ErrInvalidParameter if size is not positive.
+func SlidingWindowMax[T cmp.Ordered, C any](src Iterable[T], size int, into Builder[T, C]) (C, error) {
+	return sliding_window(src, size, cmp.Compare[T], into)
+}
+
+// SlidingWindowMin is a function that returns the smallest element of every window
+// of size consecutive elements of src, in order. See SlidingWindowMax.
+//
+// Parameters:
+//   - src: The source of the elements.
+//   - size: The number of elements of each window.
+//   - into: The builder of the resulting container.
+//
+// Returns:
+//   - C: The minima; one per window.
+//   - error: An error of type *errors.ErrInvalidParameter if size is not positive.
+func SlidingWindowMin[T cmp.Ordered, C any](src Iterable[T], size int, into Builder[T, C]) (C, error) {
+	return sliding_window(src, size, func(a, b T) int { return cmp.Compare(b, a) }, into)
+}
+
+// SlidingWindowMaxFunc is like SlidingWindowMax, but the elements are compared
+// with the given function.
+//
+// Parameters:
+//   - src: The source of the elements.
+//   - size: The number of elements of each window.
+//   - cmp: The comparison function. It returns a negative number when a < b, 0
+//     when a == b and a positive number when a > b.
+//   - into: The builder of the resulting container.
+//
+// Returns:
+//   - C: The maxima; one per window.
+//   - error: An error of type *errors.ErrInvalidParameter if size is not positive
+//     or cmp is nil.
+func SlidingWindowMaxFunc[T, C any](src Iterable[T], size int, cmp func(a, b T) int, into Builder[T, C]) (C, error) {
+	if cmp == nil {
+		return *new(C), gcers.NewErrNilParameter("cmp")
+	}
+
+	return sliding_window(src, size, cmp, into)
+}
+
+// SlidingWindowMinFunc is like SlidingWindowMin, but the elements are compared
+// with the given function.
+//
+// Parameters:
+//   - src: The source of the elements.
+//   - size: The number of elements of each window.
+//   - cmp: The comparison function. It returns a negative number when a < b, 0
+//     when a == b and a positive number when a > b.
+//   - into: The builder of the resulting container.
+//
+// Returns:
+//   - C: The minima; one per window.
+//   - error: An error of type *errors.ErrInvalidParameter if size is not positive
+//     or cmp is nil.
+func SlidingWindowMinFunc[T, C any](src Iterable[T], size int, cmp func(a, b T) int, into Builder[T, C]) (C, error) {
+	if cmp == nil {
+		return *new(C), gcers.NewErrNilParameter("cmp")
+	}
+
+	return sliding_window(src, size, func(a, b T) int { return cmp(b, a) }, into)
+}
+
+// sliding_window returns the largest element of every window according to cmp.
+//
+// Parameters:
+//   - src: The source of the elements.
+//   - size: The number of elements of each window.
+//   - cmp: The comparison function. Assumed to be non-nil.
+//   - into: The builder of the resulting container.
+//
+// Returns:
+//   - C: The maxima.
+//   - error: An error of type *errors.ErrInvalidParameter if size is not positive.
+func sliding_window[T, C any](src Iterable[T], size int, cmp func(a, b T) int, into Builder[T, C]) (C, error) {
+	if size <= 0 {
+		return *new(C), gcers.NewErrInvalidParameter("size", gcint.NewErrGT(0))
+	}
+
+	deque, _ := queue.NewMonotonicDeque(cmp) // Cannot fail.
+
+	var (
+		maxima []T
+		count  int
+	)
+
+	// window holds the last size elements, so that the one leaving the window is
+	// known.
+	window := make([]T, size)
+
+	for_each(src, func(value T) bool {
+		if count >= size {
+			deque.Evict(window[count%size])
+		}
+
+		window[count%size] = value
+		count++
+
+		deque.PushBack(value)
+
+		if count >= size {
+			front, _ := deque.PeekFront()
+			maxima = append(maxima, front)
+		}
+
+		return true
+	})
+
+	return into(maxima), nil
+}
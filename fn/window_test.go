@@ -0,0 +1,93 @@
+package fn
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// brute_window returns the extremum of every window of values, found by scanning
+// the window.
+func brute_window(values []int, size int, extremum func(...int) int) []int {
+	var result []int
+
+	for i := 0; i+size <= len(values); i++ {
+		result = append(result, extremum(values[i:i+size]...))
+	}
+
+	return result
+}
+
+// TestSlidingWindow checks the sliding window extrema against a brute-force scan,
+// with many duplicates.
+func TestSlidingWindow(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	maxOf := func(values ...int) int { return slices.Max(values) }
+	minOf := func(values ...int) int { return slices.Min(values) }
+
+	for trial := 0; trial < 200; trial++ {
+		values := make([]int, rng.Intn(40))
+		for i := range values {
+			values[i] = rng.Intn(5)
+		}
+
+		size := 1 + rng.Intn(8)
+
+		maxima, err := SlidingWindowMax(FromSlice(values), size, ToSlice[int])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if want := brute_window(values, size, maxOf); !slices.Equal(maxima, want) {
+			t.Fatalf("max of %v by %d: got %v, want %v", values, size, maxima, want)
+		}
+
+		minima, err := SlidingWindowMin(FromSlice(values), size, ToSlice[int])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if want := brute_window(values, size, minOf); !slices.Equal(minima, want) {
+			t.Fatalf("min of %v by %d: got %v, want %v", values, size, minima, want)
+		}
+
+		reversed := func(a, b int) int { return cmp.Compare(b, a) }
+
+		maxima, err = SlidingWindowMaxFunc(FromSlice(values), size, reversed, ToSlice[int])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if want := brute_window(values, size, minOf); !slices.Equal(maxima, want) {
+			t.Fatalf("max by a reversed order of %v by %d: got %v, want %v", values, size, maxima, want)
+		}
+	}
+}
+
+// TestSlidingWindowSize checks the windows larger than the input and the invalid
+// sizes.
+func TestSlidingWindowSize(t *testing.T) {
+	values := FromSlice([]int{3, 1, 2})
+
+	maxima, err := SlidingWindowMax(values, 3, ToSlice[int])
+	if err != nil || !slices.Equal(maxima, []int{3}) {
+		t.Fatalf("got %v, %v, want [3]", maxima, err)
+	}
+
+	maxima, err = SlidingWindowMax(values, 4, ToSlice[int])
+	if err != nil || len(maxima) != 0 {
+		t.Fatalf("got %v, %v, want no window", maxima, err)
+	}
+
+	for _, size := range []int{0, -1} {
+		if _, err := SlidingWindowMin(values, size, ToSlice[int]); err == nil {
+			t.Fatalf("size %d: got no error", size)
+		}
+	}
+
+	if _, err := SlidingWindowMinFunc(values, 2, nil, ToSlice[int]); err == nil {
+		t.Fatal("got no error for a nil comparison function")
+	}
+}
@@ -1,23 +1,10 @@
 //go:generate go run cmd/stack/main.go -name=LinkedStack -type=T -g=T/any -o=stack/linked_stack_generic.go
-//go:generate go run cmd/stack/main.go -name=BoolStack -type=bool -o=stack/linked_stack_bool.go
-//go:generate go run cmd/stack/main.go -name=ByteStack -type=byte -o=stack/linked_stack_byte.go
-//go:generate go run cmd/stack/main.go -name=Complex64Stack -type=complex64 -o=stack/linked_stack_complex64.go
-//go:generate go run cmd/stack/main.go -name=Complex128Stack -type=complex128 -o=stack/linked_stack_complex128.go
-//go:generate go run cmd/stack/main.go -name=ErrorStack -type=error -o=stack/linked_stack_error.go
-//go:generate go run cmd/stack/main.go -name=Float32Stack -type=float32 -o=stack/linked_stack_float32.go
-//go:generate go run cmd/stack/main.go -name=Float64Stack -type=float64 -o=stack/linked_stack_float64.go
-//go:generate go run cmd/stack/main.go -name=IntStack -type=int -o=stack/linked_stack_int.go
-//go:generate go run cmd/stack/main.go -name=Int8Stack -type=int8 -o=stack/linked_stack_int8.go
-//go:generate go run cmd/stack/main.go -name=Int16Stack -type=int16 -o=stack/linked_stack_int16.go
-//go:generate go run cmd/stack/main.go -name=Int32Stack -type=int32 -o=stack/linked_stack_int32.go
-//go:generate go run cmd/stack/main.go -name=Int64Stack -type=int64 -o=stack/linked_stack_int64.go
-//go:generate go run cmd/stack/main.go -name=RuneStack -type=rune -o=stack/linked_stack_rune.go
-//go:generate go run cmd/stack/main.go -name=StringStack -type=string -o=stack/linked_stack_string.go
-//go:generate go run cmd/stack/main.go -name=UintStack -type=uint -o=stack/linked_stack_uint.go
-//go:generate go run cmd/stack/main.go -name=Uint8Stack -type=uint8 -o=stack/linked_stack_uint8.go
-//go:generate go run cmd/stack/main.go -name=Uint16Stack -type=uint16 -o=stack/linked_stack_uint16.go
-//go:generate go run cmd/stack/main.go -name=Uint32Stack -type=uint32 -o=stack/linked_stack_uint32.go
-//go:generate go run cmd/stack/main.go -name=Uint64Stack -type=uint64 -o=stack/linked_stack_uint64.go
-//go:generate go run cmd/stack/main.go -name=UintptrStack -type=uintptr -o=stack/linked_stack_uintptr.go
+//go:generate go run cmd/stack/main.go -name=<DataType>Stack -type=bool,byte,complex64,complex128,error,float32,float64,int,int8,int16,int32,int64,rune,string,uint,uint8,uint16,uint32,uint64,uintptr -o=stack/linked_stack_<type>.go
+//go:generate go run cmd/stack/main.go -name=<DataType>MinStack -type=int,float64 -track=min -o=stack/linked_stack_<type>_min.go
+//go:generate go run cmd/stack/main.go -name=<DataType>MaxStack -type=int,float64 -track=max -o=stack/linked_stack_<type>_max.go
+//go:generate go run cmd/stack/main.go -name=<DataType>Stack -type=*ast.Node,time.Duration,[]byte,map[string]int -import=go/ast -o=cmd/stack/internal/golden/linked_stack_<type>.go
+//go:generate go run cmd/stack/main.go -name=<DataType>Stack -type=Pair[K,[]V],map[K]*Pair[K,V] -g=K/comparable,V/any -o=cmd/stack/internal/golden/linked_stack_<type>.go
+//go:generate go run cmd/stack/main.go -name=<DataType>EqStack -type=int,float64,string -eq=comparable -o=cmd/stack/internal/golden/linked_stack_<type>_eq.go
+//go:generate go run cmd/stack/main.go -name=<DataType>EqStack -type=[]byte -eq=bytes.Equal -hash=HashBytes -o=cmd/stack/internal/golden/linked_stack_<type>_eq.go
 
 package stack
@@ -0,0 +1,203 @@
+// Package growth implements the capacity policy shared by the array-backed
+// containers.
+package growth
+
+import (
+	"math"
+	"slices"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+)
+
+// Policy is the capacity policy of an array-backed container. The zero value
+// grows like the append built-in and never shrinks.
+type Policy struct {
+	// Factor is the growth factor of the backing array. 0 means that the growth of
+	// the append built-in is used.
+	Factor float64
+
+	// Reserve is the capacity below which the backing array is never shrunk.
+	Reserve int
+
+	// Shrink is the fraction of the backing array that must be in use for it to be
+	// kept after a removal. 0 means that the backing array is never shrunk
+	// automatically.
+	Shrink float64
+
+	// Limit is the maximum capacity to grow to unless more room is needed. -1
+	// means no limit.
+	Limit int
+}
+
+// New is a function that creates the policy described by the settings.
+//
+// Parameters:
+//   - s: The settings. Assumed to be non-nil.
+//
+// Returns:
+//   - Policy: The policy.
+func New(s *options.Settings) Policy {
+	return Policy{
+		Factor:  s.GrowthFactor,
+		Reserve: s.Reserve,
+		Shrink:  s.ShrinkThreshold,
+		Limit:   s.Capacity,
+	}
+}
+
+// Options returns the Go syntax of the options that give the policy, apart from
+// its limit.
+//
+// Returns:
+//   - []string: The options, such as "options.WithReserve(16)". Nil if the policy
+//     is the default one.
+func (p Policy) Options() []string {
+	var opts []string
+
+	if p.Factor != 0 {
+		opts = append(opts, display.Option("WithGrowthFactor", p.Factor))
+	}
+
+	if p.Reserve != 0 {
+		opts = append(opts, display.Option("WithReserve", p.Reserve))
+	}
+
+	if p.Shrink != 0 {
+		opts = append(opts, display.Option("WithShrinkThreshold", p.Shrink))
+	}
+
+	return opts
+}
+
+// Clone returns a copy of the values whose capacity is at least the reserve of
+// the policy.
+//
+// Parameters:
+//   - p: The policy.
+//   - values: The values to copy.
+//
+// Returns:
+//   - []T: The copy. Never returns nil.
+func Clone[T any](p Policy, values []T) []T {
+	cloned := make([]T, len(values), max(len(values), p.Reserve))
+	copy(cloned, values)
+
+	return cloned
+}
+
+// Grow returns the values with room for at least n more of them, reallocating the
+// backing array if needed.
+//
+// Parameters:
+//   - p: The policy.
+//   - values: The values.
+//   - n: The number of values to make room for. Assumed to be non-negative.
+//
+// Returns:
+//   - []T: The values, with a capacity of at least len(values) + n.
+func Grow[T any](p Policy, values []T, n int) []T {
+	need := len(values) + n
+
+	if need <= cap(values) {
+		return values
+	}
+
+	if p.Factor == 0 && p.Limit == -1 {
+		return slices.Grow(values, n)
+	}
+
+	factor := p.Factor
+	if factor == 0 {
+		factor = 2
+	}
+
+	// The product is rounded up, and at least one slot is added: truncating it
+	// would leave a factor near 1 growing the small arrays by nothing.
+	size := max(int(math.Ceil(float64(cap(values))*factor)), cap(values)+1, p.Reserve)
+
+	if p.Limit != -1 && size > p.Limit {
+		size = p.Limit
+	}
+
+	return resize(values, max(size, need))
+}
+
+// Reserve returns the values with room for at least n more of them, without any
+// extra room. The room is capped at the limit of the policy.
+//
+// Parameters:
+//   - p: The policy.
+//   - values: The values.
+//   - n: The number of values to make room for. Non-positive values are a no-op.
+//
+// Returns:
+//   - []T: The values.
+func Reserve[T any](p Policy, values []T, n int) []T {
+	if n <= 0 {
+		return values
+	}
+
+	need := len(values) + n
+
+	if p.Limit != -1 && need > p.Limit {
+		need = max(p.Limit, len(values))
+	}
+
+	if need <= cap(values) {
+		return values
+	}
+
+	return resize(values, need)
+}
+
+// Shrink returns the values in a smaller backing array if less than the shrink
+// threshold of the policy is in use.
+//
+// Parameters:
+//   - p: The policy.
+//   - values: The values.
+//
+// Returns:
+//   - []T: The values.
+func Shrink[T any](p Policy, values []T) []T {
+	if p.Shrink == 0 || float64(len(values)) >= p.Shrink*float64(cap(values)) {
+		return values
+	}
+
+	return Fit(p, values)
+}
+
+// Fit returns the values in a backing array of exactly their length, or of the
+// reserve of the policy if it is larger.
+//
+// Parameters:
+//   - p: The policy.
+//   - values: The values.
+//
+// Returns:
+//   - []T: The values.
+func Fit[T any](p Policy, values []T) []T {
+	size := max(len(values), p.Reserve)
+
+	if size >= cap(values) {
+		return values
+	}
+
+	return resize(values, size)
+}
+
+// resize copies the values into a new backing array of the given capacity.
+//
+// Parameters:
+//   - values: The values.
+//   - size: The capacity. Assumed to be at least len(values).
+//
+// Returns:
+//   - []T: The copy.
+func resize[T any](values []T, size int) []T {
+	resized := make([]T, len(values), size)
+	copy(resized, values)
+
+	return resized
+}
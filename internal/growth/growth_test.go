@@ -0,0 +1,91 @@
+package growth
+
+import (
+	"testing"
+)
+
+// grow_one_by_one grows the values one slot at a time up to n values, and returns
+// the capacities that were allocated.
+func grow_one_by_one(p Policy, n int) []int {
+	var (
+		values []int
+		caps   []int
+	)
+
+	for i := 0; i < n; i++ {
+		values = Grow(p, values, 1)
+
+		if len(caps) == 0 || cap(values) != caps[len(caps)-1] {
+			caps = append(caps, cap(values))
+		}
+
+		values = append(values, i)
+	}
+
+	return caps
+}
+
+// TestGrowFactor checks the capacities given by a growth factor, rounded up.
+func TestGrowFactor(t *testing.T) {
+	caps := grow_one_by_one(Policy{Factor: 1.5, Limit: -1}, 20)
+
+	want := []int{1, 2, 3, 5, 8, 12, 18, 27}
+
+	if len(caps) != len(want) {
+		t.Fatalf("got capacities %v, want %v", caps, want)
+	}
+
+	for i := range want {
+		if caps[i] != want[i] {
+			t.Fatalf("got capacities %v, want %v", caps, want)
+		}
+	}
+}
+
+// TestGrowFactorNearOne checks that a factor near 1 still grows the small arrays,
+// and that it grows the large ones geometrically.
+func TestGrowFactorNearOne(t *testing.T) {
+	const n = 2000
+
+	caps := grow_one_by_one(Policy{Factor: 1.01, Limit: -1}, n)
+
+	for i := 1; i < len(caps); i++ {
+		if caps[i] <= caps[i-1] {
+			t.Fatalf("capacity went from %d to %d", caps[i-1], caps[i])
+		}
+	}
+
+	// Up to 100, 1.01 adds less than a slot, so each growth adds one; past it,
+	// reaching n takes about log(n/100)/log(1.01) growths.
+	if len(caps) > 100+310 {
+		t.Fatalf("%d reallocations to hold %d values", len(caps), n)
+	}
+
+	values := Grow(Policy{Factor: 1.1, Limit: -1}, make([]int, 15), 1)
+	if cap(values) != 17 {
+		t.Fatalf("got capacity %d for 15 * 1.1, want 17", cap(values))
+	}
+}
+
+// TestGrowLimit checks that the limit caps the growth unless more room is needed.
+func TestGrowLimit(t *testing.T) {
+	p := Policy{Factor: 2, Reserve: 4, Limit: 10}
+
+	tests := []struct {
+		length, n, want int
+	}{
+		{0, 1, 4},
+		{4, 1, 8},
+		{8, 1, 10},
+		{10, 1, 11},
+		{4, 20, 24},
+	}
+
+	for _, tt := range tests {
+		values := Grow(p, make([]int, tt.length), tt.n)
+
+		if cap(values) != tt.want || len(values) != tt.length {
+			t.Fatalf("Grow(%d, %d): got len %d, cap %d, want cap %d", tt.length, tt.n, len(values), cap(values), tt.want)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Package stamps keeps track of when the elements of a container were added, so
+// that the time they spent in it can be reported when they are removed.
+package stamps
+
+import (
+	"time"
+)
+
+// Deque is a double-ended queue of timestamps, one for each element of a
+// container and in the same order. The zero value is an empty deque.
+type Deque struct {
+	// times are the timestamps, from the first element to the last.
+	times []time.Time
+}
+
+// Fill adds n copies of a timestamp after the last one. It is used for the
+// elements that were in the container before it was tracked.
+//
+// Parameters:
+//   - n: The number of timestamps.
+//   - t: The timestamp.
+func (d *Deque) Fill(n int, t time.Time) {
+	for i := 0; i < n; i++ {
+		d.times = append(d.times, t)
+	}
+}
+
+// PushBack adds a timestamp after the last one.
+//
+// Parameters:
+//   - t: The timestamp.
+func (d *Deque) PushBack(t time.Time) {
+	d.times = append(d.times, t)
+}
+
+// PushFront adds a timestamp before the first one.
+//
+// Parameters:
+//   - t: The timestamp.
+func (d *Deque) PushFront(t time.Time) {
+	d.times = append(d.times, time.Time{})
+	copy(d.times[1:], d.times)
+	d.times[0] = t
+}
+
+// PopFront removes the first timestamp and returns the time elapsed since it.
+//
+// Parameters:
+//   - now: The current time.
+//
+// Returns:
+//   - time.Duration: The elapsed time. 0 if the deque is empty.
+func (d *Deque) PopFront(now time.Time) time.Duration {
+	if len(d.times) == 0 {
+		return 0
+	}
+
+	t := d.times[0]
+	d.times = d.times[1:]
+
+	return now.Sub(t)
+}
+
+// PopBack removes the last timestamp and returns the time elapsed since it.
+//
+// Parameters:
+//   - now: The current time.
+//
+// Returns:
+//   - time.Duration: The elapsed time. 0 if the deque is empty.
+func (d *Deque) PopBack(now time.Time) time.Duration {
+	if len(d.times) == 0 {
+		return 0
+	}
+
+	t := d.times[len(d.times)-1]
+	d.times = d.times[:len(d.times)-1]
+
+	return now.Sub(t)
+}
+
+// Clear removes all the timestamps.
+func (d *Deque) Clear() {
+	d.times = nil
+}
+
+// Len returns the number of timestamps.
+//
+// Returns:
+//   - int: The number of timestamps.
+func (d *Deque) Len() int {
+	return len(d.times)
+}
@@ -3,8 +3,11 @@ package list
 import (
 	"fmt"
 	"io"
-	"strconv"
-	"strings"
+	"slices"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/internal/growth"
+	"github.com/PlayerR9/listlike/options"
 )
 
 // ArrayIterator is the iterator for the Lister interface.
@@ -40,62 +43,79 @@ type ArrayList[T any] struct {
 	// values is a slice of type T that stores the elements in the list.
 	values []T
 
-	// capacity is the maximum number of elements the list can hold.
+	// capacity is the maximum number of elements the list can hold. -1 means that
+	// the list is unlimited.
 	capacity int
+
+	// overflow is what the list does when a value is added while it is full.
+	overflow options.OverflowPolicy
+
+	// policy is the capacity policy of the values.
+	policy growth.Policy
 }
 
 // NewArrayList is a function that creates and returns a new instance of a
 // ArrayList.
 //
 // Parameters:
-//
-//   - values: A variadic parameter of type T, which represents the initial values to
-//     be stored in the list.
+//   - opts: The options of the list. Supported options are WithCapacity, without
+//     which the list is unlimited, WithInitialValues, WithOverflowPolicy,
+//     WithGrowthFactor, WithReserve and WithShrinkThreshold.
 //
 // Returns:
 //   - *ArrayList[T]: A pointer to the newly created ArrayList.
-func NewArrayList[T any](values ...T) *ArrayList[T] {
-	list := &ArrayList[T]{
-		values:   make([]T, len(values)),
-		capacity: -1,
+//   - error: An error if the options are invalid.
+func NewArrayList[T any](opts ...options.Option) (*ArrayList[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Only("ArrayList", array_list_options...)
+	if err != nil {
+		return nil, err
 	}
-	copy(list.values, values)
 
-	return list
+	return new_array_list[T](s)
 }
 
-// NewArrayList is a function that creates and returns a new instance of a
-// ArrayList.
+// new_array_list creates a new ArrayList from validated settings.
 //
 // Parameters:
-//   - capacity: An integer that represents the maximum number of elements the list.
-//     can hold. If the capacity is negative, the value is converted to a positive
-//     value.
-//   - values: A variadic parameter of type T, which represents the initial values to
-//     be stored in the list.
+//   - s: The settings. Assumed to be non-nil.
 //
 // Returns:
-//   - *ArrayList[T]: A pointer to the newly created ArrayList.
-func NewLimitedArrayList[T any](capacity int, values ...T) *ArrayList[T] {
-	if capacity < 0 {
-		capacity *= -1
+//   - *ArrayList[T]: The new list.
+//   - error: An error if the initial values are invalid.
+func new_array_list[T any](s *options.Settings) (*ArrayList[T], error) {
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
 	}
 
+	policy := growth.New(s)
+
 	list := &ArrayList[T]{
-		values:   make([]T, len(values), capacity),
-		capacity: capacity,
+		values:   growth.Clone(policy, values),
+		capacity: s.Capacity,
+		overflow: s.Overflow,
+		policy:   policy,
 	}
-	copy(list.values, values)
 
-	return list
+	return list, nil
 }
 
 // Append implements the Lister interface.
 func (list *ArrayList[T]) Append(value T) bool {
 	if list.capacity != -1 && len(list.values) >= list.capacity {
-		return false
+		if list.overflow != options.DropOldest || list.capacity == 0 {
+			return false
+		}
+
+		list.values = slices.Delete(list.values, 0, 1)
 	}
 
+	list.values = growth.Grow(list.policy, list.values, 1)
 	list.values = append(list.values, value)
 
 	return true
@@ -109,6 +129,7 @@ func (list *ArrayList[T]) DeleteFirst() (T, bool) {
 
 	toRemove := list.values[0]
 	list.values = list.values[1:]
+	list.values = growth.Shrink(list.policy, list.values)
 	return toRemove, true
 }
 
@@ -167,11 +188,7 @@ func (list *ArrayList[T]) Iterator() *ArrayIterator[T] {
 // Clear is a method of the ArrayList type. It is used to remove all elements from
 // the list.
 func (list *ArrayList[T]) Clear() {
-	if list.capacity == -1 {
-		list.values = make([]T, 0)
-	} else {
-		list.values = make([]T, 0, list.capacity)
-	}
+	list.values = make([]T, 0, list.policy.Reserve)
 }
 
 // IsFull is a method of the ArrayList type. It checks if the list is full.
@@ -182,40 +199,55 @@ func (list *ArrayList[T]) IsFull() bool {
 	return list.capacity != -1 && len(list.values) >= list.capacity
 }
 
-// GoString implements the fmt.GoStringer interface.
-func (list *ArrayList[T]) GoString() string {
-	values := make([]string, 0, len(list.values))
-	for _, v := range list.values {
-		values = append(values, fmt.Sprintf("%v", v))
-	}
+// layout returns the description of the list used to format it.
+func (list *ArrayList[T]) layout() display.Container[T] {
+	var opts []string
 
-	var builder strings.Builder
+	if list.capacity != -1 {
+		opts = append(opts, display.Option("WithCapacity", list.capacity))
+	}
 
-	builder.WriteString("ArrayList[")
+	if list.overflow != options.Reject {
+		opts = append(opts, display.Option("WithOverflowPolicy", list.overflow))
+	}
 
-	if list.capacity != -1 {
-		builder.WriteString("capacity=")
-		builder.WriteString(strconv.Itoa(list.capacity))
-		builder.WriteString(", size=")
-	} else {
-		builder.WriteString("size=")
+	return display.Container[T]{
+		Pkg:      "list",
+		Name:     "ArrayList",
+		TypeArgs: []string{display.TypeName[T]()},
+		Options:  append(opts, list.policy.Options()...),
+		Capacity: list.capacity,
+		Values:   list.values,
 	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (list *ArrayList[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, list.layout())
+}
 
-	builder.WriteString(strconv.Itoa(len(list.values)))
-	builder.WriteString(", values=[")
-	builder.WriteString(strings.Join(values, ", "))
-	builder.WriteString("]]")
+// String implements the fmt.Stringer interface.
+func (list *ArrayList[T]) String() string {
+	return fmt.Sprint(list)
+}
 
-	return builder.String()
+// GoString implements the fmt.GoStringer interface.
+func (list *ArrayList[T]) GoString() string {
+	return list.layout().GoSyntax()
 }
 
 // Prepend implements the Lister interface.
 func (list *ArrayList[T]) Prepend(value T) bool {
 	if list.capacity != -1 && len(list.values) >= list.capacity {
-		return false
+		if list.overflow != options.DropOldest || list.capacity == 0 {
+			return false
+		}
+
+		list.DeleteLast()
 	}
 
-	list.values = append([]T{value}, list.values...)
+	list.values = growth.Grow(list.policy, list.values, 1)
+	list.values = slices.Insert(list.values, 0, value)
 
 	return true
 }
@@ -228,6 +260,7 @@ func (list *ArrayList[T]) DeleteLast() (T, bool) {
 
 	toRemove := list.values[len(list.values)-1]
 	list.values = list.values[:len(list.values)-1]
+	list.values = growth.Shrink(list.policy, list.values)
 	return toRemove, true
 }
 
@@ -259,22 +292,69 @@ func (list *ArrayList[T]) Slice() []T {
 // Returns:
 //   - *ArrayList[T]: A copy of the list.
 func (list *ArrayList[T]) Copy() *ArrayList[T] {
-	if list.capacity == -1 {
-		l := &ArrayList[T]{
-			values: make([]T, len(list.values)),
-		}
+	l := &ArrayList[T]{
+		values:   growth.Clone(list.policy, list.values),
+		capacity: list.capacity,
+		overflow: list.overflow,
+		policy:   list.policy,
+	}
 
-		copy(l.values, list.values)
+	return l
+}
 
-		return l
+// forEach implements the walker interface.
+func (list *ArrayList[T]) forEach(f func(idx int, value T) bool) {
+	for i, value := range list.values {
+		if !f(i, value) {
+			return
+		}
 	}
+}
 
-	l := &ArrayList[T]{
-		values:   make([]T, len(list.values), list.capacity),
-		capacity: list.capacity,
+// forEachReverse implements the walker interface.
+func (list *ArrayList[T]) forEachReverse(f func(idx int, value T) bool) {
+	for i := len(list.values) - 1; i >= 0; i-- {
+		if !f(i, list.values[i]) {
+			return
+		}
 	}
+}
 
-	copy(l.values, list.values)
+// removeFunc implements the walker interface.
+func (list *ArrayList[T]) removeFunc(pred func(value T) bool, all bool) int {
+	var count int
 
-	return l
+	kept := list.values[:0]
+
+	for _, value := range list.values {
+		if (all || count == 0) && pred(value) {
+			count++
+		} else {
+			kept = append(kept, value)
+		}
+	}
+
+	// Release the references held by the unused tail.
+	clear(list.values[len(kept):])
+
+	list.values = kept
+	list.values = growth.Shrink(list.policy, list.values)
+
+	return count
+}
+
+// Reserve is a method of the ArrayList type. It makes room for at least n more
+// elements so that adding them does not reallocate the backing array. The room is
+// capped at the capacity of the list.
+//
+// Parameters:
+//   - n: The number of elements to make room for. Non-positive values are a no-op.
+func (list *ArrayList[T]) Reserve(n int) {
+	list.values = growth.Reserve(list.policy, list.values, n)
+}
+
+// ShrinkToFit is a method of the ArrayList type. It releases the unused part of the
+// backing array, except for the reserve the list was created with.
+func (list *ArrayList[T]) ShrinkToFit() {
+	list.values = growth.Fit(list.policy, list.values)
 }
@@ -2,6 +2,8 @@ package list
 
 import (
 	"fmt"
+
+	"github.com/PlayerR9/listlike/display"
 )
 
 type Iterater[T any] interface {
@@ -108,6 +110,39 @@ type Lister[T any] interface {
 	fmt.GoStringer
 }
 
+// layouter is implemented by the lists that describe themselves to be formatted.
+type layouter[T any] interface {
+	// layout returns the description of the list used to format it.
+	layout() display.Container[T]
+}
+
+// format_wrapped formats a list that wraps another one. If the wrapped list
+// describes itself, the detailed form gives the label prefix; otherwise, it
+// formats itself.
+//
+// Parameters:
+//   - f: The state of the formatter.
+//   - verb: The verb.
+//   - prefix: The prefix of the label of the detailed form, such as "Observed".
+//   - list: The wrapped list.
+func format_wrapped[T any](f fmt.State, verb rune, prefix string, list Lister[T]) {
+	l, ok := list.(layouter[T])
+	if !ok {
+		fmt.Fprintf(f, fmt.FormatString(f, verb), list)
+		return
+	}
+
+	c := l.layout()
+
+	if c.Label == "" {
+		c.Label = c.Name
+	}
+
+	c.Label = prefix + c.Label
+
+	display.Format(f, verb, c)
+}
+
 // ListNode represents a node in a linked list. It holds a value of a generic type
 // and a reference to the next node in the list.
 type ListNode[T any] struct {
@@ -3,8 +3,9 @@ package list
 import (
 	"fmt"
 	"io"
-	"strconv"
-	"strings"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
 )
 
 // ListIterator is the iterator for the Lister interface.
@@ -44,100 +45,80 @@ type LinkedList[T any] struct {
 	// size is the current number of elements in the list.
 	size int
 
-	// capacity is the maximum number of elements the list can hold.
+	// capacity is the maximum number of elements the list can hold. -1 means that
+	// the list is unlimited.
 	capacity int
+
+	// overflow is what the list does when a value is added while it is full.
+	overflow options.OverflowPolicy
+
+	// pool is the pool of free nodes. Nil if pooling is disabled.
+	pool *node_pool[T]
 }
 
 // NewLinkedList is a function that creates and returns a new instance of a
 // LinkedList.
 //
 // Parameters:
-//   - values: A variadic parameter of type T, which represents the initial values to
-//     be stored in the list.
+//   - opts: The options of the list. Supported options are WithCapacity, without
+//     which the list is unlimited, WithInitialValues, WithOverflowPolicy and
+//     WithNodePool.
 //
 // Returns:
 //   - *LinkedList[T]: A pointer to the newly created LinkedList.
-func NewLinkedList[T any](values ...T) *LinkedList[T] {
-	list := &LinkedList[T]{
-		capacity: -1,
+//   - error: An error if the options are invalid.
+func NewLinkedList[T any](opts ...options.Option) (*LinkedList[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(values) == 0 {
-		return list
+	err = s.Only("LinkedList", linked_list_options...)
+	if err != nil {
+		return nil, err
 	}
 
-	list.size = len(values)
-
-	// First node
-	list_node := NewListNode(values[0])
-
-	list.front = list_node
-	list.back = list_node
-
-	// Subsequent nodes
-	for _, element := range values {
-		list_node := NewListNode(element)
-		list_node.SetPrev(list.back)
-
-		list.back.SetNext(list_node)
-		list.back = list_node
-	}
-
-	return list
+	return new_linked_list[T](s)
 }
 
-// NewLimitedLinkedList is a function that creates and returns a new instance of a
-// LimitedLinkedList.
+// new_linked_list creates a new LinkedList from validated settings.
 //
 // Parameters:
-//
-//   - values: A variadic parameter of type T, which represents the initial values to
-//     be stored in the list.
+//   - s: The settings. Assumed to be non-nil.
 //
 // Returns:
-//
-//   - *LimitedLinkedList[T]: A pointer to the newly created LimitedLinkedList.
-func NewLimitedLinkedList[T any](capacity int, values ...T) *LinkedList[T] {
-	if capacity < 0 {
-		capacity *= -1
+//   - *LinkedList[T]: The new list.
+//   - error: An error if the initial values are invalid.
+func new_linked_list[T any](s *options.Settings) (*LinkedList[T], error) {
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
 	}
 
 	list := &LinkedList[T]{
-		capacity: capacity,
-	}
-
-	if len(values) == 0 {
-		return list
+		capacity: s.Capacity,
+		overflow: s.Overflow,
+		pool:     new_node_pool[T](s.NodePool),
 	}
 
-	list.size = len(values)
-
-	// First node
-	list_node := NewListNode(values[0])
-
-	list.front = list_node
-	list.back = list_node
-
-	// Subsequent nodes
-	for _, element := range values {
-		list_node := NewListNode(element)
-		list_node.SetPrev(list.back)
-
-		list.back.SetNext(list_node)
-
-		list.back = list_node
+	for _, value := range values {
+		list.Append(value)
 	}
 
-	return list
+	return list, nil
 }
 
 // Append implements the Lister interface.
 func (list *LinkedList[T]) Append(value T) bool {
 	if list.capacity != -1 && list.size >= list.capacity {
-		return false
+		if list.overflow != options.DropOldest || list.capacity == 0 {
+			return false
+		}
+
+		list.DeleteFirst()
 	}
 
-	list_node := NewListNode(value)
+	list_node := list.pool.get(value)
 
 	if list.back == nil {
 		list.front = list_node
@@ -170,9 +151,12 @@ func (list *LinkedList[T]) DeleteFirst() (T, bool) {
 
 	list.size--
 
+	value := toRemove.Value
 	toRemove.SetNext(nil)
 
-	return toRemove.Value, true
+	list.pool.put(toRemove)
+
+	return value, true
 }
 
 // PeekFirst implements the Lister interface.
@@ -234,21 +218,18 @@ func (list *LinkedList[T]) Clear() {
 		return // List is already empty
 	}
 
-	// 1. First node
-	list.front.SetPrev(nil)
-	prev := list.front
+	// 1. Unlink the nodes
+	for node := list.front; node != nil; {
+		next := node.Next()
 
-	// 2. Subsequent nodes
-	for node := list.front.Next(); node != nil; node = node.Next() {
 		node.SetPrev(nil)
+		node.SetNext(nil)
+		list.pool.put(node)
 
-		prev = node
-		prev.SetNext(nil)
+		node = next
 	}
 
-	prev.SetNext(nil)
-
-	// 3. Reset list fields
+	// 2. Reset list fields
 	list.front = nil
 	list.back = nil
 	list.size = 0
@@ -263,41 +244,58 @@ func (list *LinkedList[T]) IsFull() bool {
 	return list.capacity != -1 && list.size >= list.capacity
 }
 
-// GoString implements the fmt.GoStringer interface.
-func (list *LinkedList[T]) GoString() string {
-	values := make([]string, 0, list.size)
+// layout returns the description of the list used to format it.
+func (list *LinkedList[T]) layout() display.Container[T] {
+	var opts []string
 
-	for list_node := list.front; list_node != nil; list_node = list_node.Next() {
-		values = append(values, fmt.Sprintf("%v", list_node.Value))
+	if list.capacity != -1 {
+		opts = append(opts, display.Option("WithCapacity", list.capacity))
 	}
 
-	var builder strings.Builder
+	if list.overflow != options.Reject {
+		opts = append(opts, display.Option("WithOverflowPolicy", list.overflow))
+	}
 
-	builder.WriteString("LimitedLinkedList[")
+	if list.pool != nil {
+		opts = append(opts, display.Option("WithNodePool", list.pool.limit))
+	}
 
-	if list.capacity == -1 {
-		builder.WriteString("size=")
-	} else {
-		builder.WriteString("capacity=")
-		builder.WriteString(strconv.Itoa(list.capacity))
-		builder.WriteString(", size=")
+	return display.Container[T]{
+		Pkg:      "list",
+		Name:     "LinkedList",
+		TypeArgs: []string{display.TypeName[T]()},
+		Options:  opts,
+		Capacity: list.capacity,
+		Values:   list.Slice(),
 	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (list *LinkedList[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, list.layout())
+}
 
-	builder.WriteString(strconv.Itoa(list.size))
-	builder.WriteString(", values=[")
-	builder.WriteString(strings.Join(values, ", "))
-	builder.WriteString("]]")
+// String implements the fmt.Stringer interface.
+func (list *LinkedList[T]) String() string {
+	return fmt.Sprint(list)
+}
 
-	return builder.String()
+// GoString implements the fmt.GoStringer interface.
+func (list *LinkedList[T]) GoString() string {
+	return list.layout().GoSyntax()
 }
 
 // Prepend implements the Lister interface.
 func (list *LinkedList[T]) Prepend(value T) bool {
 	if list.capacity != -1 && list.size >= list.capacity {
-		return false
+		if list.overflow != options.DropOldest || list.capacity == 0 {
+			return false
+		}
+
+		list.DeleteLast()
 	}
 
-	list_node := NewListNode(value)
+	list_node := list.pool.get(value)
 
 	if list.front == nil {
 		list.back = list_node
@@ -330,9 +328,12 @@ func (list *LinkedList[T]) DeleteLast() (T, bool) {
 
 	list.size--
 
+	value := toRemove.Value
 	toRemove.SetPrev(nil)
 
-	return toRemove.Value, true
+	list.pool.put(toRemove)
+
+	return value, true
 }
 
 // PeekLast implements the Lister interface.
@@ -368,6 +369,8 @@ func (list *LinkedList[T]) Copy() *LinkedList[T] {
 	list_copy := &LinkedList[T]{
 		size:     list.size,
 		capacity: list.capacity,
+		overflow: list.overflow,
+		pool:     list.pool.copy(),
 	}
 
 	if list.front == nil {
@@ -398,3 +401,77 @@ func (list *LinkedList[T]) Copy() *LinkedList[T] {
 
 	return list_copy
 }
+
+// forEach implements the walker interface.
+func (list *LinkedList[T]) forEach(f func(idx int, value T) bool) {
+	var i int
+
+	for list_node := list.front; list_node != nil; list_node = list_node.Next() {
+		if !f(i, list_node.Value) {
+			return
+		}
+
+		i++
+	}
+}
+
+// forEachReverse implements the walker interface.
+func (list *LinkedList[T]) forEachReverse(f func(idx int, value T) bool) {
+	i := list.size - 1
+
+	for list_node := list.back; list_node != nil; list_node = list_node.Prev() {
+		if !f(i, list_node.Value) {
+			return
+		}
+
+		i--
+	}
+}
+
+// removeFunc implements the walker interface.
+func (list *LinkedList[T]) removeFunc(pred func(value T) bool, all bool) int {
+	var count int
+
+	list_node := list.front
+
+	for list_node != nil && (all || count == 0) {
+		next := list_node.Next()
+
+		if pred(list_node.Value) {
+			list.unlink(list_node)
+			count++
+		}
+
+		list_node = next
+	}
+
+	return count
+}
+
+// unlink removes the node from the list and clears its links.
+//
+// Parameters:
+//   - list_node: The node to remove. Assumed to be in the list.
+func (list *LinkedList[T]) unlink(list_node *ListNode[T]) {
+	prev := list_node.Prev()
+	next := list_node.Next()
+
+	if prev == nil {
+		list.front = next
+	} else {
+		prev.SetNext(next)
+	}
+
+	if next == nil {
+		list.back = prev
+	} else {
+		next.SetPrev(prev)
+	}
+
+	list_node.SetPrev(nil)
+	list_node.SetNext(nil)
+
+	list.pool.put(list_node)
+
+	list.size--
+}
@@ -1,11 +1,13 @@
 package list
 
 import (
-	"strconv"
-	"strings"
+	"fmt"
 	"sync"
+	"sync/atomic"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
 
-	gcstr "github.com/PlayerR9/go-commons/strings"
 	itrs "github.com/PlayerR9/iterators/simple"
 )
 
@@ -20,105 +22,126 @@ type LimitedSafeList[T any] struct {
 	// concurrent reads and writes to the front and back nodes are thread-safe.
 	frontMutex, backMutex sync.RWMutex
 
-	// size is the current number of elements in the list.
-	size int
+	// size is the current number of elements in the list. It is updated atomically
+	// as Append and Prepend only hold one of the two locks.
+	size atomic.Int64
 
-	// capacity is the maximum number of elements that the list can hold.
+	// capacity is the maximum number of elements that the list can hold. -1 means
+	// that the list is unlimited.
 	capacity int
+
+	// overflow is what the list does when a value is added while it is full.
+	overflow options.OverflowPolicy
+
+	// shared is true when the nodes may be shared with a snapshot.
+	shared bool
 }
 
 // NewSafeList is a function that creates and returns a new instance of a
-// SafeList.
+// LimitedSafeList.
 //
 // Parameters:
-//
-//   - values: A variadic parameter of type T, which represents the initial values to
-//     be stored in the list.
+//   - opts: The options of the list. Supported options are WithCapacity, without
+//     which the list is unlimited, WithInitialValues and WithOverflowPolicy.
 //
 // Returns:
-//
-//   - *SafeList[T]: A pointer to the newly created SafeList.
-func NewSafeList[T any](values ...T) *LimitedSafeList[T] {
-	list := &LimitedSafeList[T]{
-		capacity: -1,
-	}
-
-	if len(values) == 0 {
-		return list
+//   - *LimitedSafeList[T]: A pointer to the newly created LimitedSafeList.
+//   - error: An error if the options are invalid.
+func NewSafeList[T any](opts ...options.Option) (*LimitedSafeList[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
 	}
 
-	list.size = len(values)
-
-	// First node
-	list_node := NewListSafeNode(values[0])
-
-	list.front = list_node
-	list.back = list_node
-
-	// Subsequent nodes
-	for _, element := range values {
-		list_node := NewListSafeNode(element)
-		list_node.SetPrev(list.back)
-
-		list.back.SetNext(list_node)
-		list.back = list_node
+	err = s.Only("LimitedSafeList", safe_list_options...)
+	if err != nil {
+		return nil, err
 	}
 
-	return list
+	return new_safe_list[T](s)
 }
 
-// NewLimitedSafeList is a function that creates and returns a new instance of a
-// LimitedSafeList.
+// new_safe_list creates a new LimitedSafeList from validated settings.
 //
 // Parameters:
-//
-//   - values: A variadic parameter of type T, which represents the initial values to
-//     be stored in the list.
+//   - s: The settings. Assumed to be non-nil.
 //
 // Returns:
-//
-//   - *LimitedSafeList[T]: A pointer to the newly created LimitedSafeList.
-func NewLimitedSafeList[T any](capacity int, values ...T) *LimitedSafeList[T] {
-	if capacity < 0 {
-		capacity *= -1
+//   - *LimitedSafeList[T]: The new list.
+//   - error: An error if the initial values are invalid.
+func new_safe_list[T any](s *options.Settings) (*LimitedSafeList[T], error) {
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
 	}
 
 	list := &LimitedSafeList[T]{
-		capacity: capacity,
+		capacity: s.Capacity,
+		overflow: s.Overflow,
 	}
 
-	if len(values) == 0 {
-		return list
+	for _, value := range values {
+		list.Append(value)
 	}
 
-	list.size = len(values)
+	return list, nil
+}
 
-	// First node
-	list_node := NewListSafeNode(values[0])
+// Append implements the Lister interface.
+func (list *LimitedSafeList[T]) Append(value T) bool {
+	list.backMutex.Lock()
 
-	list.front = list_node
-	list.back = list_node
+	if list.back == nil {
+		// The list is empty, so the front changes as well. The front lock must be
+		// taken before the back one.
+		list.backMutex.Unlock()
 
-	// Subsequent nodes
-	for _, element := range values {
-		list_node := NewListSafeNode(element)
+		return list.appendLocked(value)
+	}
 
-		list_node.SetPrev(list.back)
+	if !list.reserve() {
+		list.backMutex.Unlock()
 
-		list.back.SetNext(list_node)
-		list.back = list_node
-	}
+		if list.overflow != options.DropOldest || list.capacity == 0 {
+			return false
+		}
 
-	return list
-}
+		return list.appendLocked(value)
+	}
 
-// Append implements the Lister interface.
-func (list *LimitedSafeList[T]) Append(value T) bool {
-	list.backMutex.Lock()
 	defer list.backMutex.Unlock()
 
-	if list.capacity != -1 && list.size >= list.capacity {
-		return false
+	node := NewListSafeNode(value)
+
+	list.back.SetNext(node)
+	node.SetPrev(list.back)
+
+	list.back = node
+
+	return true
+}
+
+// appendLocked appends the value while holding both locks. It is used when the
+// front may change as well; that is, when the list is empty or when its first
+// element is dropped to make room. As the back lock was released to take the
+// locks in order, the state of the list is checked again.
+//
+// Parameters:
+//   - value: The value to append.
+//
+// Returns:
+//   - bool: True if the value was appended, false if the list is full and does
+//     not drop its elements.
+func (list *LimitedSafeList[T]) appendLocked(value T) bool {
+	list.lock()
+	defer list.unlock()
+
+	if list.capacity != -1 && list.length() >= list.capacity {
+		if list.overflow != options.DropOldest || list.capacity == 0 {
+			return false
+		}
+
+		list.dropFirst()
 	}
 
 	node := NewListSafeNode(value)
@@ -127,19 +150,43 @@ func (list *LimitedSafeList[T]) Append(value T) bool {
 		list.back.SetNext(node)
 		node.SetPrev(list.back)
 	} else {
-		// The list is empty
-		list.frontMutex.Lock()
 		list.front = node
-		list.frontMutex.Unlock()
 	}
 
 	list.back = node
 
-	list.size++
+	list.size.Add(1)
 
 	return true
 }
 
+// reserve counts a new element if the list is not full. The caller must hold one
+// of the locks.
+//
+// Returns:
+//   - bool: True if the element was counted, false if the list is full.
+func (list *LimitedSafeList[T]) reserve() bool {
+	for {
+		size := list.size.Load()
+
+		if list.capacity != -1 && size >= int64(list.capacity) {
+			return false
+		}
+
+		if list.size.CompareAndSwap(size, size+1) {
+			return true
+		}
+	}
+}
+
+// length returns the number of elements of the list.
+//
+// Returns:
+//   - int: The number of elements.
+func (list *LimitedSafeList[T]) length() int {
+	return int(list.size.Load())
+}
+
 // DeleteFirst implements the Lister interface.
 func (list *LimitedSafeList[T]) DeleteFirst() (T, bool) {
 	list.frontMutex.Lock()
@@ -163,9 +210,11 @@ func (list *LimitedSafeList[T]) DeleteFirst() (T, bool) {
 
 	list.backMutex.Unlock()
 
-	list.size--
+	list.size.Add(-1)
 
-	toRemove.SetNext(nil)
+	if !list.shared {
+		toRemove.SetNext(nil)
+	}
 
 	return toRemove.Value, true
 }
@@ -207,7 +256,7 @@ func (list *LimitedSafeList[T]) Size() int {
 	list.backMutex.RLock()
 	defer list.backMutex.RUnlock()
 
-	return list.size
+	return list.length()
 }
 
 // Capacity is a method of the LimitedSafeList type. It returns the maximum number of
@@ -257,24 +306,27 @@ func (list *LimitedSafeList[T]) Clear() {
 		return // List is already empty
 	}
 
-	// 1. First node
-	list.front.SetPrev(nil)
-	prev := list.front
+	if !list.shared {
+		// 1. First node
+		list.front.SetPrev(nil)
+		prev := list.front
 
-	// 2. Subsequent nodes
-	for node := list.front.Next(); node != nil; node = node.Next() {
-		node.SetPrev(nil)
+		// 2. Subsequent nodes
+		for node := list.front.Next(); node != nil; node = node.Next() {
+			node.SetPrev(nil)
+
+			prev = node
+			prev.SetNext(nil)
+		}
 
-		prev = node
 		prev.SetNext(nil)
 	}
 
-	prev.SetNext(nil)
-
 	// 3. Reset list fields
 	list.front = nil
 	list.back = nil
-	list.size = 0
+	list.size.Store(0)
+	list.shared = false
 }
 
 // IsFull is a method of the LimitedSafeList type. It checks if the list is fu
@@ -283,33 +335,45 @@ func (list *LimitedSafeList[T]) Clear() {
 //
 //   - isFull: A boolean value that is true if the list is full, and false otherwise.
 func (list *LimitedSafeList[T]) IsFull() (isFull bool) {
-	return list.capacity != -1 && list.size >= list.capacity
+	return list.capacity != -1 && list.length() >= list.capacity
 }
 
-// GoString implements the fmt.GoStringer interface.
-func (list *LimitedSafeList[T]) GoString() string {
-	list.frontMutex.RLock()
-	defer list.frontMutex.RUnlock()
+// layout returns the description of the list used to format it.
+func (list *LimitedSafeList[T]) layout() display.Container[T] {
+	var opts []string
 
-	list.backMutex.RLock()
-	defer list.backMutex.RUnlock()
+	if list.capacity != -1 {
+		opts = append(opts, display.Option("WithCapacity", list.capacity))
+	}
 
-	values := make([]string, 0, list.size)
-	for node := list.front; node != nil; node = node.Next() {
-		values = append(values, gcstr.GoStringOf(node.Value))
+	if list.overflow != options.Reject {
+		opts = append(opts, display.Option("WithOverflowPolicy", list.overflow))
 	}
 
-	var builder strings.Builder
+	return display.Container[T]{
+		Pkg:      "list",
+		Name:     "LimitedSafeList",
+		TypeArgs: []string{display.TypeName[T]()},
+		Ctor:     "NewSafeList",
+		Options:  opts,
+		Capacity: list.capacity,
+		Values:   list.Slice(),
+	}
+}
 
-	builder.WriteString("LimitedSafeList[capacity=")
-	builder.WriteString(strconv.Itoa(list.capacity))
-	builder.WriteString(", size=")
-	builder.WriteString(strconv.Itoa(list.size))
-	builder.WriteString(", values=[")
-	builder.WriteString(strings.Join(values, ", "))
-	builder.WriteString("]]")
+// Format implements the fmt.Formatter interface.
+func (list *LimitedSafeList[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, list.layout())
+}
 
-	return builder.String()
+// String implements the fmt.Stringer interface.
+func (list *LimitedSafeList[T]) String() string {
+	return fmt.Sprint(list)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (list *LimitedSafeList[T]) GoString() string {
+	return list.layout().GoSyntax()
 }
 
 // Prepend implements the Lister interface.
@@ -317,8 +381,17 @@ func (list *LimitedSafeList[T]) Prepend(value T) bool {
 	list.frontMutex.Lock()
 	defer list.frontMutex.Unlock()
 
-	if list.size >= list.capacity {
-		return false
+	if !list.reserve() {
+		if list.overflow != options.DropOldest || list.capacity == 0 {
+			return false
+		}
+
+		list.backMutex.Lock()
+		list.unshare()
+		list.unlink(list.back)
+		list.backMutex.Unlock()
+
+		list.size.Add(1)
 	}
 
 	node := NewListSafeNode(value)
@@ -335,15 +408,15 @@ func (list *LimitedSafeList[T]) Prepend(value T) bool {
 
 	list.front = node
 
-	list.size++
-
 	return true
 }
 
 // DeleteLast implements the Lister interface.
+//
+// Both locks are held, the front before the back, as the front may change.
 func (list *LimitedSafeList[T]) DeleteLast() (T, bool) {
-	list.backMutex.Lock()
-	defer list.backMutex.Unlock()
+	list.lock()
+	defer list.unlock()
 
 	if list.back == nil {
 		return *new(T), false
@@ -351,7 +424,10 @@ func (list *LimitedSafeList[T]) DeleteLast() (T, bool) {
 
 	toRemove := list.back
 
-	list.frontMutex.Lock()
+	if list.shared {
+		list.unshare()
+		toRemove = list.back
+	}
 
 	list.back = list.back.Prev()
 
@@ -361,9 +437,7 @@ func (list *LimitedSafeList[T]) DeleteLast() (T, bool) {
 		list.back.SetNext(nil)
 	}
 
-	list.frontMutex.Unlock()
-
-	list.size--
+	list.size.Add(-1)
 
 	toRemove.SetPrev(nil)
 
@@ -395,7 +469,7 @@ func (list *LimitedSafeList[T]) Slice() []T {
 	list.backMutex.RLock()
 	defer list.backMutex.RUnlock()
 
-	slice := make([]T, 0, list.size)
+	slice := make([]T, 0, list.length())
 
 	for node := list.front; node != nil; node = node.Next() {
 		slice = append(slice, node.Value)
@@ -417,10 +491,12 @@ func (list *LimitedSafeList[T]) Copy() *LimitedSafeList[T] {
 	defer list.backMutex.RUnlock()
 
 	list_copy := &LimitedSafeList[T]{
-		size:     list.size,
 		capacity: list.capacity,
+		overflow: list.overflow,
 	}
 
+	list_copy.size.Store(list.size.Load())
+
 	if list.front == nil {
 		return list_copy
 	}
@@ -449,3 +525,122 @@ func (list *LimitedSafeList[T]) Copy() *LimitedSafeList[T] {
 
 	return list_copy
 }
+
+// forEach implements the walker interface.
+//
+// The list is read-locked during the whole walk, so f must not modify it.
+func (list *LimitedSafeList[T]) forEach(f func(idx int, value T) bool) {
+	list.frontMutex.RLock()
+	defer list.frontMutex.RUnlock()
+
+	list.backMutex.RLock()
+	defer list.backMutex.RUnlock()
+
+	var i int
+
+	for node := list.front; node != nil; node = node.Next() {
+		if !f(i, node.Value) {
+			return
+		}
+
+		i++
+	}
+}
+
+// forEachReverse implements the walker interface.
+//
+// The list is read-locked during the whole walk, so f must not modify it.
+func (list *LimitedSafeList[T]) forEachReverse(f func(idx int, value T) bool) {
+	list.frontMutex.RLock()
+	defer list.frontMutex.RUnlock()
+
+	list.backMutex.RLock()
+	defer list.backMutex.RUnlock()
+
+	i := list.length() - 1
+
+	for node := list.back; node != nil; node = node.Prev() {
+		if !f(i, node.Value) {
+			return
+		}
+
+		i--
+	}
+}
+
+// removeFunc implements the walker interface.
+//
+// The list is locked during the whole walk, so pred must not access it.
+func (list *LimitedSafeList[T]) removeFunc(pred func(value T) bool, all bool) int {
+	list.frontMutex.Lock()
+	defer list.frontMutex.Unlock()
+
+	list.backMutex.Lock()
+	defer list.backMutex.Unlock()
+
+	list.unshare()
+
+	var count int
+
+	node := list.front
+
+	for node != nil && (all || count == 0) {
+		next := node.Next()
+
+		if pred(node.Value) {
+			list.unlink(node)
+			count++
+		}
+
+		node = next
+	}
+
+	return count
+}
+
+// unlink removes the node from the list and clears its links. The caller must
+// hold both locks.
+//
+// Parameters:
+//   - node: The node to remove. Assumed to be in the list.
+func (list *LimitedSafeList[T]) unlink(node *ListSafeNode[T]) {
+	prev := node.Prev()
+	next := node.Next()
+
+	if prev == nil {
+		list.front = next
+	} else {
+		prev.SetNext(next)
+	}
+
+	if next == nil {
+		list.back = prev
+	} else {
+		next.SetPrev(prev)
+	}
+
+	node.SetPrev(nil)
+	node.SetNext(nil)
+
+	list.size.Add(-1)
+}
+
+// dropFirst removes the first node of the list. The caller must hold both locks
+// and the list is assumed to be non-empty.
+func (list *LimitedSafeList[T]) dropFirst() {
+	to_remove := list.front
+
+	list.front = to_remove.Next()
+
+	if list.front == nil {
+		list.back = nil
+	} else {
+		list.front.SetPrev(nil)
+	}
+
+	list.size.Add(-1)
+
+	if !list.shared {
+		to_remove.SetNext(nil)
+	}
+}
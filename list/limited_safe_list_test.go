@@ -0,0 +1,91 @@
+package list
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PlayerR9/listlike/options"
+)
+
+// TestLimitedSafeListAppendDropOldest checks that appending to a full list drops
+// its first element.
+func TestLimitedSafeListAppendDropOldest(t *testing.T) {
+	list, err := NewSafeList[int](
+		options.WithCapacity(3),
+		options.WithOverflowPolicy(options.DropOldest),
+		options.WithInitialValues[int](1, 2, 3),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !list.Append(4) {
+		t.Fatal("Append on a full DropOldest list failed")
+	}
+
+	got := list.Slice()
+	if len(got) != 3 || got[0] != 2 || got[2] != 4 {
+		t.Fatalf("got %v, want [2 3 4]", got)
+	}
+}
+
+// TestLimitedSafeListAppendDropOldestConcurrent checks that evicting appends do
+// not deadlock with the operations that lock the front before the back.
+func TestLimitedSafeListAppendDropOldestConcurrent(t *testing.T) {
+	list, err := NewSafeList[int](
+		options.WithCapacity(4),
+		options.WithOverflowPolicy(options.DropOldest),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		var wg sync.WaitGroup
+
+		for g := 0; g < 4; g++ {
+			wg.Add(3)
+
+			go func() {
+				defer wg.Done()
+
+				for i := 0; i < 2000; i++ {
+					list.Append(i)
+				}
+			}()
+
+			go func() {
+				defer wg.Done()
+
+				for i := 0; i < 2000; i++ {
+					list.DeleteFirst()
+				}
+			}()
+
+			go func() {
+				defer wg.Done()
+
+				for i := 0; i < 2000; i++ {
+					list.Prepend(i)
+				}
+			}()
+		}
+
+		wg.Wait()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(20 * time.Second):
+		t.Fatal("deadlock between Append, Prepend and DeleteFirst")
+	}
+
+	if list.Size() > 4 {
+		t.Fatalf("got size %d, want at most 4", list.Size())
+	}
+}
@@ -0,0 +1,75 @@
+package list
+
+import (
+	"github.com/PlayerR9/listlike/options"
+)
+
+var (
+	// array_list_options are the options supported by NewArrayList.
+	array_list_options = []string{"WithCapacity", "WithInitialValues", "WithOverflowPolicy", "WithGrowthFactor", "WithReserve", "WithShrinkThreshold"}
+
+	// linked_list_options are the options supported by NewLinkedList.
+	linked_list_options = []string{"WithCapacity", "WithInitialValues", "WithOverflowPolicy", "WithNodePool"}
+
+	// safe_list_options are the options supported by NewSafeList.
+	safe_list_options = []string{"WithCapacity", "WithInitialValues", "WithOverflowPolicy"}
+)
+
+// New is a function that creates a list whose implementation is picked from the
+// options:
+//   - a LimitedSafeList if WithThreadSafety is enabled;
+//   - a LinkedList if WithNodePool is given;
+//   - an ArrayList otherwise.
+//
+// Options that the picked implementation does not support are rejected with an
+// *options.ErrUnsupportedOption.
+//
+// Parameters:
+//   - opts: The options of the list.
+//
+// Returns:
+//   - Lister[T]: The new list.
+//   - error: An error if the options are invalid.
+func New[T any](opts ...options.Option) (Lister[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var list Lister[T]
+
+	switch {
+	case s.ThreadSafe:
+		err := s.Only("LimitedSafeList", append(safe_list_options, "WithThreadSafety")...)
+		if err != nil {
+			return nil, err
+		}
+
+		list, err = new_safe_list[T](s)
+		if err != nil {
+			return nil, err
+		}
+	case s.Has("WithNodePool"):
+		err := s.Only("LinkedList", append(linked_list_options, "WithThreadSafety")...)
+		if err != nil {
+			return nil, err
+		}
+
+		list, err = new_linked_list[T](s)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		err := s.Only("ArrayList", append(array_list_options, "WithThreadSafety")...)
+		if err != nil {
+			return nil, err
+		}
+
+		list, err = new_array_list[T](s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return list, nil
+}
@@ -0,0 +1,215 @@
+package list
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/PlayerR9/listlike/internal/stamps"
+	"github.com/PlayerR9/listlike/observe"
+
+	gcers "github.com/PlayerR9/go-commons/errors"
+)
+
+// Observe is a function that returns a list that reports every operation
+// performed through it to an observer. Append and Prepend are reported as pushes,
+// DeleteFirst and DeleteLast as pops. The elements already in the list are
+// considered added at the time of the call.
+//
+// The list must only be modified through the returned one afterwards; otherwise,
+// the reported wait times are wrong. The returned list is safe for concurrent use
+// if the list is.
+//
+// Parameters:
+//   - list: The list to observe.
+//   - o: The observer.
+//
+// Returns:
+//   - Lister[T]: The observed list.
+//   - error: An error of type *errors.ErrInvalidParameter if list or o is nil.
+func Observe[T any](list Lister[T], o observe.Observer) (Lister[T], error) {
+	if list == nil {
+		return nil, gcers.NewErrNilParameter("list")
+	} else if o == nil {
+		return nil, gcers.NewErrNilParameter("o")
+	}
+
+	l := &observed_list[T]{
+		list:     list,
+		observer: o,
+	}
+
+	l.stamps.Fill(list.Size(), time.Now())
+
+	return l, nil
+}
+
+// observed_list is a Lister that reports the operations performed on another one
+// to an observer.
+type observed_list[T any] struct {
+	// list is the observed list.
+	list Lister[T]
+
+	// observer is the observer to report to.
+	observer observe.Observer
+
+	// stamps are the times at which the elements were added, from the first to the
+	// last.
+	stamps stamps.Deque
+
+	// mu is the mutex that serializes the modifications, so that the stamps match
+	// the elements.
+	mu sync.Mutex
+}
+
+// Append implements the Lister interface.
+func (l *observed_list[T]) Append(value T) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	size := l.list.Size()
+
+	ok := l.list.Append(value)
+	if !ok {
+		l.observer.OnOverflow(observe.Event{Size: size, Time: time.Now()})
+
+		return false
+	}
+
+	now := time.Now()
+	after := l.list.Size()
+
+	l.stamps.PushBack(now)
+	l.observer.OnPush(observe.Event{Size: after, Time: now})
+
+	if after == size {
+		l.observer.OnOverflow(observe.Event{Size: after, Time: now, Wait: l.stamps.PopFront(now)})
+	}
+
+	return true
+}
+
+// Prepend implements the Lister interface.
+func (l *observed_list[T]) Prepend(value T) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	size := l.list.Size()
+
+	ok := l.list.Prepend(value)
+	if !ok {
+		l.observer.OnOverflow(observe.Event{Size: size, Time: time.Now()})
+
+		return false
+	}
+
+	now := time.Now()
+	after := l.list.Size()
+
+	l.stamps.PushFront(now)
+	l.observer.OnPush(observe.Event{Size: after, Time: now})
+
+	if after == size {
+		l.observer.OnOverflow(observe.Event{Size: after, Time: now, Wait: l.stamps.PopBack(now)})
+	}
+
+	return true
+}
+
+// DeleteFirst implements the Lister interface.
+func (l *observed_list[T]) DeleteFirst() (T, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	value, ok := l.list.DeleteFirst()
+	if ok {
+		now := time.Now()
+
+		l.observer.OnPop(observe.Event{Size: l.list.Size(), Time: now, Wait: l.stamps.PopFront(now)})
+	}
+
+	return value, ok
+}
+
+// DeleteLast implements the Lister interface.
+func (l *observed_list[T]) DeleteLast() (T, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	value, ok := l.list.DeleteLast()
+	if ok {
+		now := time.Now()
+
+		l.observer.OnPop(observe.Event{Size: l.list.Size(), Time: now, Wait: l.stamps.PopBack(now)})
+	}
+
+	return value, ok
+}
+
+// PeekFirst implements the Lister interface.
+func (l *observed_list[T]) PeekFirst() (T, bool) {
+	return l.list.PeekFirst()
+}
+
+// PeekLast implements the Lister interface.
+func (l *observed_list[T]) PeekLast() (T, bool) {
+	return l.list.PeekLast()
+}
+
+// IsEmpty implements the Lister interface.
+func (l *observed_list[T]) IsEmpty() bool {
+	return l.list.IsEmpty()
+}
+
+// Size implements the Lister interface.
+func (l *observed_list[T]) Size() int {
+	return l.list.Size()
+}
+
+// Clear implements the Lister interface.
+func (l *observed_list[T]) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	size := l.list.Size()
+
+	l.list.Clear()
+	l.stamps.Clear()
+
+	l.observer.OnClear(observe.Event{Size: size, Time: time.Now()})
+}
+
+// Capacity implements the Lister interface.
+func (l *observed_list[T]) Capacity() int {
+	return l.list.Capacity()
+}
+
+// IsFull implements the Lister interface.
+func (l *observed_list[T]) IsFull() bool {
+	return l.list.IsFull()
+}
+
+// Slice implements the Lister interface.
+func (l *observed_list[T]) Slice() []T {
+	return l.list.Slice()
+}
+
+// Format implements the fmt.Formatter interface.
+//
+// The list is formatted as the observed one.
+func (l *observed_list[T]) Format(f fmt.State, verb rune) {
+	format_wrapped(f, verb, "Observed", l.list)
+}
+
+// String implements the fmt.Stringer interface.
+func (l *observed_list[T]) String() string {
+	return fmt.Sprint(l)
+}
+
+// GoString implements the fmt.GoStringer interface.
+//
+// The observer cannot be rebuilt, so the Go syntax is the one of the observed
+// list.
+func (l *observed_list[T]) GoString() string {
+	return l.list.GoString()
+}
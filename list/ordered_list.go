@@ -0,0 +1,337 @@
+package list
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/internal/growth"
+	"github.com/PlayerR9/listlike/options"
+)
+
+// OrderedList is a generic type that represents a list data structure with or
+// without a limited capacity whose elements are always kept in ascending order.
+// It is implemented using an array.
+type OrderedList[T cmp.Ordered] struct {
+	// values is a slice of type T that stores the elements in the list, in
+	// ascending order.
+	values []T
+
+	// capacity is the maximum number of elements the list can hold. -1 means that
+	// the list is unlimited.
+	capacity int
+
+	// policy is the capacity policy of the values.
+	policy growth.Policy
+}
+
+// NewOrderedList is a function that creates and returns a new instance of an
+// OrderedList.
+//
+// Parameters:
+//   - opts: The options of the list. Supported options are WithCapacity, without
+//     which the list is unlimited, WithInitialValues, which do not need to be
+//     sorted, WithGrowthFactor, WithReserve and WithShrinkThreshold.
+//
+// Returns:
+//   - *OrderedList[T]: A pointer to the newly created OrderedList.
+//   - error: An error if the options are invalid.
+func NewOrderedList[T cmp.Ordered](opts ...options.Option) (*OrderedList[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Only("OrderedList", "WithCapacity", "WithInitialValues", "WithGrowthFactor", "WithReserve", "WithShrinkThreshold")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := growth.New(s)
+
+	list := &OrderedList[T]{
+		values:   growth.Clone(policy, values),
+		capacity: s.Capacity,
+		policy:   policy,
+	}
+
+	slices.Sort(list.values)
+
+	return list, nil
+}
+
+// Insert is a method of the OrderedList type. It inserts the value at its sorted
+// position, after the elements that are equal to it. The order is that of
+// cmp.Compare, in which NaN is smaller than any other floating-point value.
+//
+// Parameters:
+//   - value: The value to insert.
+//
+// Returns:
+//   - bool: True if the value was inserted, false if the list is full.
+func (list *OrderedList[T]) Insert(value T) bool {
+	if list.capacity != -1 && len(list.values) >= list.capacity {
+		return false
+	}
+
+	pos := sort.Search(len(list.values), func(i int) bool {
+		return cmp.Compare(list.values[i], value) > 0
+	})
+
+	list.values = growth.Grow(list.policy, list.values, 1)
+	list.values = slices.Insert(list.values, pos, value)
+
+	return true
+}
+
+// Append implements the Lister interface.
+//
+// The value is inserted at its sorted position, just like with Insert.
+func (list *OrderedList[T]) Append(value T) bool {
+	return list.Insert(value)
+}
+
+// Prepend implements the Lister interface.
+//
+// The value is inserted at its sorted position, just like with Insert.
+func (list *OrderedList[T]) Prepend(value T) bool {
+	return list.Insert(value)
+}
+
+// DeleteFirst implements the Lister interface.
+//
+// The smallest element is removed.
+func (list *OrderedList[T]) DeleteFirst() (T, bool) {
+	if len(list.values) == 0 {
+		return *new(T), false
+	}
+
+	toRemove := list.values[0]
+	list.values = slices.Delete(list.values, 0, 1)
+	list.values = growth.Shrink(list.policy, list.values)
+
+	return toRemove, true
+}
+
+// DeleteLast implements the Lister interface.
+//
+// The greatest element is removed.
+func (list *OrderedList[T]) DeleteLast() (T, bool) {
+	if len(list.values) == 0 {
+		return *new(T), false
+	}
+
+	toRemove := list.values[len(list.values)-1]
+	list.values = list.values[:len(list.values)-1]
+	list.values = growth.Shrink(list.policy, list.values)
+
+	return toRemove, true
+}
+
+// PeekFirst implements the Lister interface.
+//
+// The smallest element is returned.
+func (list *OrderedList[T]) PeekFirst() (T, bool) {
+	if len(list.values) == 0 {
+		return *new(T), false
+	}
+
+	return list.values[0], true
+}
+
+// PeekLast implements the Lister interface.
+//
+// The greatest element is returned.
+func (list *OrderedList[T]) PeekLast() (T, bool) {
+	if len(list.values) == 0 {
+		return *new(T), false
+	}
+
+	return list.values[len(list.values)-1], true
+}
+
+// Delete is a method of the OrderedList type. It removes the first occurrence of
+// the value from the list.
+//
+// Parameters:
+//   - value: The value to remove.
+//
+// Returns:
+//   - bool: True if the value was removed, false if it was not in the list.
+func (list *OrderedList[T]) Delete(value T) bool {
+	pos, ok := slices.BinarySearch(list.values, value)
+	if !ok {
+		return false
+	}
+
+	list.values = slices.Delete(list.values, pos, pos+1)
+	list.values = growth.Shrink(list.policy, list.values)
+
+	return true
+}
+
+// IndexOf is a method of the OrderedList type. It returns the index of the first
+// occurrence of the value in the list.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - int: The index of the value. -1 if the value is not in the list.
+func (list *OrderedList[T]) IndexOf(value T) int {
+	pos, ok := slices.BinarySearch(list.values, value)
+	if !ok {
+		return -1
+	}
+
+	return pos
+}
+
+// Contains is a method of the OrderedList type. It checks whether the list holds
+// the value.
+//
+// Parameters:
+//   - value: The value to search for.
+//
+// Returns:
+//   - bool: True if the value is in the list, false otherwise.
+func (list *OrderedList[T]) Contains(value T) bool {
+	_, ok := slices.BinarySearch(list.values, value)
+	return ok
+}
+
+// IsEmpty is a method of the OrderedList type. It checks if the list is empty.
+//
+// Returns:
+//   - bool: A boolean value that is true if the list is empty, and false otherwise.
+func (list *OrderedList[T]) IsEmpty() bool {
+	return len(list.values) == 0
+}
+
+// Size is a method of the OrderedList type. It returns the number of elements in
+// the list.
+//
+// Returns:
+//   - int: An integer that represents the number of elements in the list.
+func (list *OrderedList[T]) Size() int {
+	return len(list.values)
+}
+
+// Capacity is a method of the OrderedList type. It returns the maximum number of
+// elements the list can hold.
+//
+// Returns:
+//   - int: The maximum number of elements the list can hold. -1 if there is no
+//     limit.
+func (list *OrderedList[T]) Capacity() int {
+	return list.capacity
+}
+
+// IsFull is a method of the OrderedList type. It checks if the list is full.
+//
+// Returns:
+//   - bool: A boolean value that is true if the list is full, and false otherwise.
+func (list *OrderedList[T]) IsFull() bool {
+	return list.capacity != -1 && len(list.values) >= list.capacity
+}
+
+// Clear is a method of the OrderedList type. It is used to remove all elements
+// from the list.
+func (list *OrderedList[T]) Clear() {
+	list.values = make([]T, 0, list.policy.Reserve)
+}
+
+// Iterator is a method of the OrderedList type. It returns an iterator over the
+// list, in ascending order.
+//
+// Returns:
+//   - *ArrayIterator[T]: An iterator for the list.
+func (list *OrderedList[T]) Iterator() *ArrayIterator[T] {
+	return &ArrayIterator[T]{
+		values: list.values,
+		pos:    0,
+	}
+}
+
+// Slice is a method of the OrderedList type that returns a slice of type T
+// containing the elements of the list, in ascending order.
+//
+// Returns:
+//   - []T: A slice of type T containing the elements of the list.
+func (list *OrderedList[T]) Slice() []T {
+	slice := make([]T, len(list.values))
+	copy(slice, list.values)
+
+	return slice
+}
+
+// layout returns the description of the list used to format it.
+func (list *OrderedList[T]) layout() display.Container[T] {
+	var opts []string
+
+	if list.capacity != -1 {
+		opts = append(opts, display.Option("WithCapacity", list.capacity))
+	}
+
+	return display.Container[T]{
+		Pkg:      "list",
+		Name:     "OrderedList",
+		TypeArgs: []string{display.TypeName[T]()},
+		Options:  append(opts, list.policy.Options()...),
+		Capacity: list.capacity,
+		Values:   list.values,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (list *OrderedList[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, list.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (list *OrderedList[T]) String() string {
+	return fmt.Sprint(list)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (list *OrderedList[T]) GoString() string {
+	return list.layout().GoSyntax()
+}
+
+// Copy is a method of the OrderedList type. It is used to create a shallow copy
+// of the list.
+//
+// Returns:
+//   - *OrderedList[T]: A copy of the list.
+func (list *OrderedList[T]) Copy() *OrderedList[T] {
+	l := &OrderedList[T]{
+		values:   growth.Clone(list.policy, list.values),
+		capacity: list.capacity,
+		policy:   list.policy,
+	}
+
+	return l
+}
+
+// Reserve is a method of the OrderedList type. It makes room for at least n more
+// elements so that adding them does not reallocate the backing array. The room is
+// capped at the capacity of the list.
+//
+// Parameters:
+//   - n: The number of elements to make room for. Non-positive values are a no-op.
+func (list *OrderedList[T]) Reserve(n int) {
+	list.values = growth.Reserve(list.policy, list.values, n)
+}
+
+// ShrinkToFit is a method of the OrderedList type. It releases the unused part of
+// the backing array, except for the reserve the list was created with.
+func (list *OrderedList[T]) ShrinkToFit() {
+	list.values = growth.Fit(list.policy, list.values)
+}
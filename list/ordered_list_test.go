@@ -0,0 +1,86 @@
+package list
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/PlayerR9/listlike/options"
+)
+
+// TestOrderedListInsertNaN checks that NaN is inserted where slices.Sort puts it,
+// so that the list stays sorted and the binary searches find it.
+func TestOrderedListInsertNaN(t *testing.T) {
+	list, err := NewOrderedList[float64]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, value := range []float64{1, math.NaN(), 0.5, math.Inf(-1), math.NaN(), 2} {
+		list.Insert(value)
+	}
+
+	got := list.Slice()
+
+	if !slices.IsSortedFunc(got, cmp.Compare[float64]) {
+		t.Fatalf("got %v, want a sorted list", got)
+	}
+
+	if !math.IsNaN(got[0]) || !math.IsNaN(got[1]) || got[2] != math.Inf(-1) || got[5] != 2 {
+		t.Fatalf("got %v, want [NaN NaN -Inf 0.5 1 2]", got)
+	}
+
+	if !list.Contains(math.NaN()) || list.IndexOf(math.NaN()) != 0 || list.IndexOf(0.5) != 3 {
+		t.Fatalf("NaN or 0.5 not found in %v", got)
+	}
+
+	if !list.Delete(math.NaN()) || !list.Delete(math.NaN()) || list.Contains(math.NaN()) {
+		t.Fatalf("could not delete both NaNs from %v", got)
+	}
+}
+
+// TestOrderedListDuplicates checks that equal values are kept, that the first
+// occurrence is found and deleted first, and that the initial values are sorted.
+func TestOrderedListDuplicates(t *testing.T) {
+	list, err := NewOrderedList[int](options.WithInitialValues[int](3, 1, 3, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list.Insert(3)
+	list.Insert(1)
+
+	want := []int{1, 1, 2, 3, 3, 3}
+	if got := list.Slice(); !slices.Equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if idx := list.IndexOf(3); idx != 3 {
+		t.Fatalf("got index %d, want 3", idx)
+	}
+
+	if !list.Delete(1) || !slices.Equal(list.Slice(), want[1:]) {
+		t.Fatalf("got %v after Delete(1), want %v", list.Slice(), want[1:])
+	}
+
+	if list.Delete(4) {
+		t.Fatal("Delete(4) removed a value")
+	}
+}
+
+// TestOrderedListCapacity checks that a full list rejects new values.
+func TestOrderedListCapacity(t *testing.T) {
+	list, err := NewOrderedList[int](options.WithCapacity(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !list.Insert(2) || !list.Append(1) || list.Prepend(0) {
+		t.Fatalf("got %v, want the third value rejected", list.Slice())
+	}
+
+	if got := list.Slice(); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("got %v, want [1 2]", got)
+	}
+}
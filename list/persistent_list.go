@@ -0,0 +1,417 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+
+	itrs "github.com/PlayerR9/iterators/simple"
+)
+
+// persistent_list_node is an immutable node of a persistent list. Once created, a
+// node is never modified, so it can be shared by any number of lists.
+type persistent_list_node[T any] struct {
+	// value is the value stored in the node.
+	value T
+
+	// next is a pointer to the next node of the chain.
+	next *persistent_list_node[T]
+}
+
+// split_chain copies the first k nodes of a chain of n nodes and moves the values
+// of the remaining ones into a new chain, in reverse order.
+//
+// Parameters:
+//   - chain: The chain to split.
+//   - n: The number of nodes of the chain.
+//   - k: The number of nodes to keep. Assumed to be in the range [0, n).
+//
+// Returns:
+//   - *persistent_list_node[T]: The copy of the first k nodes.
+//   - *persistent_list_node[T]: The last node of the copy.
+//   - *persistent_list_node[T]: The reversed chain of the remaining nodes.
+//   - *persistent_list_node[T]: The last node of the reversed chain.
+func split_chain[T any](chain *persistent_list_node[T], n, k int) (*persistent_list_node[T], *persistent_list_node[T], *persistent_list_node[T], *persistent_list_node[T]) {
+	values := make([]T, 0, n)
+
+	for node := chain; node != nil; node = node.next {
+		values = append(values, node.value)
+	}
+
+	var kept, kept_bottom *persistent_list_node[T]
+
+	for i := k - 1; i >= 0; i-- {
+		kept = &persistent_list_node[T]{
+			value: values[i],
+			next:  kept,
+		}
+
+		if kept_bottom == nil {
+			kept_bottom = kept
+		}
+	}
+
+	var moved, moved_bottom *persistent_list_node[T]
+
+	for i := k; i < n; i++ {
+		moved = &persistent_list_node[T]{
+			value: values[i],
+			next:  moved,
+		}
+
+		if moved_bottom == nil {
+			moved_bottom = moved
+		}
+	}
+
+	return kept, kept_bottom, moved, moved_bottom
+}
+
+// PersistentList is a generic type that represents an immutable double-ended list
+// data structure whose nodes are shared between versions.
+//
+// The list is made of two singly linked chains: one holding the first elements in
+// order and one holding the last elements in reverse order. Append, Prepend and
+// the Peek methods take O(1) time and share every existing node with the original
+// list. DeleteFirst and DeleteLast take O(1) time while the chain they need is not
+// empty; otherwise, the other one is split in half, which copies its nodes in O(n)
+// time.
+//
+// Only the returned version keeps the split chains. Deleting again from the same
+// version, as when backtracking, splits its chain again; so the O(1) amortized
+// bound only holds when each version is deleted from at most once, and the worst
+// case of a deletion is O(n).
+//
+// None of the methods modify the list they are called on, so it does not
+// implement the Lister interface. The zero value and the nil pointer are both valid
+// empty lists.
+type PersistentList[T any] struct {
+	// front is the chain of the first elements, in order.
+	front *persistent_list_node[T]
+
+	// front_bottom is the last node of the front chain.
+	front_bottom *persistent_list_node[T]
+
+	// front_size is the number of nodes of the front chain.
+	front_size int
+
+	// back is the chain of the last elements, in reverse order.
+	back *persistent_list_node[T]
+
+	// back_bottom is the last node of the back chain.
+	back_bottom *persistent_list_node[T]
+
+	// back_size is the number of nodes of the back chain.
+	back_size int
+}
+
+// NewPersistentList is a function that creates and returns a new instance of a
+// PersistentList.
+//
+// Parameters:
+//   - opts: The options of the list. The only supported option is
+//     WithInitialValues.
+//
+// Returns:
+//   - *PersistentList[T]: A pointer to the newly created PersistentList.
+//   - error: An error if the options are invalid.
+func NewPersistentList[T any](opts ...options.Option) (*PersistentList[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Only("PersistentList", "WithInitialValues")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &PersistentList[T]{
+		front_size: len(values),
+	}
+
+	for i := len(values) - 1; i >= 0; i-- {
+		list.front = &persistent_list_node[T]{
+			value: values[i],
+			next:  list.front,
+		}
+
+		if list.front_bottom == nil {
+			list.front_bottom = list.front
+		}
+	}
+
+	return list, nil
+}
+
+// Append is a method of the PersistentList type. It returns a new version of the
+// list with the value at the end. The list itself is left unchanged.
+//
+// Parameters:
+//   - value: The value to append.
+//
+// Returns:
+//   - *PersistentList[T]: The new version of the list. Never returns nil.
+func (list *PersistentList[T]) Append(value T) *PersistentList[T] {
+	appended := list.clone()
+
+	appended.back = &persistent_list_node[T]{
+		value: value,
+		next:  appended.back,
+	}
+
+	if appended.back_bottom == nil {
+		appended.back_bottom = appended.back
+	}
+
+	appended.back_size++
+
+	return appended
+}
+
+// Prepend is a method of the PersistentList type. It returns a new version of the
+// list with the value at the beginning. The list itself is left unchanged.
+//
+// Parameters:
+//   - value: The value to prepend.
+//
+// Returns:
+//   - *PersistentList[T]: The new version of the list. Never returns nil.
+func (list *PersistentList[T]) Prepend(value T) *PersistentList[T] {
+	prepended := list.clone()
+
+	prepended.front = &persistent_list_node[T]{
+		value: value,
+		next:  prepended.front,
+	}
+
+	if prepended.front_bottom == nil {
+		prepended.front_bottom = prepended.front
+	}
+
+	prepended.front_size++
+
+	return prepended
+}
+
+// DeleteFirst is a method of the PersistentList type. It returns a new version of
+// the list without its first element. The list itself is left unchanged.
+//
+// It takes O(n) time when the front chain is empty, as when every element was
+// appended; see PersistentList.
+//
+// Returns:
+//   - *PersistentList[T]: The new version of the list. The list itself if it is
+//     empty.
+//   - T: The first element of the list.
+//   - bool: True if the list was not empty, false otherwise.
+func (list *PersistentList[T]) DeleteFirst() (*PersistentList[T], T, bool) {
+	if list.IsEmpty() {
+		return list, *new(T), false
+	}
+
+	deleted := list.clone()
+
+	if deleted.front == nil {
+		k := deleted.back_size / 2
+
+		deleted.back, deleted.back_bottom, deleted.front, deleted.front_bottom = split_chain(deleted.back, deleted.back_size, k)
+		deleted.front_size = deleted.back_size - k
+		deleted.back_size = k
+	}
+
+	value := deleted.front.value
+
+	deleted.front = deleted.front.next
+	deleted.front_size--
+
+	if deleted.front == nil {
+		deleted.front_bottom = nil
+	}
+
+	return deleted, value, true
+}
+
+// DeleteLast is a method of the PersistentList type. It returns a new version of
+// the list without its last element. The list itself is left unchanged.
+//
+// It takes O(n) time when the back chain is empty, as when every element was
+// prepended; see PersistentList.
+//
+// Returns:
+//   - *PersistentList[T]: The new version of the list. The list itself if it is
+//     empty.
+//   - T: The last element of the list.
+//   - bool: True if the list was not empty, false otherwise.
+func (list *PersistentList[T]) DeleteLast() (*PersistentList[T], T, bool) {
+	if list.IsEmpty() {
+		return list, *new(T), false
+	}
+
+	deleted := list.clone()
+
+	if deleted.back == nil {
+		k := deleted.front_size / 2
+
+		deleted.front, deleted.front_bottom, deleted.back, deleted.back_bottom = split_chain(deleted.front, deleted.front_size, k)
+		deleted.back_size = deleted.front_size - k
+		deleted.front_size = k
+	}
+
+	value := deleted.back.value
+
+	deleted.back = deleted.back.next
+	deleted.back_size--
+
+	if deleted.back == nil {
+		deleted.back_bottom = nil
+	}
+
+	return deleted, value, true
+}
+
+// PeekFirst is a method of the PersistentList type. It returns the first element
+// of the list.
+//
+// Returns:
+//   - T: The first element of the list.
+//   - bool: True if the list is not empty, false otherwise.
+func (list *PersistentList[T]) PeekFirst() (T, bool) {
+	if list == nil {
+		return *new(T), false
+	}
+
+	if list.front != nil {
+		return list.front.value, true
+	} else if list.back_bottom != nil {
+		return list.back_bottom.value, true
+	}
+
+	return *new(T), false
+}
+
+// PeekLast is a method of the PersistentList type. It returns the last element of
+// the list.
+//
+// Returns:
+//   - T: The last element of the list.
+//   - bool: True if the list is not empty, false otherwise.
+func (list *PersistentList[T]) PeekLast() (T, bool) {
+	if list == nil {
+		return *new(T), false
+	}
+
+	if list.back != nil {
+		return list.back.value, true
+	} else if list.front_bottom != nil {
+		return list.front_bottom.value, true
+	}
+
+	return *new(T), false
+}
+
+// IsEmpty is a method of the PersistentList type. It is used to check if the list
+// is empty.
+//
+// Returns:
+//   - bool: true if the list is empty, and false otherwise.
+func (list *PersistentList[T]) IsEmpty() bool {
+	return list == nil || (list.front == nil && list.back == nil)
+}
+
+// Size is a method of the PersistentList type. It is used to return the number of
+// elements in the list.
+//
+// Returns:
+//   - int: The number of elements in the list.
+func (list *PersistentList[T]) Size() int {
+	if list == nil {
+		return 0
+	}
+
+	return list.front_size + list.back_size
+}
+
+// Iterator is a method of the PersistentList type. It is used to return an
+// iterator over the elements in the list, from the first to the last.
+//
+// Returns:
+//   - itrs.Iterater[T]: An iterator for the elements in the list.
+func (list *PersistentList[T]) Iterator() itrs.Iterater[T] {
+	return itrs.NewSimpleIterator(list.Slice())
+}
+
+// Slice is a method of the PersistentList type. It is used to return a slice of
+// the elements in the list.
+//
+// Returns:
+//   - []T: A slice of the elements in the list, from the first to the last.
+func (list *PersistentList[T]) Slice() []T {
+	if list == nil {
+		return nil
+	}
+
+	slice := make([]T, list.front_size+list.back_size)
+
+	i := 0
+
+	for node := list.front; node != nil; node = node.next {
+		slice[i] = node.value
+		i++
+	}
+
+	i = len(slice) - 1
+
+	for node := list.back; node != nil; node = node.next {
+		slice[i] = node.value
+		i--
+	}
+
+	return slice
+}
+
+// layout returns the description of the list used to format it.
+func (list *PersistentList[T]) layout() display.Container[T] {
+	return display.Container[T]{
+		Pkg:      "list",
+		Name:     "PersistentList",
+		TypeArgs: []string{display.TypeName[T]()},
+		Capacity: -1,
+		Values:   list.Slice(),
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (list *PersistentList[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, list.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (list *PersistentList[T]) String() string {
+	return fmt.Sprint(list)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (list *PersistentList[T]) GoString() string {
+	return list.layout().GoSyntax()
+}
+
+// clone returns a shallow copy of the list header. The nodes are shared.
+//
+// Returns:
+//   - *PersistentList[T]: The copy. Never returns nil.
+func (list *PersistentList[T]) clone() *PersistentList[T] {
+	if list == nil {
+		return &PersistentList[T]{}
+	}
+
+	cloned := *list
+
+	return &cloned
+}
@@ -0,0 +1,123 @@
+package list
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/PlayerR9/listlike/options"
+)
+
+// TestPersistentListVersions checks random operations on random versions of a
+// list against slices; every version must keep its values.
+func TestPersistentListVersions(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	versions := []*PersistentList[int]{nil}
+	models := [][]int{nil}
+
+	for i := 0; i < 5000; i++ {
+		j := rng.Intn(len(versions))
+		list, model := versions[j], models[j]
+
+		var (
+			next  *PersistentList[int]
+			value int
+			ok    bool
+		)
+
+		switch rng.Intn(4) {
+		case 0:
+			next = list.Append(i)
+			model = append(slices.Clip(model), i)
+		case 1:
+			next = list.Prepend(i)
+			model = append([]int{i}, model...)
+		case 2:
+			next, value, ok = list.DeleteFirst()
+
+			if ok != (len(model) > 0) || (ok && value != model[0]) {
+				t.Fatalf("step %d: DeleteFirst of %v gave %d, %t", i, model, value, ok)
+			}
+
+			if ok {
+				model = model[1:]
+			}
+		default:
+			next, value, ok = list.DeleteLast()
+
+			if ok != (len(model) > 0) || (ok && value != model[len(model)-1]) {
+				t.Fatalf("step %d: DeleteLast of %v gave %d, %t", i, model, value, ok)
+			}
+
+			if ok {
+				model = model[:len(model)-1]
+			}
+		}
+
+		versions = append(versions, next)
+		models = append(models, model)
+
+		if i%100 == 0 {
+			for k, v := range versions {
+				if got := v.Slice(); !slices.Equal(got, models[k]) || v.Size() != len(models[k]) {
+					t.Fatalf("step %d: version %d is %v of size %d, want %v", i, k, got, v.Size(), models[k])
+				}
+			}
+		}
+	}
+}
+
+// TestPersistentListPeek checks the Peek methods when the elements are all in one
+// of the two chains.
+func TestPersistentListPeek(t *testing.T) {
+	var appended, prepended *PersistentList[int]
+
+	for i := 1; i <= 3; i++ {
+		appended = appended.Append(i)
+		prepended = prepended.Prepend(i)
+	}
+
+	tests := []struct {
+		name        string
+		list        *PersistentList[int]
+		first, last int
+	}{
+		{"appended", appended, 1, 3},
+		{"prepended", prepended, 3, 1},
+	}
+
+	for _, tt := range tests {
+		first, ok1 := tt.list.PeekFirst()
+		last, ok2 := tt.list.PeekLast()
+
+		if !ok1 || !ok2 || first != tt.first || last != tt.last {
+			t.Errorf("%s: got %d and %d, want %d and %d", tt.name, first, last, tt.first, tt.last)
+		}
+	}
+
+	var empty *PersistentList[int]
+
+	if _, ok := empty.PeekFirst(); ok || !empty.IsEmpty() || empty.Size() != 0 {
+		t.Fatal("the nil list is not empty")
+	}
+
+	if list, _, ok := empty.DeleteLast(); ok || list != empty {
+		t.Fatal("DeleteLast on the nil list succeeded")
+	}
+}
+
+// TestPersistentListInitialValues checks that the initial values keep their order.
+func TestPersistentListInitialValues(t *testing.T) {
+	list, err := NewPersistentList[int](options.WithInitialValues[int](1, 2, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list, last, _ := list.DeleteLast()
+	list = list.Prepend(0)
+
+	if got := list.Slice(); last != 3 || !slices.Equal(got, []int{0, 1, 2}) {
+		t.Fatalf("got %v after deleting %d, want [0 1 2] after deleting 3", got, last)
+	}
+}
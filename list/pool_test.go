@@ -0,0 +1,101 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/PlayerR9/listlike/options"
+)
+
+// TestNodePoolZeroes checks that a recycled node holds neither its value nor a link
+// into the list, so a pooled node does not keep a value alive.
+func TestNodePoolZeroes(t *testing.T) {
+	value := new(int)
+
+	list, err := NewLinkedList[*int](options.WithNodePool(8))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list.Append(value)
+	list.Append(new(int))
+
+	if got, _ := list.DeleteFirst(); got != value {
+		t.Fatal("DeleteFirst did not return the appended value")
+	}
+
+	node := list.pool.free
+	if node == nil {
+		t.Fatal("the deleted node was not pooled")
+	}
+
+	if node.Value != nil {
+		t.Error("the pooled node still holds its value")
+	}
+
+	if node.prev != nil {
+		t.Error("the pooled node still links to the list")
+	}
+
+	reused := list.pool.get(nil)
+	if reused != node || reused.prev != nil || reused.next != nil {
+		t.Error("get did not reuse the pooled node with cleared links")
+	}
+}
+
+// TestNodePoolNoAllocs checks that appending and deleting on a warmed up list does
+// not allocate.
+func TestNodePoolNoAllocs(t *testing.T) {
+	list, err := NewLinkedList[int](options.WithNodePool(8))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		run  func()
+	}{
+		{"AppendDeleteFirst", func() { list.Append(1); list.DeleteFirst() }},
+		{"PrependDeleteLast", func() { list.Prepend(1); list.DeleteLast() }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Warm up the pool.
+			tt.run()
+
+			if allocs := testing.AllocsPerRun(100, tt.run); allocs != 0 {
+				t.Errorf("got %v allocations per operation pair, want 0", allocs)
+			}
+		})
+	}
+}
+
+// BenchmarkNodePool measures an append and a deletion on a list with and without a
+// node pool.
+func BenchmarkNodePool(b *testing.B) {
+	for _, size := range []int{0, 8} {
+		name := "NoPool"
+		if size > 0 {
+			name = "Pool"
+		}
+
+		b.Run(name, func(b *testing.B) {
+			var opts []options.Option
+			if size > 0 {
+				opts = append(opts, options.WithNodePool(size))
+			}
+
+			list, err := NewLinkedList[int](opts...)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				list.Append(i)
+				list.DeleteFirst()
+			}
+		})
+	}
+}
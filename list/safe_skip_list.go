@@ -0,0 +1,293 @@
+package list
+
+import (
+	"cmp"
+	"fmt"
+	"sync"
+
+	"github.com/PlayerR9/listlike/display"
+
+	gcers "github.com/PlayerR9/go-commons/errors"
+	itrs "github.com/PlayerR9/iterators/simple"
+)
+
+// SafeSkipList is a generic type that represents a thread-safe ordered map
+// implemented using a skip list. See SkipList for details.
+//
+// Like SkipList, it implements the Lister interface on its entries, in ascending
+// key order.
+type SafeSkipList[K, V any] struct {
+	// list is the underlying skip list.
+	list *SkipList[K, V]
+
+	// mu is the mutex that guards the list.
+	mu sync.RWMutex
+}
+
+// NewSafeSkipList is a function that creates and returns a new instance of a
+// SafeSkipList whose keys are in their natural order.
+//
+// Returns:
+//   - *SafeSkipList[K, V]: A pointer to the newly created SafeSkipList. Never
+//     returns nil.
+func NewSafeSkipList[K cmp.Ordered, V any]() *SafeSkipList[K, V] {
+	return &SafeSkipList[K, V]{
+		list: NewSkipList[K, V](),
+	}
+}
+
+// NewSafeSkipListFunc is a function that creates and returns a new instance of a
+// SafeSkipList whose keys are ordered by the given comparison function.
+//
+// Parameters:
+//   - cmp: The comparison function. It returns a negative number when a < b, a
+//     positive number when a > b and zero when a == b.
+//
+// Returns:
+//   - *SafeSkipList[K, V]: A pointer to the newly created SafeSkipList.
+//   - error: An error of type *errors.ErrInvalidParameter if cmp is nil.
+func NewSafeSkipListFunc[K, V any](cmp func(a, b K) int) (*SafeSkipList[K, V], error) {
+	if cmp == nil {
+		return nil, gcers.NewErrNilParameter("cmp")
+	}
+
+	return &SafeSkipList[K, V]{
+		list: new_skip_list[K, V](cmp),
+	}, nil
+}
+
+// Insert is a method of the SafeSkipList type. See SkipList.Insert.
+func (sl *SafeSkipList[K, V]) Insert(key K, value V) bool {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.Insert(key, value)
+}
+
+// Get is a method of the SafeSkipList type. See SkipList.Get.
+func (sl *SafeSkipList[K, V]) Get(key K) (V, bool) {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.Get(key)
+}
+
+// Contains is a method of the SafeSkipList type. See SkipList.Contains.
+func (sl *SafeSkipList[K, V]) Contains(key K) bool {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.Contains(key)
+}
+
+// Delete is a method of the SafeSkipList type. See SkipList.Delete.
+func (sl *SafeSkipList[K, V]) Delete(key K) (V, bool) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.Delete(key)
+}
+
+// Floor is a method of the SafeSkipList type. See SkipList.Floor.
+func (sl *SafeSkipList[K, V]) Floor(key K) (SkipListEntry[K, V], bool) {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.Floor(key)
+}
+
+// Ceiling is a method of the SafeSkipList type. See SkipList.Ceiling.
+func (sl *SafeSkipList[K, V]) Ceiling(key K) (SkipListEntry[K, V], bool) {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.Ceiling(key)
+}
+
+// Rank is a method of the SafeSkipList type. See SkipList.Rank.
+func (sl *SafeSkipList[K, V]) Rank(key K) (int, bool) {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.Rank(key)
+}
+
+// At is a method of the SafeSkipList type. See SkipList.At.
+func (sl *SafeSkipList[K, V]) At(index int) (SkipListEntry[K, V], bool) {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.At(index)
+}
+
+// Range is a method of the SafeSkipList type. See SkipList.Range.
+//
+// The iterator works on a copy of the entries, so it is not affected by later
+// modifications of the list.
+func (sl *SafeSkipList[K, V]) Range(from, to K) itrs.Iterater[SkipListEntry[K, V]] {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.Range(from, to)
+}
+
+// Iterator is a method of the SafeSkipList type. See SkipList.Iterator.
+//
+// The iterator works on a copy of the entries, so it is not affected by later
+// modifications of the list.
+func (sl *SafeSkipList[K, V]) Iterator() itrs.Iterater[SkipListEntry[K, V]] {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.Iterator()
+}
+
+// Keys is a method of the SafeSkipList type. See SkipList.Keys.
+func (sl *SafeSkipList[K, V]) Keys() []K {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.Keys()
+}
+
+// Append implements the Lister interface.
+//
+// The entry is inserted at its sorted position. Always returns true.
+func (sl *SafeSkipList[K, V]) Append(entry SkipListEntry[K, V]) bool {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.Append(entry)
+}
+
+// Prepend implements the Lister interface.
+//
+// The entry is inserted at its sorted position. Always returns true.
+func (sl *SafeSkipList[K, V]) Prepend(entry SkipListEntry[K, V]) bool {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.Prepend(entry)
+}
+
+// DeleteFirst implements the Lister interface.
+func (sl *SafeSkipList[K, V]) DeleteFirst() (SkipListEntry[K, V], bool) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.DeleteFirst()
+}
+
+// DeleteLast implements the Lister interface.
+func (sl *SafeSkipList[K, V]) DeleteLast() (SkipListEntry[K, V], bool) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.DeleteLast()
+}
+
+// PeekFirst implements the Lister interface.
+func (sl *SafeSkipList[K, V]) PeekFirst() (SkipListEntry[K, V], bool) {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.PeekFirst()
+}
+
+// PeekLast implements the Lister interface.
+func (sl *SafeSkipList[K, V]) PeekLast() (SkipListEntry[K, V], bool) {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.PeekLast()
+}
+
+// IsEmpty implements the Lister interface.
+func (sl *SafeSkipList[K, V]) IsEmpty() bool {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.IsEmpty()
+}
+
+// Size implements the Lister interface.
+func (sl *SafeSkipList[K, V]) Size() int {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.Size()
+}
+
+// Clear implements the Lister interface.
+func (sl *SafeSkipList[K, V]) Clear() {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	sl.list.Clear()
+}
+
+// Capacity implements the Lister interface.
+//
+// Always returns -1.
+func (sl *SafeSkipList[K, V]) Capacity() int {
+	return -1
+}
+
+// IsFull implements the Lister interface.
+//
+// Always returns false.
+func (sl *SafeSkipList[K, V]) IsFull() bool {
+	return false
+}
+
+// Slice implements the Lister interface.
+func (sl *SafeSkipList[K, V]) Slice() []SkipListEntry[K, V] {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.Slice()
+}
+
+// layout returns the description of the list used to format it.
+func (sl *SafeSkipList[K, V]) layout() display.Container[SkipListEntry[K, V]] {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	c := sl.list.layout()
+
+	c.Name = "SafeSkipList"
+
+	return c
+}
+
+// Format implements the fmt.Formatter interface.
+//
+// The %#v verb cannot rebuild a list created with NewSafeSkipListFunc, as its
+// comparison function has no Go syntax; it gives the detailed form instead.
+func (sl *SafeSkipList[K, V]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, sl.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (sl *SafeSkipList[K, V]) String() string {
+	return fmt.Sprint(sl)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (sl *SafeSkipList[K, V]) GoString() string {
+	return sl.layout().GoSyntax()
+}
+
+// Copy is a method of the SafeSkipList type. It is used to create a shallow copy
+// of the list.
+//
+// Returns:
+//   - *SafeSkipList[K, V]: A copy of the list.
+func (sl *SafeSkipList[K, V]) Copy() *SafeSkipList[K, V] {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return &SafeSkipList[K, V]{
+		list: sl.list.Copy(),
+	}
+}
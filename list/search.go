@@ -0,0 +1,370 @@
+package list
+
+// walker is implemented by the lists that can be searched and pruned in place,
+// without copying their elements.
+type walker[T any] interface {
+	// forEach calls f on every element of the list, from the front to the back,
+	// until f returns false.
+	//
+	// Parameters:
+	//   - f: The function to call with the index and the value of each element.
+	forEach(f func(idx int, value T) bool)
+
+	// forEachReverse calls f on every element of the list, from the back to the
+	// front, until f returns false.
+	//
+	// Parameters:
+	//   - f: The function to call with the index and the value of each element.
+	forEachReverse(f func(idx int, value T) bool)
+
+	// removeFunc removes, from the front to the back, the elements that satisfy
+	// the predicate. The predicate is called exactly once per visited element.
+	//
+	// Parameters:
+	//   - pred: The predicate that selects the elements to remove.
+	//   - all: Whether to remove every selected element or only the first one.
+	//
+	// Returns:
+	//   - int: The number of elements removed.
+	removeFunc(pred func(value T) bool, all bool) int
+}
+
+// lister_walker is the walker used for the Lister implementations that are not
+// part of this package. It relies on the methods of the Lister interface.
+type lister_walker[T any] struct {
+	// list is the underlying list.
+	list Lister[T]
+}
+
+// forEach implements the walker interface.
+func (w lister_walker[T]) forEach(f func(idx int, value T) bool) {
+	for i, value := range w.list.Slice() {
+		if !f(i, value) {
+			return
+		}
+	}
+}
+
+// forEachReverse implements the walker interface.
+func (w lister_walker[T]) forEachReverse(f func(idx int, value T) bool) {
+	values := w.list.Slice()
+
+	for i := len(values) - 1; i >= 0; i-- {
+		if !f(i, values[i]) {
+			return
+		}
+	}
+}
+
+// removeFunc implements the walker interface.
+//
+// The list is emptied and the kept elements are appended back in order.
+func (w lister_walker[T]) removeFunc(pred func(value T) bool, all bool) int {
+	values := w.list.Slice()
+	w.list.Clear()
+
+	var count int
+
+	for _, value := range values {
+		if (all || count == 0) && pred(value) {
+			count++
+		} else {
+			w.list.Append(value)
+		}
+	}
+
+	return count
+}
+
+// walker_of returns the walker of the given list.
+//
+// Parameters:
+//   - list: The list to walk.
+//
+// Returns:
+//   - walker[T]: The walker of the list.
+func walker_of[T any](list Lister[T]) walker[T] {
+	w, ok := list.(walker[T])
+	if ok {
+		return w
+	}
+
+	return lister_walker[T]{
+		list: list,
+	}
+}
+
+// equals is the equality function of comparable types.
+func equals[T comparable](a, b T) bool {
+	return a == b
+}
+
+// Contains is a function that checks whether the list holds the given value.
+//
+// Parameters:
+//   - list: The list to search.
+//   - value: The value to search for.
+//
+// Returns:
+//   - bool: True if the value is in the list, false otherwise.
+func Contains[T comparable](list Lister[T], value T) bool {
+	return IndexOfFunc(list, value, equals[T]) != -1
+}
+
+// ContainsFunc is like Contains but uses the given equality function.
+//
+// Parameters:
+//   - list: The list to search.
+//   - value: The value to search for.
+//   - equal: The function that checks whether two values are equal.
+//
+// Returns:
+//   - bool: True if the value is in the list, false otherwise.
+func ContainsFunc[T any](list Lister[T], value T, equal func(a, b T) bool) bool {
+	return IndexOfFunc(list, value, equal) != -1
+}
+
+// IndexOf is a function that returns the index of the first occurrence of the value
+// in the list, starting from the front.
+//
+// Parameters:
+//   - list: The list to search.
+//   - value: The value to search for.
+//
+// Returns:
+//   - int: The index of the value. -1 if the value is not in the list.
+func IndexOf[T comparable](list Lister[T], value T) int {
+	return IndexOfFunc(list, value, equals[T])
+}
+
+// IndexOfFunc is like IndexOf but uses the given equality function.
+//
+// Parameters:
+//   - list: The list to search.
+//   - value: The value to search for.
+//   - equal: The function that checks whether two values are equal.
+//
+// Returns:
+//   - int: The index of the value. -1 if the value is not in the list.
+func IndexOfFunc[T any](list Lister[T], value T, equal func(a, b T) bool) int {
+	if list == nil || equal == nil {
+		return -1
+	}
+
+	idx := -1
+
+	walker_of(list).forEach(func(i int, elem T) bool {
+		if !equal(elem, value) {
+			return true
+		}
+
+		idx = i
+
+		return false
+	})
+
+	return idx
+}
+
+// LastIndexOf is a function that returns the index of the last occurrence of the
+// value in the list.
+//
+// Parameters:
+//   - list: The list to search.
+//   - value: The value to search for.
+//
+// Returns:
+//   - int: The index of the value. -1 if the value is not in the list.
+func LastIndexOf[T comparable](list Lister[T], value T) int {
+	return LastIndexOfFunc(list, value, equals[T])
+}
+
+// LastIndexOfFunc is like LastIndexOf but uses the given equality function.
+//
+// Parameters:
+//   - list: The list to search.
+//   - value: The value to search for.
+//   - equal: The function that checks whether two values are equal.
+//
+// Returns:
+//   - int: The index of the value. -1 if the value is not in the list.
+func LastIndexOfFunc[T any](list Lister[T], value T, equal func(a, b T) bool) int {
+	if list == nil || equal == nil {
+		return -1
+	}
+
+	idx := -1
+
+	walker_of(list).forEachReverse(func(i int, elem T) bool {
+		if !equal(elem, value) {
+			return true
+		}
+
+		idx = i
+
+		return false
+	})
+
+	return idx
+}
+
+// Count is a function that returns the number of occurrences of the value in the
+// list.
+//
+// Parameters:
+//   - list: The list to search.
+//   - value: The value to count.
+//
+// Returns:
+//   - int: The number of occurrences of the value.
+func Count[T comparable](list Lister[T], value T) int {
+	return CountFunc(list, value, equals[T])
+}
+
+// CountFunc is like Count but uses the given equality function.
+//
+// Parameters:
+//   - list: The list to search.
+//   - value: The value to count.
+//   - equal: The function that checks whether two values are equal.
+//
+// Returns:
+//   - int: The number of occurrences of the value.
+func CountFunc[T any](list Lister[T], value T, equal func(a, b T) bool) int {
+	if list == nil || equal == nil {
+		return 0
+	}
+
+	var count int
+
+	walker_of(list).forEach(func(_ int, elem T) bool {
+		if equal(elem, value) {
+			count++
+		}
+
+		return true
+	})
+
+	return count
+}
+
+// RemoveFirst is a function that removes the first occurrence of the value from
+// the list.
+//
+// Parameters:
+//   - list: The list to modify.
+//   - value: The value to remove.
+//
+// Returns:
+//   - bool: True if the value was removed, false if it was not in the list.
+func RemoveFirst[T comparable](list Lister[T], value T) bool {
+	return RemoveFirstFunc(list, value, equals[T])
+}
+
+// RemoveFirstFunc is like RemoveFirst but uses the given equality function.
+//
+// Parameters:
+//   - list: The list to modify.
+//   - value: The value to remove.
+//   - equal: The function that checks whether two values are equal.
+//
+// Returns:
+//   - bool: True if the value was removed, false if it was not in the list.
+func RemoveFirstFunc[T any](list Lister[T], value T, equal func(a, b T) bool) bool {
+	if list == nil || equal == nil {
+		return false
+	}
+
+	count := walker_of(list).removeFunc(func(elem T) bool {
+		return equal(elem, value)
+	}, false)
+
+	return count > 0
+}
+
+// RemoveAll is a function that removes every occurrence of the value from the list.
+//
+// Parameters:
+//   - list: The list to modify.
+//   - value: The value to remove.
+//
+// Returns:
+//   - int: The number of elements removed.
+func RemoveAll[T comparable](list Lister[T], value T) int {
+	return RemoveAllFunc(list, value, equals[T])
+}
+
+// RemoveAllFunc is like RemoveAll but uses the given equality function.
+//
+// Parameters:
+//   - list: The list to modify.
+//   - value: The value to remove.
+//   - equal: The function that checks whether two values are equal.
+//
+// Returns:
+//   - int: The number of elements removed.
+func RemoveAllFunc[T any](list Lister[T], value T, equal func(a, b T) bool) int {
+	if list == nil || equal == nil {
+		return 0
+	}
+
+	return walker_of(list).removeFunc(func(elem T) bool {
+		return equal(elem, value)
+	}, true)
+}
+
+// Dedupe is a function that removes the duplicates from the list. Only the first
+// occurrence of each value is kept, so the relative order of the list is preserved.
+//
+// Parameters:
+//   - list: The list to modify.
+//
+// Returns:
+//   - int: The number of elements removed.
+func Dedupe[T comparable](list Lister[T]) int {
+	if list == nil {
+		return 0
+	}
+
+	seen := make(map[T]struct{})
+
+	return walker_of(list).removeFunc(func(elem T) bool {
+		_, ok := seen[elem]
+		if ok {
+			return true
+		}
+
+		seen[elem] = struct{}{}
+
+		return false
+	}, true)
+}
+
+// DedupeFunc is like Dedupe but uses the given equality function. Because values
+// cannot be hashed, this takes quadratic time.
+//
+// Parameters:
+//   - list: The list to modify.
+//   - equal: The function that checks whether two values are equal.
+//
+// Returns:
+//   - int: The number of elements removed.
+func DedupeFunc[T any](list Lister[T], equal func(a, b T) bool) int {
+	if list == nil || equal == nil {
+		return 0
+	}
+
+	var kept []T
+
+	return walker_of(list).removeFunc(func(elem T) bool {
+		for _, k := range kept {
+			if equal(k, elem) {
+				return true
+			}
+		}
+
+		kept = append(kept, elem)
+
+		return false
+	}, true)
+}
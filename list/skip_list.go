@@ -0,0 +1,672 @@
+package list
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/PlayerR9/listlike/display"
+
+	gcers "github.com/PlayerR9/go-commons/errors"
+	itrs "github.com/PlayerR9/iterators/simple"
+)
+
+const (
+	// skip_list_max_level is the maximum number of levels of a skip list.
+	skip_list_max_level int = 32
+)
+
+// SkipListEntry is a key-value pair stored in a skip list.
+type SkipListEntry[K, V any] struct {
+	// Key is the key of the entry.
+	Key K
+
+	// Value is the value of the entry.
+	Value V
+}
+
+// Format implements the fmt.Formatter interface.
+//
+// The %#v verb gives the Go syntax of the entry; any other verb gives the key and
+// the value formatted with it, separated by ": ".
+func (entry SkipListEntry[K, V]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprintf(f, "list.SkipListEntry[%s, %s]{Key: %#v, Value: %#v}", display.TypeName[K](), display.TypeName[V](), entry.Key, entry.Value)
+		return
+	}
+
+	format := fmt.FormatString(f, verb)
+
+	fmt.Fprintf(f, format+": "+format, entry.Key, entry.Value)
+}
+
+// skip_node is a node of a skip list.
+type skip_node[K, V any] struct {
+	// entry is the key-value pair stored in the node.
+	entry SkipListEntry[K, V]
+
+	// next holds the next node of each level of the node.
+	next []*skip_node[K, V]
+
+	// span holds, for each level, the number of bottom-level steps between the
+	// node and its next node on that level.
+	span []int
+
+	// prev is the previous node on the bottom level. Nil for the first node.
+	prev *skip_node[K, V]
+}
+
+// SkipList is a generic type that represents an ordered map implemented using a skip
+// list. Insertions, deletions, lookups and rank queries take O(log n) time on
+// average.
+//
+// A SkipList also implements the Lister interface on its entries, in ascending key
+// order. Because of this, Append and Prepend insert the entry at its sorted
+// position.
+type SkipList[K, V any] struct {
+	// head is the sentinel node that precedes the first node on every level.
+	head *skip_node[K, V]
+
+	// tail is the last node on the bottom level. Nil if the list is empty.
+	tail *skip_node[K, V]
+
+	// level is the number of levels currently in use.
+	level int
+
+	// size is the current number of entries in the list.
+	size int
+
+	// cmp is the function used to order the keys.
+	cmp func(a, b K) int
+
+	// ordered tells whether cmp is cmp.Compare, as given by NewSkipList.
+	ordered bool
+}
+
+// NewSkipList is a function that creates and returns a new instance of a SkipList
+// whose keys are in their natural order.
+//
+// Returns:
+//   - *SkipList[K, V]: A pointer to the newly created SkipList. Never returns nil.
+func NewSkipList[K cmp.Ordered, V any]() *SkipList[K, V] {
+	sl := new_skip_list[K, V](cmp.Compare[K])
+	sl.ordered = true
+
+	return sl
+}
+
+// NewSkipListFunc is a function that creates and returns a new instance of a
+// SkipList whose keys are ordered by the given comparison function.
+//
+// Parameters:
+//   - cmp: The comparison function. It returns a negative number when a < b, a
+//     positive number when a > b and zero when a == b.
+//
+// Returns:
+//   - *SkipList[K, V]: A pointer to the newly created SkipList.
+//   - error: An error of type *errors.ErrInvalidParameter if cmp is nil.
+func NewSkipListFunc[K, V any](cmp func(a, b K) int) (*SkipList[K, V], error) {
+	if cmp == nil {
+		return nil, gcers.NewErrNilParameter("cmp")
+	}
+
+	return new_skip_list[K, V](cmp), nil
+}
+
+// new_skip_list creates an empty skip list with the given comparison function.
+//
+// Parameters:
+//   - cmp: The comparison function. Assumed to be non-nil.
+//
+// Returns:
+//   - *SkipList[K, V]: The new skip list. Never returns nil.
+func new_skip_list[K, V any](cmp func(a, b K) int) *SkipList[K, V] {
+	return &SkipList[K, V]{
+		head: &skip_node[K, V]{
+			next: make([]*skip_node[K, V], skip_list_max_level),
+			span: make([]int, skip_list_max_level),
+		},
+		level: 1,
+		cmp:   cmp,
+	}
+}
+
+// random_level returns a random level for a new node. Each level is reached with a
+// probability of 1/4.
+//
+// Returns:
+//   - int: The level, in the range [1, skip_list_max_level].
+func random_level() int {
+	level := 1
+
+	for level < skip_list_max_level && rand.IntN(4) == 0 {
+		level++
+	}
+
+	return level
+}
+
+// Insert is a method of the SkipList type. It associates the value with the key,
+// replacing the previous value if the key is already in the list.
+//
+// Parameters:
+//   - key: The key of the entry.
+//   - value: The value of the entry.
+//
+// Returns:
+//   - bool: True if the key is new, false if its value was replaced.
+func (sl *SkipList[K, V]) Insert(key K, value V) bool {
+	var (
+		update [skip_list_max_level]*skip_node[K, V]
+		rank   [skip_list_max_level]int
+	)
+
+	x := sl.head
+
+	for i := sl.level - 1; i >= 0; i-- {
+		if i < sl.level-1 {
+			rank[i] = rank[i+1]
+		}
+
+		for x.next[i] != nil && sl.cmp(x.next[i].entry.Key, key) < 0 {
+			rank[i] += x.span[i]
+			x = x.next[i]
+		}
+
+		update[i] = x
+	}
+
+	if n := x.next[0]; n != nil && sl.cmp(n.entry.Key, key) == 0 {
+		n.entry.Value = value
+
+		return false
+	}
+
+	level := random_level()
+
+	if level > sl.level {
+		for i := sl.level; i < level; i++ {
+			rank[i] = 0
+			update[i] = sl.head
+			update[i].span[i] = sl.size
+		}
+
+		sl.level = level
+	}
+
+	node := &skip_node[K, V]{
+		entry: SkipListEntry[K, V]{
+			Key:   key,
+			Value: value,
+		},
+		next: make([]*skip_node[K, V], level),
+		span: make([]int, level),
+	}
+
+	for i := 0; i < level; i++ {
+		node.next[i] = update[i].next[i]
+		update[i].next[i] = node
+
+		node.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+
+	for i := level; i < sl.level; i++ {
+		update[i].span[i]++
+	}
+
+	if update[0] != sl.head {
+		node.prev = update[0]
+	}
+
+	if node.next[0] == nil {
+		sl.tail = node
+	} else {
+		node.next[0].prev = node
+	}
+
+	sl.size++
+
+	return true
+}
+
+// Get is a method of the SkipList type. It returns the value associated with the
+// key.
+//
+// Parameters:
+//   - key: The key to search for.
+//
+// Returns:
+//   - V: The value associated with the key. The zero value if the key is not found.
+//   - bool: True if the key was found, false otherwise.
+func (sl *SkipList[K, V]) Get(key K) (V, bool) {
+	node := sl.ceiling(key)
+	if node == nil || sl.cmp(node.entry.Key, key) != 0 {
+		return *new(V), false
+	}
+
+	return node.entry.Value, true
+}
+
+// Contains is a method of the SkipList type. It checks whether the key is in the
+// list.
+//
+// Parameters:
+//   - key: The key to search for.
+//
+// Returns:
+//   - bool: True if the key was found, false otherwise.
+func (sl *SkipList[K, V]) Contains(key K) bool {
+	node := sl.ceiling(key)
+
+	return node != nil && sl.cmp(node.entry.Key, key) == 0
+}
+
+// Delete is a method of the SkipList type. It removes the entry with the given key.
+//
+// Parameters:
+//   - key: The key of the entry to remove.
+//
+// Returns:
+//   - V: The value of the removed entry. The zero value if the key is not found.
+//   - bool: True if the entry was removed, false if the key is not found.
+func (sl *SkipList[K, V]) Delete(key K) (V, bool) {
+	var update [skip_list_max_level]*skip_node[K, V]
+
+	x := sl.head
+
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && sl.cmp(x.next[i].entry.Key, key) < 0 {
+			x = x.next[i]
+		}
+
+		update[i] = x
+	}
+
+	x = x.next[0]
+	if x == nil || sl.cmp(x.entry.Key, key) != 0 {
+		return *new(V), false
+	}
+
+	sl.unlink(x, update[:sl.level])
+
+	return x.entry.Value, true
+}
+
+// unlink removes the node from the list.
+//
+// Parameters:
+//   - node: The node to remove.
+//   - update: The rightmost node before node on each level in use.
+func (sl *SkipList[K, V]) unlink(node *skip_node[K, V], update []*skip_node[K, V]) {
+	for i, u := range update {
+		if u.next[i] == node {
+			u.span[i] += node.span[i] - 1
+			u.next[i] = node.next[i]
+		} else {
+			u.span[i]--
+		}
+	}
+
+	if node.next[0] == nil {
+		sl.tail = node.prev
+	} else {
+		node.next[0].prev = node.prev
+	}
+
+	for sl.level > 1 && sl.head.next[sl.level-1] == nil {
+		sl.level--
+	}
+
+	sl.size--
+
+	clear(node.next)
+	node.prev = nil
+}
+
+// ceiling returns the first node whose key is greater than or equal to the key.
+//
+// Parameters:
+//   - key: The key to search for.
+//
+// Returns:
+//   - *skip_node[K, V]: The node. Nil if there is none.
+func (sl *SkipList[K, V]) ceiling(key K) *skip_node[K, V] {
+	x := sl.head
+
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && sl.cmp(x.next[i].entry.Key, key) < 0 {
+			x = x.next[i]
+		}
+	}
+
+	return x.next[0]
+}
+
+// Floor is a method of the SkipList type. It returns the entry with the greatest
+// key that is less than or equal to the given key.
+//
+// Parameters:
+//   - key: The key to search for.
+//
+// Returns:
+//   - SkipListEntry[K, V]: The entry. The zero value if there is none.
+//   - bool: True if such an entry exists, false otherwise.
+func (sl *SkipList[K, V]) Floor(key K) (SkipListEntry[K, V], bool) {
+	x := sl.head
+
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && sl.cmp(x.next[i].entry.Key, key) <= 0 {
+			x = x.next[i]
+		}
+	}
+
+	if x == sl.head {
+		return SkipListEntry[K, V]{}, false
+	}
+
+	return x.entry, true
+}
+
+// Ceiling is a method of the SkipList type. It returns the entry with the smallest
+// key that is greater than or equal to the given key.
+//
+// Parameters:
+//   - key: The key to search for.
+//
+// Returns:
+//   - SkipListEntry[K, V]: The entry. The zero value if there is none.
+//   - bool: True if such an entry exists, false otherwise.
+func (sl *SkipList[K, V]) Ceiling(key K) (SkipListEntry[K, V], bool) {
+	node := sl.ceiling(key)
+	if node == nil {
+		return SkipListEntry[K, V]{}, false
+	}
+
+	return node.entry, true
+}
+
+// Rank is a method of the SkipList type. It returns the number of keys that are
+// less than the given key; that is, the index the key has, or would have, in the
+// list.
+//
+// Parameters:
+//   - key: The key to search for.
+//
+// Returns:
+//   - int: The rank of the key.
+//   - bool: True if the key is in the list, false otherwise.
+func (sl *SkipList[K, V]) Rank(key K) (int, bool) {
+	var rank int
+
+	x := sl.head
+
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && sl.cmp(x.next[i].entry.Key, key) < 0 {
+			rank += x.span[i]
+			x = x.next[i]
+		}
+	}
+
+	found := x.next[0] != nil && sl.cmp(x.next[0].entry.Key, key) == 0
+
+	return rank, found
+}
+
+// At is a method of the SkipList type. It returns the entry at the given index, in
+// ascending key order.
+//
+// Parameters:
+//   - index: The index of the entry.
+//
+// Returns:
+//   - SkipListEntry[K, V]: The entry. The zero value if the index is out of bounds.
+//   - bool: True if the index is in the range [0, sl.Size()), false otherwise.
+func (sl *SkipList[K, V]) At(index int) (SkipListEntry[K, V], bool) {
+	if index < 0 || index >= sl.size {
+		return SkipListEntry[K, V]{}, false
+	}
+
+	target := index + 1
+
+	var traversed int
+
+	x := sl.head
+
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && traversed+x.span[i] <= target {
+			traversed += x.span[i]
+			x = x.next[i]
+		}
+
+		if traversed == target {
+			break
+		}
+	}
+
+	return x.entry, true
+}
+
+// Range is a method of the SkipList type. It returns an iterator over the entries
+// whose keys are in the range [from, to), in ascending key order.
+//
+// Parameters:
+//   - from: The inclusive lower bound of the range.
+//   - to: The exclusive upper bound of the range.
+//
+// Returns:
+//   - itrs.Iterater[SkipListEntry[K, V]]: An iterator over the entries.
+func (sl *SkipList[K, V]) Range(from, to K) itrs.Iterater[SkipListEntry[K, V]] {
+	var builder itrs.Builder[SkipListEntry[K, V]]
+
+	for node := sl.ceiling(from); node != nil && sl.cmp(node.entry.Key, to) < 0; node = node.next[0] {
+		builder.Add(node.entry)
+	}
+
+	return builder.Build()
+}
+
+// Iterator is a method of the SkipList type. It returns an iterator over all the
+// entries, in ascending key order.
+//
+// Returns:
+//   - itrs.Iterater[SkipListEntry[K, V]]: An iterator over the entries.
+func (sl *SkipList[K, V]) Iterator() itrs.Iterater[SkipListEntry[K, V]] {
+	return itrs.NewSimpleIterator(sl.Slice())
+}
+
+// Keys is a method of the SkipList type. It returns the keys of the list, in
+// ascending order.
+//
+// Returns:
+//   - []K: The keys of the list.
+func (sl *SkipList[K, V]) Keys() []K {
+	keys := make([]K, 0, sl.size)
+
+	for node := sl.head.next[0]; node != nil; node = node.next[0] {
+		keys = append(keys, node.entry.Key)
+	}
+
+	return keys
+}
+
+// Append implements the Lister interface.
+//
+// The entry is inserted at its sorted position, replacing the value of an existing
+// entry with the same key. Always returns true.
+func (sl *SkipList[K, V]) Append(entry SkipListEntry[K, V]) bool {
+	sl.Insert(entry.Key, entry.Value)
+
+	return true
+}
+
+// Prepend implements the Lister interface.
+//
+// The entry is inserted at its sorted position, replacing the value of an existing
+// entry with the same key. Always returns true.
+func (sl *SkipList[K, V]) Prepend(entry SkipListEntry[K, V]) bool {
+	sl.Insert(entry.Key, entry.Value)
+
+	return true
+}
+
+// DeleteFirst implements the Lister interface.
+//
+// The entry with the smallest key is removed.
+func (sl *SkipList[K, V]) DeleteFirst() (SkipListEntry[K, V], bool) {
+	node := sl.head.next[0]
+	if node == nil {
+		return SkipListEntry[K, V]{}, false
+	}
+
+	update := make([]*skip_node[K, V], sl.level)
+	for i := range update {
+		update[i] = sl.head
+	}
+
+	sl.unlink(node, update)
+
+	return node.entry, true
+}
+
+// DeleteLast implements the Lister interface.
+//
+// The entry with the greatest key is removed.
+func (sl *SkipList[K, V]) DeleteLast() (SkipListEntry[K, V], bool) {
+	if sl.tail == nil {
+		return SkipListEntry[K, V]{}, false
+	}
+
+	entry := sl.tail.entry
+
+	sl.Delete(entry.Key)
+
+	return entry, true
+}
+
+// PeekFirst implements the Lister interface.
+//
+// The entry with the smallest key is returned.
+func (sl *SkipList[K, V]) PeekFirst() (SkipListEntry[K, V], bool) {
+	node := sl.head.next[0]
+	if node == nil {
+		return SkipListEntry[K, V]{}, false
+	}
+
+	return node.entry, true
+}
+
+// PeekLast implements the Lister interface.
+//
+// The entry with the greatest key is returned.
+func (sl *SkipList[K, V]) PeekLast() (SkipListEntry[K, V], bool) {
+	if sl.tail == nil {
+		return SkipListEntry[K, V]{}, false
+	}
+
+	return sl.tail.entry, true
+}
+
+// IsEmpty implements the Lister interface.
+func (sl *SkipList[K, V]) IsEmpty() bool {
+	return sl.size == 0
+}
+
+// Size implements the Lister interface.
+func (sl *SkipList[K, V]) Size() int {
+	return sl.size
+}
+
+// Clear implements the Lister interface.
+func (sl *SkipList[K, V]) Clear() {
+	clear(sl.head.next)
+	clear(sl.head.span)
+
+	sl.tail = nil
+	sl.level = 1
+	sl.size = 0
+}
+
+// Capacity implements the Lister interface.
+//
+// Always returns -1.
+func (sl *SkipList[K, V]) Capacity() int {
+	return -1
+}
+
+// IsFull implements the Lister interface.
+//
+// Always returns false.
+func (sl *SkipList[K, V]) IsFull() bool {
+	return false
+}
+
+// Slice implements the Lister interface.
+//
+// The entries are in ascending key order.
+func (sl *SkipList[K, V]) Slice() []SkipListEntry[K, V] {
+	slice := make([]SkipListEntry[K, V], 0, sl.size)
+
+	for node := sl.head.next[0]; node != nil; node = node.next[0] {
+		slice = append(slice, node.entry)
+	}
+
+	return slice
+}
+
+// layout returns the description of the list used to format it.
+func (sl *SkipList[K, V]) layout() display.Container[SkipListEntry[K, V]] {
+	entries := make([]SkipListEntry[K, V], 0, sl.size)
+
+	for node := sl.head.next[0]; node != nil; node = node.next[0] {
+		entries = append(entries, node.entry)
+	}
+
+	return display.Container[SkipListEntry[K, V]]{
+		Pkg:      "list",
+		Name:     "SkipList",
+		TypeArgs: []string{display.TypeName[K](), display.TypeName[V]()},
+		NoError:  true,
+		Opaque:   !sl.ordered,
+		Insert:   "Insert",
+		GoArgs: func(entry SkipListEntry[K, V]) string {
+			return fmt.Sprintf("%#v, %#v", entry.Key, entry.Value)
+		},
+		Capacity: -1,
+		Values:   entries,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+//
+// The %#v verb cannot rebuild a list created with NewSkipListFunc, as its
+// comparison function has no Go syntax; it gives the detailed form instead.
+func (sl *SkipList[K, V]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, sl.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (sl *SkipList[K, V]) String() string {
+	return fmt.Sprint(sl)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (sl *SkipList[K, V]) GoString() string {
+	return sl.layout().GoSyntax()
+}
+
+// Copy is a method of the SkipList type. It is used to create a shallow copy of the
+// list.
+//
+// Returns:
+//   - *SkipList[K, V]: A copy of the list.
+func (sl *SkipList[K, V]) Copy() *SkipList[K, V] {
+	sl_copy := new_skip_list[K, V](sl.cmp)
+	sl_copy.ordered = sl.ordered
+
+	for node := sl.head.next[0]; node != nil; node = node.next[0] {
+		sl_copy.Insert(node.entry.Key, node.entry.Value)
+	}
+
+	return sl_copy
+}
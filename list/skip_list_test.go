@@ -0,0 +1,268 @@
+package list
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// check_skip_list checks the links, the spans, the tail and the size of a skip
+// list against the keys it should hold, in order.
+func check_skip_list(t *testing.T, sl *SkipList[int, int], keys []int) {
+	t.Helper()
+
+	if sl.Size() != len(keys) {
+		t.Fatalf("got size %d, want %d", sl.Size(), len(keys))
+	}
+
+	index := make(map[*skip_node[int, int]]int, len(keys))
+
+	var prev *skip_node[int, int]
+
+	i := 0
+
+	for node := sl.head.next[0]; node != nil; node = node.next[0] {
+		if i >= len(keys) || node.entry.Key != keys[i] {
+			t.Fatalf("got key %d at index %d, want keys %v", node.entry.Key, i, keys)
+		}
+
+		if node.prev != prev {
+			t.Fatalf("wrong previous node at index %d", i)
+		}
+
+		index[node] = i + 1
+		prev = node
+		i++
+	}
+
+	if i != len(keys) || sl.tail != prev {
+		t.Fatalf("got %d nodes and a wrong tail, want %d nodes", i, len(keys))
+	}
+
+	for level := 0; level < sl.level; level++ {
+		pos := 0
+
+		// The span of the last node of a level is not used, so it is not checked.
+		for x := sl.head; x.next[level] != nil; x = x.next[level] {
+			next := x.next[level]
+
+			if want := index[next] - pos; x.span[level] != want {
+				t.Fatalf("level %d: got span %d after position %d, want %d", level, x.span[level], pos, want)
+			}
+
+			pos = index[next]
+		}
+	}
+}
+
+// TestSkipListModel checks random insertions and deletions against a sorted
+// slice, together with Rank, At, Floor, Ceiling and the Peek methods.
+func TestSkipListModel(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	sl := NewSkipList[int, int]()
+
+	var keys []int
+
+	for step := 0; step < 4000; step++ {
+		key := rng.Intn(300)
+		pos, found := slices.BinarySearch(keys, key)
+
+		switch op := rng.Intn(10); {
+		case op < 5:
+			if sl.Insert(key, -key) == found {
+				t.Fatalf("step %d: Insert(%d) reported a new key %t", step, key, !found)
+			}
+
+			if !found {
+				keys = slices.Insert(keys, pos, key)
+			}
+		case op < 8:
+			value, ok := sl.Delete(key)
+
+			if ok != found || (ok && value != -key) {
+				t.Fatalf("step %d: Delete(%d) gave %d, %t", step, key, value, ok)
+			}
+
+			if found {
+				keys = slices.Delete(keys, pos, pos+1)
+			}
+		case op == 8:
+			entry, ok := sl.DeleteFirst()
+
+			if ok != (len(keys) > 0) || (ok && entry.Key != keys[0]) {
+				t.Fatalf("step %d: DeleteFirst gave %v, %t", step, entry, ok)
+			}
+
+			if ok {
+				keys = keys[1:]
+			}
+		default:
+			entry, ok := sl.DeleteLast()
+
+			if ok != (len(keys) > 0) || (ok && entry.Key != keys[len(keys)-1]) {
+				t.Fatalf("step %d: DeleteLast gave %v, %t", step, entry, ok)
+			}
+
+			if ok {
+				keys = keys[:len(keys)-1]
+			}
+		}
+
+		if step%50 != 0 {
+			continue
+		}
+
+		check_skip_list(t, sl, keys)
+
+		for i, k := range keys {
+			if rank, ok := sl.Rank(k); !ok || rank != i {
+				t.Fatalf("step %d: Rank(%d) = %d, %t, want %d", step, k, rank, ok, i)
+			}
+
+			if entry, ok := sl.At(i); !ok || entry.Key != k || entry.Value != -k {
+				t.Fatalf("step %d: At(%d) = %v, %t, want key %d", step, i, entry, ok, k)
+			}
+		}
+
+		probe := rng.Intn(320) - 10
+		pos, found = slices.BinarySearch(keys, probe)
+
+		if rank, ok := sl.Rank(probe); rank != pos || ok != found {
+			t.Fatalf("step %d: Rank(%d) = %d, %t, want %d, %t", step, probe, rank, ok, pos, found)
+		}
+
+		if entry, ok := sl.Ceiling(probe); ok != (pos < len(keys)) || (ok && entry.Key != keys[pos]) {
+			t.Fatalf("step %d: Ceiling(%d) = %v, %t", step, probe, entry, ok)
+		}
+
+		floor := pos - 1
+		if found {
+			floor = pos
+		}
+
+		if entry, ok := sl.Floor(probe); ok != (floor >= 0) || (ok && entry.Key != keys[floor]) {
+			t.Fatalf("step %d: Floor(%d) = %v, %t", step, probe, entry, ok)
+		}
+
+		for _, index := range []int{-1, len(keys)} {
+			if _, ok := sl.At(index); ok {
+				t.Fatalf("step %d: At(%d) succeeded on %d keys", step, index, len(keys))
+			}
+		}
+
+		first, ok1 := sl.PeekFirst()
+		last, ok2 := sl.PeekLast()
+
+		if ok1 != (len(keys) > 0) || ok2 != ok1 || (ok1 && (first.Key != keys[0] || last.Key != keys[len(keys)-1])) {
+			t.Fatalf("step %d: got %v and %v as first and last of %v", step, first, last, keys)
+		}
+	}
+}
+
+// TestSkipListFunc checks a skip list ordered by a comparison function, with
+// Range and a copy.
+func TestSkipListFunc(t *testing.T) {
+	reversed := func(a, b int) int {
+		return cmp.Compare(b, a)
+	}
+
+	sl, err := NewSkipListFunc[int, string](reversed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []int{3, 1, 4, 1, 5, 9, 2, 6} {
+		sl.Insert(key, "")
+	}
+
+	if got := sl.Keys(); !slices.Equal(got, []int{9, 6, 5, 4, 3, 2, 1}) {
+		t.Fatalf("got %v, want the keys in descending order", got)
+	}
+
+	if rank, ok := sl.Rank(4); !ok || rank != 3 {
+		t.Fatalf("got rank %d, %t, want 3", rank, ok)
+	}
+
+	var keys []int
+
+	for iter := sl.Range(6, 2); ; {
+		entry, err := iter.Consume()
+		if err != nil {
+			break
+		}
+
+		keys = append(keys, entry.Key)
+	}
+
+	if !slices.Equal(keys, []int{6, 5, 4, 3}) {
+		t.Fatalf("got %v in the range [6, 2), want [6 5 4 3]", keys)
+	}
+
+	sl_copy := sl.Copy()
+	sl_copy.Insert(7, "")
+
+	if sl.Contains(7) || !slices.Equal(sl_copy.Keys(), []int{9, 7, 6, 5, 4, 3, 2, 1}) {
+		t.Fatal("the copy is not ordered by the comparison function or shares nodes")
+	}
+
+	if _, err := NewSkipListFunc[int, int](nil); err == nil {
+		t.Fatal("got no error for a nil comparison function")
+	}
+}
+
+// TestSafeSkipListConcurrent checks concurrent use of a SafeSkipList; it is meant
+// to be run with -race.
+func TestSafeSkipListConcurrent(t *testing.T) {
+	sl := NewSafeSkipList[int, int]()
+
+	var wg sync.WaitGroup
+
+	for g := 0; g < 4; g++ {
+		wg.Add(2)
+
+		go func(g int) {
+			defer wg.Done()
+
+			for i := 0; i < 1000; i++ {
+				key := (i*4 + g) % 500
+
+				sl.Insert(key, key)
+
+				if i%3 == 0 {
+					sl.Delete(key)
+				}
+			}
+		}(g)
+
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < 1000; i++ {
+				if entry, ok := sl.At(i % 10); ok {
+					sl.Rank(entry.Key)
+				}
+
+				sl.Floor(i % 500)
+				sl.PeekLast()
+				sl.Slice()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	keys := sl.Keys()
+
+	if !slices.IsSorted(keys) || len(keys) != sl.Size() {
+		t.Fatalf("got %d sorted keys %t for a size of %d", len(keys), slices.IsSorted(keys), sl.Size())
+	}
+
+	for i, key := range keys {
+		if rank, ok := sl.Rank(key); !ok || rank != i {
+			t.Fatalf("Rank(%d) = %d, %t, want %d", key, rank, ok, i)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/PlayerR9/listlike/display"
+
+	itrs "github.com/PlayerR9/iterators/simple"
+)
+
+// ListSnapshot is a read-only view of a LimitedSafeList at the time it was taken.
+//
+// A snapshot is produced in O(1) time and shares its nodes with the list. It needs
+// no locking, so it can be read from any goroutine while the list keeps being
+// modified; the list copies its nodes the first time it needs to rewrite a link
+// they share.
+type ListSnapshot[T any] struct {
+	// front and back are pointers to the first and last nodes of the snapshot,
+	// respectively.
+	front, back *ListSafeNode[T]
+
+	// size is the number of elements in the snapshot.
+	size int
+}
+
+// PeekFirst is a method of the ListSnapshot type. It returns the first element of
+// the snapshot.
+//
+// Returns:
+//   - T: The first element of the snapshot.
+//   - bool: True if the snapshot is not empty, false otherwise.
+func (s *ListSnapshot[T]) PeekFirst() (T, bool) {
+	if s.size == 0 {
+		return *new(T), false
+	}
+
+	return s.front.Value, true
+}
+
+// PeekLast is a method of the ListSnapshot type. It returns the last element of
+// the snapshot.
+//
+// Returns:
+//   - T: The last element of the snapshot.
+//   - bool: True if the snapshot is not empty, false otherwise.
+func (s *ListSnapshot[T]) PeekLast() (T, bool) {
+	if s.size == 0 {
+		return *new(T), false
+	}
+
+	return s.back.Value, true
+}
+
+// IsEmpty is a method of the ListSnapshot type. It is used to check if the
+// snapshot is empty.
+//
+// Returns:
+//   - bool: true if the snapshot is empty, and false otherwise.
+func (s *ListSnapshot[T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Size is a method of the ListSnapshot type. It is used to return the number of
+// elements in the snapshot.
+//
+// Returns:
+//   - int: The number of elements in the snapshot.
+func (s *ListSnapshot[T]) Size() int {
+	return s.size
+}
+
+// Iterator is a method of the ListSnapshot type. It is used to return an iterator
+// over the elements in the snapshot, from the first to the last. Unlike the
+// iterators of the lists, it does not copy the elements.
+//
+// Returns:
+//   - itrs.Iterater[T]: An iterator for the elements in the snapshot.
+func (s *ListSnapshot[T]) Iterator() itrs.Iterater[T] {
+	return &snapshot_iterator[T]{
+		snapshot: s,
+	}
+}
+
+// Slice is a method of the ListSnapshot type. It is used to return a slice of the
+// elements in the snapshot.
+//
+// Returns:
+//   - []T: A slice of the elements in the snapshot.
+func (s *ListSnapshot[T]) Slice() []T {
+	slice := make([]T, 0, s.size)
+
+	s.forEach(func(_ int, value T) bool {
+		slice = append(slice, value)
+
+		return true
+	})
+
+	return slice
+}
+
+// layout returns the description of the snapshot used to format it.
+func (s *ListSnapshot[T]) layout() display.Container[T] {
+	values := make([]T, 0, s.size)
+
+	s.forEach(func(_ int, value T) bool {
+		values = append(values, value)
+
+		return true
+	})
+
+	// A snapshot cannot be built directly, so it is rebuilt as the snapshot of a
+	// safe list with the same values.
+	return display.Container[T]{
+		Pkg:      "list",
+		Name:     "ListSnapshot",
+		TypeArgs: []string{display.TypeName[T]()},
+		Ctor:     "NewSafeList",
+		Method:   "Snapshot",
+		Capacity: -1,
+		Values:   values,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (s *ListSnapshot[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, s.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (s *ListSnapshot[T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (s *ListSnapshot[T]) GoString() string {
+	return s.layout().GoSyntax()
+}
+
+// forEach calls f on each element of the snapshot, from the first to the last,
+// until f returns false.
+//
+// The walk is bounded by the size of the snapshot, so it never follows the link
+// of its last node, which the list may still be writing to.
+func (s *ListSnapshot[T]) forEach(f func(idx int, value T) bool) {
+	node := s.front
+
+	for i := 0; i < s.size; i++ {
+		if !f(i, node.Value) {
+			return
+		}
+
+		if i+1 < s.size {
+			node = node.Next()
+		}
+	}
+}
+
+// snapshot_iterator is an iterator over the elements of a ListSnapshot.
+type snapshot_iterator[T any] struct {
+	// snapshot is the snapshot to iterate over.
+	snapshot *ListSnapshot[T]
+
+	// node is the node of the last consumed element. Nil if the iterator is not
+	// started.
+	node *ListSafeNode[T]
+
+	// index is the index of the next element.
+	index int
+}
+
+// Consume implements the itrs.Iterater interface.
+func (iter *snapshot_iterator[T]) Consume() (T, error) {
+	if iter.index >= iter.snapshot.size {
+		return *new(T), itrs.Exhausted
+	}
+
+	if iter.node == nil {
+		iter.node = iter.snapshot.front
+	} else {
+		iter.node = iter.node.Next()
+	}
+
+	iter.index++
+
+	return iter.node.Value, nil
+}
+
+// Restart implements the itrs.Iterater interface.
+func (iter *snapshot_iterator[T]) Restart() {
+	iter.node = nil
+	iter.index = 0
+}
+
+// Snapshot is a method of the LimitedSafeList type. It returns a read-only view of
+// the list in O(1) time. See ListSnapshot.
+//
+// Returns:
+//   - *ListSnapshot[T]: The snapshot. Never returns nil.
+func (list *LimitedSafeList[T]) Snapshot() *ListSnapshot[T] {
+	list.lock()
+	defer list.unlock()
+
+	list.shared = list.front != nil
+
+	return &ListSnapshot[T]{
+		front: list.front,
+		back:  list.back,
+		size:  list.length(),
+	}
+}
+
+// unshare replaces the nodes of the list by copies so that the links can be
+// rewritten without affecting the snapshots. The caller must hold both locks.
+func (list *LimitedSafeList[T]) unshare() {
+	if !list.shared {
+		return
+	}
+
+	list.shared = false
+
+	if list.front == nil {
+		return
+	}
+
+	front := NewListSafeNode(list.front.Value)
+	back := front
+
+	for node := list.front.Next(); node != nil; node = node.Next() {
+		node_copy := NewListSafeNode(node.Value)
+		node_copy.SetPrev(back)
+
+		back.SetNext(node_copy)
+		back = node_copy
+	}
+
+	list.front = front
+	list.back = back
+}
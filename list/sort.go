@@ -0,0 +1,327 @@
+package list
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Sortable is an interface that defines the lists that can be sorted in place.
+type Sortable[T any] interface {
+	// SortFunc sorts the list in ascending order as determined by the cmp function.
+	//
+	// Parameters:
+	//   - cmp: The comparison function. It returns a negative number when a < b,
+	//     a positive number when a > b and zero when a == b.
+	SortFunc(cmp func(a, b T) int)
+
+	// SortStableFunc is like SortFunc but keeps the original order of equal
+	// elements.
+	//
+	// Parameters:
+	//   - cmp: The comparison function.
+	SortStableFunc(cmp func(a, b T) int)
+
+	// IsSortedFunc checks whether the list is sorted in ascending order as
+	// determined by the cmp function.
+	//
+	// Parameters:
+	//   - cmp: The comparison function.
+	//
+	// Returns:
+	//   - bool: True if the list is sorted, false otherwise.
+	IsSortedFunc(cmp func(a, b T) int) bool
+}
+
+// Merger is the constraint of the lists that can merge a sorted list of the same
+// type into themselves.
+type Merger[T, L any] interface {
+	// MergeFunc merges the sorted other list into the sorted list. On success,
+	// other is left empty.
+	//
+	// Parameters:
+	//   - other: The list to merge.
+	//   - cmp: The comparison function both lists are sorted by.
+	//
+	// Returns:
+	//   - bool: True if the lists were merged, false if the result would exceed
+	//     the capacity of the list.
+	MergeFunc(other L, cmp func(a, b T) int) bool
+}
+
+// Sort is a function that sorts the list in ascending order.
+//
+// Parameters:
+//   - list: The list to sort.
+func Sort[T cmp.Ordered](list Sortable[T]) {
+	if list == nil {
+		return
+	}
+
+	list.SortFunc(cmp.Compare[T])
+}
+
+// SortStable is a function that sorts the list in ascending order while keeping
+// the original order of equal elements.
+//
+// Parameters:
+//   - list: The list to sort.
+func SortStable[T cmp.Ordered](list Sortable[T]) {
+	if list == nil {
+		return
+	}
+
+	list.SortStableFunc(cmp.Compare[T])
+}
+
+// IsSorted is a function that checks whether the list is sorted in ascending order.
+//
+// Parameters:
+//   - list: The list to check.
+//
+// Returns:
+//   - bool: True if the list is sorted, false otherwise. True if list is nil.
+func IsSorted[T cmp.Ordered](list Sortable[T]) bool {
+	if list == nil {
+		return true
+	}
+
+	return list.IsSortedFunc(cmp.Compare[T])
+}
+
+// BinarySearch is a function that searches for the target in a list sorted in
+// ascending order.
+//
+// Parameters:
+//   - list: The list to search.
+//   - target: The value to search for.
+//
+// Returns:
+//   - int: The position of the target, or the position where it would be inserted.
+//   - bool: True if the target was found, false otherwise.
+func BinarySearch[T cmp.Ordered](list *ArrayList[T], target T) (int, bool) {
+	if list == nil {
+		return 0, false
+	}
+
+	return slices.BinarySearch(list.values, target)
+}
+
+// Merge is a function that merges the sorted other list into the sorted list, so
+// that the result is sorted in ascending order. On success, other is left empty.
+//
+// Parameters:
+//   - list: The list to merge into.
+//   - other: The list to merge.
+//
+// Returns:
+//   - bool: True if the lists were merged, false if the result would exceed the
+//     capacity of the list.
+func Merge[T cmp.Ordered, L Merger[T, L]](list, other L) bool {
+	return list.MergeFunc(other, cmp.Compare[T])
+}
+
+// SortFunc implements the Sortable interface.
+func (list *ArrayList[T]) SortFunc(cmp func(a, b T) int) {
+	slices.SortFunc(list.values, cmp)
+}
+
+// SortStableFunc implements the Sortable interface.
+func (list *ArrayList[T]) SortStableFunc(cmp func(a, b T) int) {
+	slices.SortStableFunc(list.values, cmp)
+}
+
+// IsSortedFunc implements the Sortable interface.
+func (list *ArrayList[T]) IsSortedFunc(cmp func(a, b T) int) bool {
+	return slices.IsSortedFunc(list.values, cmp)
+}
+
+// BinarySearchFunc is a method of the ArrayList type. It searches for the target in
+// a list sorted in ascending order as determined by the cmp function.
+//
+// Parameters:
+//   - target: The value to search for.
+//   - cmp: The comparison function the list is sorted by.
+//
+// Returns:
+//   - int: The position of the target, or the position where it would be inserted.
+//   - bool: True if the target was found, false otherwise.
+func (list *ArrayList[T]) BinarySearchFunc(target T, cmp func(a, b T) int) (int, bool) {
+	return slices.BinarySearchFunc(list.values, target, cmp)
+}
+
+// MergeFunc implements the Merger interface.
+//
+// Equal elements of the list come before the ones of other.
+func (list *ArrayList[T]) MergeFunc(other *ArrayList[T], cmp func(a, b T) int) bool {
+	if other == nil || other == list || len(other.values) == 0 {
+		return true
+	}
+
+	total := len(list.values) + len(other.values)
+
+	if list.capacity != -1 && total > list.capacity {
+		return false
+	}
+
+	merged := make([]T, 0, max(total, cap(list.values)))
+
+	i, j := 0, 0
+
+	for i < len(list.values) && j < len(other.values) {
+		if cmp(other.values[j], list.values[i]) < 0 {
+			merged = append(merged, other.values[j])
+			j++
+		} else {
+			merged = append(merged, list.values[i])
+			i++
+		}
+	}
+
+	merged = append(merged, list.values[i:]...)
+	merged = append(merged, other.values[j:]...)
+
+	list.values = merged
+	other.Clear()
+
+	return true
+}
+
+// SortFunc implements the Sortable interface.
+//
+// The list is sorted with a merge sort that relinks its nodes, so no element is
+// copied. The sort is stable.
+func (list *LinkedList[T]) SortFunc(cmp func(a, b T) int) {
+	if list.size < 2 {
+		return
+	}
+
+	list.front = merge_sort_nodes(list.front, list.size, cmp)
+	list.relink()
+}
+
+// SortStableFunc implements the Sortable interface.
+//
+// This is the same as SortFunc as the merge sort is already stable.
+func (list *LinkedList[T]) SortStableFunc(cmp func(a, b T) int) {
+	list.SortFunc(cmp)
+}
+
+// IsSortedFunc implements the Sortable interface.
+func (list *LinkedList[T]) IsSortedFunc(cmp func(a, b T) int) bool {
+	if list.front == nil {
+		return true
+	}
+
+	for node := list.front.Next(); node != nil; node = node.Next() {
+		if cmp(node.Value, node.Prev().Value) < 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MergeFunc implements the Merger interface.
+//
+// The nodes of other are relinked into the list, so no element is copied. Equal
+// elements of the list come before the ones of other.
+func (list *LinkedList[T]) MergeFunc(other *LinkedList[T], cmp func(a, b T) int) bool {
+	if other == nil || other == list || other.front == nil {
+		return true
+	}
+
+	if list.capacity != -1 && list.size+other.size > list.capacity {
+		return false
+	}
+
+	list.front = merge_nodes(list.front, other.front, cmp)
+	list.size += other.size
+	list.relink()
+
+	other.front = nil
+	other.back = nil
+	other.size = 0
+
+	return true
+}
+
+// relink fixes the previous pointers and the back of the list after its nodes
+// have been reordered through their next pointers.
+func (list *LinkedList[T]) relink() {
+	var prev *ListNode[T]
+
+	for node := list.front; node != nil; node = node.Next() {
+		node.SetPrev(prev)
+		prev = node
+	}
+
+	list.back = prev
+}
+
+// merge_sort_nodes sorts a chain of nodes through their next pointers. The previous
+// pointers are left inconsistent.
+//
+// Parameters:
+//   - front: The first node of the chain.
+//   - size: The number of nodes in the chain.
+//   - cmp: The comparison function.
+//
+// Returns:
+//   - *ListNode[T]: The first node of the sorted chain.
+func merge_sort_nodes[T any](front *ListNode[T], size int, cmp func(a, b T) int) *ListNode[T] {
+	if size < 2 {
+		if front != nil {
+			front.SetNext(nil)
+		}
+
+		return front
+	}
+
+	half := size / 2
+
+	middle := front
+	for i := 0; i < half; i++ {
+		middle = middle.Next()
+	}
+
+	left := merge_sort_nodes(front, half, cmp)
+	right := merge_sort_nodes(middle, size-half, cmp)
+
+	return merge_nodes(left, right, cmp)
+}
+
+// merge_nodes merges two sorted chains of nodes through their next pointers. The
+// previous pointers are left inconsistent.
+//
+// Parameters:
+//   - left: The first node of the first chain. Its nodes come first on ties.
+//   - right: The first node of the second chain.
+//   - cmp: The comparison function.
+//
+// Returns:
+//   - *ListNode[T]: The first node of the merged chain.
+func merge_nodes[T any](left, right *ListNode[T], cmp func(a, b T) int) *ListNode[T] {
+	var head ListNode[T]
+
+	tail := &head
+
+	for left != nil && right != nil {
+		if cmp(right.Value, left.Value) < 0 {
+			tail.SetNext(right)
+			right = right.Next()
+		} else {
+			tail.SetNext(left)
+			left = left.Next()
+		}
+
+		tail = tail.Next()
+	}
+
+	if left != nil {
+		tail.SetNext(left)
+	} else {
+		tail.SetNext(right)
+	}
+
+	return head.Next()
+}
@@ -0,0 +1,486 @@
+package list
+
+import (
+	"errors"
+	"unsafe"
+
+	gcers "github.com/PlayerR9/go-commons/errors"
+	gcint "github.com/PlayerR9/go-commons/ints"
+)
+
+var (
+	// ErrFull is the error returned when an operation would exceed the capacity
+	// of a list.
+	ErrFull error
+)
+
+func init() {
+	ErrFull = errors.New("the list is full")
+}
+
+// Concat is a method of the LinkedList type. It moves all the nodes of other to
+// the end of the list in constant time. On success, other is left empty.
+//
+// Parameters:
+//   - other: The list whose nodes are moved. It must not be the list itself.
+//
+// Returns:
+//   - bool: True if the nodes were moved, false if the result would exceed the
+//     capacity of the list or if other is the list itself.
+func (list *LinkedList[T]) Concat(other *LinkedList[T]) bool {
+	if other == list {
+		return false
+	} else if other == nil || other.front == nil {
+		return true
+	}
+
+	if list.capacity != -1 && list.size+other.size > list.capacity {
+		return false
+	}
+
+	if list.back == nil {
+		list.front = other.front
+	} else {
+		list.back.SetNext(other.front)
+		other.front.SetPrev(list.back)
+	}
+
+	list.back = other.back
+	list.size += other.size
+
+	other.front = nil
+	other.back = nil
+	other.size = 0
+
+	return true
+}
+
+// SpliceAt is a method of the LinkedList type. It moves all the nodes of other into
+// the list so that the first of them ends up at the given index. Apart from finding
+// the index, this takes constant time. On success, other is left empty.
+//
+// Parameters:
+//   - index: The position of the first moved node, in the range [0, list.Size()].
+//   - other: The list whose nodes are moved. It must not be the list itself.
+//
+// Returns:
+//   - error: An error if the nodes could not be moved.
+//
+// Errors:
+//   - *errors.ErrInvalidParameter: If the index is out of bounds or if other is the
+//     list itself.
+//   - ErrFull: If the result would exceed the capacity of the list.
+func (list *LinkedList[T]) SpliceAt(index int, other *LinkedList[T]) error {
+	if index < 0 || index > list.size {
+		return gcers.NewErrInvalidParameter("index", gcint.NewErrOutOfBounds(index, 0, list.size).WithUpperBound(true))
+	} else if other == list {
+		return gcers.NewErrInvalidParameter("other", errors.New("cannot splice a list into itself"))
+	} else if other == nil || other.front == nil {
+		return nil
+	}
+
+	if list.capacity != -1 && list.size+other.size > list.capacity {
+		return ErrFull
+	}
+
+	if index == list.size {
+		list.Concat(other)
+
+		return nil
+	}
+
+	next := list.nodeAt(index)
+	prev := next.Prev()
+
+	if prev == nil {
+		list.front = other.front
+	} else {
+		prev.SetNext(other.front)
+		other.front.SetPrev(prev)
+	}
+
+	other.back.SetNext(next)
+	next.SetPrev(other.back)
+
+	list.size += other.size
+
+	other.front = nil
+	other.back = nil
+	other.size = 0
+
+	return nil
+}
+
+// SplitAt is a method of the LinkedList type. It moves the nodes of the list into
+// two new lists: the first one holds the elements before the index and the second
+// one the remaining ones. Both lists have the capacity and the pool settings of the
+// list. Apart from finding the index, this takes constant time. On success, the list
+// is left empty.
+//
+// Parameters:
+//   - index: The position of the split, in the range [0, list.Size()].
+//
+// Returns:
+//   - *LinkedList[T]: The list of the elements before the index.
+//   - *LinkedList[T]: The list of the elements from the index onwards.
+//   - error: An error of type *errors.ErrInvalidParameter if the index is out of
+//     bounds.
+func (list *LinkedList[T]) SplitAt(index int) (*LinkedList[T], *LinkedList[T], error) {
+	if index < 0 || index > list.size {
+		return nil, nil, gcers.NewErrInvalidParameter("index", gcint.NewErrOutOfBounds(index, 0, list.size).WithUpperBound(true))
+	}
+
+	first := &LinkedList[T]{
+		capacity: list.capacity,
+		overflow: list.overflow,
+		pool:     list.pool.copy(),
+	}
+
+	second := &LinkedList[T]{
+		capacity: list.capacity,
+		overflow: list.overflow,
+		pool:     list.pool.copy(),
+	}
+
+	switch index {
+	case 0:
+		second.front, second.back, second.size = list.front, list.back, list.size
+	case list.size:
+		first.front, first.back, first.size = list.front, list.back, list.size
+	default:
+		middle := list.nodeAt(index)
+
+		first.front, first.back, first.size = list.front, middle.Prev(), index
+		second.front, second.back, second.size = middle, list.back, list.size-index
+
+		first.back.SetNext(nil)
+		middle.SetPrev(nil)
+	}
+
+	list.front = nil
+	list.back = nil
+	list.size = 0
+
+	return first, second, nil
+}
+
+// Reverse is a method of the LinkedList type. It reverses the order of the
+// elements of the list in place by relinking its nodes.
+func (list *LinkedList[T]) Reverse() {
+	for node := list.front; node != nil; node = node.Prev() {
+		prev, next := node.Prev(), node.Next()
+
+		node.SetPrev(next)
+		node.SetNext(prev)
+	}
+
+	list.front, list.back = list.back, list.front
+}
+
+// nodeAt returns the node at the given index, walking from the nearest end of the
+// list.
+//
+// Parameters:
+//   - index: The index of the node. Assumed to be in the range [0, list.size).
+//
+// Returns:
+//   - *ListNode[T]: The node at the index.
+func (list *LinkedList[T]) nodeAt(index int) *ListNode[T] {
+	if index < list.size/2 {
+		node := list.front
+
+		for i := 0; i < index; i++ {
+			node = node.Next()
+		}
+
+		return node
+	}
+
+	node := list.back
+
+	for i := list.size - 1; i > index; i-- {
+		node = node.Prev()
+	}
+
+	return node
+}
+
+// Concat is a method of the LimitedSafeList type. It moves all the nodes of other
+// to the end of the list in constant time. On success, other is left empty.
+//
+// Both lists are locked during the operation, in the order of their addresses so
+// that concurrent calls on the same two lists cannot deadlock.
+//
+// Parameters:
+//   - other: The list whose nodes are moved. It must not be the list itself.
+//
+// Returns:
+//   - bool: True if the nodes were moved, false if the result would exceed the
+//     capacity of the list or if other is the list itself.
+func (list *LimitedSafeList[T]) Concat(other *LimitedSafeList[T]) bool {
+	if other == list {
+		return false
+	} else if other == nil {
+		return true
+	}
+
+	list.lockPair(other)
+	defer list.unlockPair(other)
+
+	if other.front == nil {
+		return true
+	}
+
+	if list.capacity != -1 && list.length()+other.length() > list.capacity {
+		return false
+	}
+
+	list.concat(other)
+
+	return true
+}
+
+// SpliceAt is a method of the LimitedSafeList type. It moves all the nodes of other
+// into the list so that the first of them ends up at the given index. Apart from
+// finding the index, this takes constant time. On success, other is left empty.
+//
+// Both lists are locked during the operation, in the order of their addresses so
+// that concurrent calls on the same two lists cannot deadlock.
+//
+// Parameters:
+//   - index: The position of the first moved node, in the range [0, list.Size()].
+//   - other: The list whose nodes are moved. It must not be the list itself.
+//
+// Returns:
+//   - error: An error if the nodes could not be moved.
+//
+// Errors:
+//   - *errors.ErrInvalidParameter: If the index is out of bounds or if other is the
+//     list itself.
+//   - ErrFull: If the result would exceed the capacity of the list.
+func (list *LimitedSafeList[T]) SpliceAt(index int, other *LimitedSafeList[T]) error {
+	if other == list {
+		return gcers.NewErrInvalidParameter("other", errors.New("cannot splice a list into itself"))
+	}
+
+	if other == nil {
+		list.lock()
+		defer list.unlock()
+	} else {
+		list.lockPair(other)
+		defer list.unlockPair(other)
+	}
+
+	if index < 0 || index > list.length() {
+		return gcers.NewErrInvalidParameter("index", gcint.NewErrOutOfBounds(index, 0, list.length()).WithUpperBound(true))
+	} else if other == nil || other.front == nil {
+		return nil
+	}
+
+	if list.capacity != -1 && list.length()+other.length() > list.capacity {
+		return ErrFull
+	}
+
+	if index == list.length() {
+		list.concat(other)
+
+		return nil
+	}
+
+	list.unshare()
+
+	next := list.nodeAt(index)
+	prev := next.Prev()
+
+	if prev == nil {
+		list.front = other.front
+	} else {
+		prev.SetNext(other.front)
+		other.front.SetPrev(prev)
+	}
+
+	other.back.SetNext(next)
+	next.SetPrev(other.back)
+
+	list.size.Add(other.size.Load())
+	list.shared = list.shared || other.shared
+
+	other.front = nil
+	other.back = nil
+	other.size.Store(0)
+	other.shared = false
+
+	return nil
+}
+
+// SplitAt is a method of the LimitedSafeList type. It moves the nodes of the list
+// into two new lists: the first one holds the elements before the index and the
+// second one the remaining ones. Both lists have the capacity of the list. Apart
+// from finding the index, this takes constant time. On success, the list is left
+// empty.
+//
+// Parameters:
+//   - index: The position of the split, in the range [0, list.Size()].
+//
+// Returns:
+//   - *LimitedSafeList[T]: The list of the elements before the index.
+//   - *LimitedSafeList[T]: The list of the elements from the index onwards.
+//   - error: An error of type *errors.ErrInvalidParameter if the index is out of
+//     bounds.
+func (list *LimitedSafeList[T]) SplitAt(index int) (*LimitedSafeList[T], *LimitedSafeList[T], error) {
+	list.lock()
+	defer list.unlock()
+
+	if index < 0 || index > list.length() {
+		return nil, nil, gcers.NewErrInvalidParameter("index", gcint.NewErrOutOfBounds(index, 0, list.length()).WithUpperBound(true))
+	}
+
+	if index > 0 && index < list.length() {
+		list.unshare()
+	}
+
+	first := &LimitedSafeList[T]{
+		capacity: list.capacity,
+		overflow: list.overflow,
+		shared:   list.shared,
+	}
+
+	second := &LimitedSafeList[T]{
+		capacity: list.capacity,
+		overflow: list.overflow,
+		shared:   list.shared,
+	}
+
+	switch index {
+	case 0:
+		second.front, second.back = list.front, list.back
+		second.size.Store(list.size.Load())
+	case list.length():
+		first.front, first.back = list.front, list.back
+		first.size.Store(list.size.Load())
+	default:
+		middle := list.nodeAt(index)
+
+		first.front, first.back = list.front, middle.Prev()
+		first.size.Store(int64(index))
+
+		second.front, second.back = middle, list.back
+		second.size.Store(int64(list.length() - index))
+
+		first.back.SetNext(nil)
+		middle.SetPrev(nil)
+	}
+
+	list.front = nil
+	list.back = nil
+	list.size.Store(0)
+	list.shared = false
+
+	return first, second, nil
+}
+
+// Reverse is a method of the LimitedSafeList type. It reverses the order of the
+// elements of the list in place by relinking its nodes.
+func (list *LimitedSafeList[T]) Reverse() {
+	list.lock()
+	defer list.unlock()
+
+	list.unshare()
+
+	for node := list.front; node != nil; node = node.Prev() {
+		prev, next := node.Prev(), node.Next()
+
+		node.SetPrev(next)
+		node.SetNext(prev)
+	}
+
+	list.front, list.back = list.back, list.front
+}
+
+// lock locks both ends of the list, the front before the back.
+func (list *LimitedSafeList[T]) lock() {
+	list.frontMutex.Lock()
+	list.backMutex.Lock()
+}
+
+// unlock unlocks both ends of the list.
+func (list *LimitedSafeList[T]) unlock() {
+	list.backMutex.Unlock()
+	list.frontMutex.Unlock()
+}
+
+// lockPair locks the list and other, which must be distinct, in the order of their
+// addresses. Every method that locks two lists goes through it, so that two
+// goroutines locking the same pair never wait on each other.
+//
+// Parameters:
+//   - other: The other list. Assumed to be non-nil and not the list itself.
+func (list *LimitedSafeList[T]) lockPair(other *LimitedSafeList[T]) {
+	if uintptr(unsafe.Pointer(list)) < uintptr(unsafe.Pointer(other)) {
+		list.lock()
+		other.lock()
+	} else {
+		other.lock()
+		list.lock()
+	}
+}
+
+// unlockPair unlocks the list and other, as locked by lockPair.
+//
+// Parameters:
+//   - other: The other list. Assumed to be non-nil and not the list itself.
+func (list *LimitedSafeList[T]) unlockPair(other *LimitedSafeList[T]) {
+	list.unlock()
+	other.unlock()
+}
+
+// concat moves the nodes of other to the end of the list. The caller must hold the
+// locks of both lists and other must not be empty.
+//
+// Parameters:
+//   - other: The list whose nodes are moved.
+func (list *LimitedSafeList[T]) concat(other *LimitedSafeList[T]) {
+	if list.back == nil {
+		list.front = other.front
+	} else {
+		list.back.SetNext(other.front)
+		other.front.SetPrev(list.back)
+	}
+
+	list.back = other.back
+	list.size.Add(other.size.Load())
+	list.shared = list.shared || other.shared
+
+	other.front = nil
+	other.back = nil
+	other.size.Store(0)
+	other.shared = false
+}
+
+// nodeAt returns the node at the given index, walking from the nearest end of the
+// list. The caller must hold the locks of the list.
+//
+// Parameters:
+//   - index: The index of the node. Assumed to be in the range [0, list.size).
+//
+// Returns:
+//   - *ListSafeNode[T]: The node at the index.
+func (list *LimitedSafeList[T]) nodeAt(index int) *ListSafeNode[T] {
+	if index < list.length()/2 {
+		node := list.front
+
+		for i := 0; i < index; i++ {
+			node = node.Next()
+		}
+
+		return node
+	}
+
+	node := list.back
+
+	for i := list.length() - 1; i > index; i-- {
+		node = node.Prev()
+	}
+
+	return node
+}
@@ -0,0 +1,73 @@
+package list
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PlayerR9/listlike/options"
+)
+
+// TestLimitedSafeListConcatCrossed checks that concatenating two lists into each
+// other from two goroutines does not deadlock and loses no element.
+func TestLimitedSafeListConcatCrossed(t *testing.T) {
+	a, err := NewSafeList[int](options.WithInitialValues[int](1, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := NewSafeList[int](options.WithInitialValues[int](3, 4))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < 1000; i++ {
+			wg.Add(4)
+
+			go func() { defer wg.Done(); a.Concat(b) }()
+			go func() { defer wg.Done(); b.Concat(a) }()
+			go func() { defer wg.Done(); _ = a.SpliceAt(0, b) }()
+			go func() { defer wg.Done(); _ = b.SpliceAt(0, a) }()
+		}
+
+		wg.Wait()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("deadlock between crossed Concat and SpliceAt calls")
+	}
+
+	if got := a.Size() + b.Size(); got != 4 {
+		t.Fatalf("got %d elements in total, want 4", got)
+	}
+}
+
+// TestLimitedSafeListConcatSelf checks that a list cannot be concatenated to or
+// spliced into itself.
+func TestLimitedSafeListConcatSelf(t *testing.T) {
+	a, err := NewSafeList[int](options.WithInitialValues[int](1, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.Concat(a) {
+		t.Error("Concat of the list itself succeeded")
+	}
+
+	if a.SpliceAt(0, a) == nil {
+		t.Error("SpliceAt of the list itself succeeded")
+	}
+
+	if a.Size() != 2 {
+		t.Errorf("got size %d, want 2", a.Size())
+	}
+}
@@ -0,0 +1,655 @@
+package list
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+)
+
+const (
+	// unrolled_node_size is the number of elements each node of an unrolled list
+	// can hold.
+	unrolled_node_size int = 64
+)
+
+// unrolled_node is a node of an unrolled list.
+type unrolled_node[T any] struct {
+	// values holds the elements of the node. Only the first count are in use.
+	values [unrolled_node_size]T
+
+	// count is the number of elements in use.
+	count int
+
+	// prev and next are the previous and next nodes in the list, respectively.
+	prev, next *unrolled_node[T]
+}
+
+// insert inserts the value at the given offset, shifting the following elements.
+// The node must not be full.
+//
+// Parameters:
+//   - offset: The offset of the value, in the range [0, node.count].
+//   - value: The value to insert.
+func (node *unrolled_node[T]) insert(offset int, value T) {
+	copy(node.values[offset+1:node.count+1], node.values[offset:node.count])
+	node.values[offset] = value
+	node.count++
+}
+
+// remove removes the value at the given offset, shifting the following elements.
+//
+// Parameters:
+//   - offset: The offset of the value, in the range [0, node.count).
+//
+// Returns:
+//   - T: The removed value.
+func (node *unrolled_node[T]) remove(offset int) T {
+	value := node.values[offset]
+
+	copy(node.values[offset:node.count-1], node.values[offset+1:node.count])
+	node.count--
+	node.values[node.count] = *new(T)
+
+	return value
+}
+
+// UnrolledIterator is the iterator of the UnrolledList type. It walks the nodes of
+// the list directly.
+type UnrolledIterator[T any] struct {
+	// head is the first node of the list.
+	head *unrolled_node[T]
+
+	// current is the node being iterated over.
+	current *unrolled_node[T]
+
+	// offset is the position of the next element in the current node.
+	offset int
+}
+
+// Consume implements the Iterater interface.
+func (it *UnrolledIterator[T]) Consume() (T, error) {
+	for it.current != nil && it.offset >= it.current.count {
+		it.current = it.current.next
+		it.offset = 0
+	}
+
+	if it.current == nil {
+		return *new(T), io.EOF
+	}
+
+	value := it.current.values[it.offset]
+	it.offset++
+
+	return value, nil
+}
+
+// Restart implements the Iterater interface.
+func (it *UnrolledIterator[T]) Restart() {
+	it.current = it.head
+	it.offset = 0
+}
+
+// UnrolledList is a generic type that represents a list data structure with or
+// without a limited capacity, implemented using an unrolled linked list; that is,
+// a doubly linked list whose nodes hold up to 64 elements each.
+//
+// Compared to LinkedList, it allocates far fewer nodes and iterates over contiguous
+// memory, while keeping insertions in the middle cheaper than ArrayList.
+type UnrolledList[T any] struct {
+	// front and back are pointers to the first and last nodes in the list,
+	// respectively.
+	front, back *unrolled_node[T]
+
+	// size is the current number of elements in the list.
+	size int
+
+	// capacity is the maximum number of elements the list can hold. -1 means that
+	// the list is unlimited.
+	capacity int
+}
+
+// NewUnrolledList is a function that creates and returns a new instance of an
+// UnrolledList.
+//
+// Parameters:
+//   - opts: The options of the list. Supported options are WithCapacity, without
+//     which the list is unlimited, and WithInitialValues.
+//
+// Returns:
+//   - *UnrolledList[T]: A pointer to the newly created UnrolledList.
+//   - error: An error if the options are invalid.
+func NewUnrolledList[T any](opts ...options.Option) (*UnrolledList[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Only("UnrolledList", "WithCapacity", "WithInitialValues")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &UnrolledList[T]{
+		capacity: s.Capacity,
+	}
+
+	for _, value := range values {
+		list.Append(value)
+	}
+
+	return list, nil
+}
+
+// Append implements the Lister interface.
+func (list *UnrolledList[T]) Append(value T) bool {
+	if list.IsFull() {
+		return false
+	}
+
+	if list.back == nil || list.back.count == unrolled_node_size {
+		list.insertNodeAfter(list.back)
+	}
+
+	list.back.insert(list.back.count, value)
+	list.size++
+
+	return true
+}
+
+// Prepend implements the Lister interface.
+func (list *UnrolledList[T]) Prepend(value T) bool {
+	if list.IsFull() {
+		return false
+	}
+
+	if list.front == nil || list.front.count == unrolled_node_size {
+		list.insertNodeBefore(list.front)
+	}
+
+	list.front.insert(0, value)
+	list.size++
+
+	return true
+}
+
+// DeleteFirst implements the Lister interface.
+func (list *UnrolledList[T]) DeleteFirst() (T, bool) {
+	if list.front == nil {
+		return *new(T), false
+	}
+
+	node := list.front
+	value := node.remove(0)
+
+	list.size--
+
+	if node.count == 0 {
+		list.unlinkNode(node)
+	}
+
+	return value, true
+}
+
+// DeleteLast implements the Lister interface.
+func (list *UnrolledList[T]) DeleteLast() (T, bool) {
+	if list.back == nil {
+		return *new(T), false
+	}
+
+	node := list.back
+	value := node.remove(node.count - 1)
+
+	list.size--
+
+	if node.count == 0 {
+		list.unlinkNode(node)
+	}
+
+	return value, true
+}
+
+// PeekFirst implements the Lister interface.
+func (list *UnrolledList[T]) PeekFirst() (T, bool) {
+	if list.front == nil {
+		return *new(T), false
+	}
+
+	return list.front.values[0], true
+}
+
+// PeekLast implements the Lister interface.
+func (list *UnrolledList[T]) PeekLast() (T, bool) {
+	if list.back == nil {
+		return *new(T), false
+	}
+
+	return list.back.values[list.back.count-1], true
+}
+
+// Get is a method of the UnrolledList type. It returns the element at the given
+// index.
+//
+// Parameters:
+//   - index: The index of the element.
+//
+// Returns:
+//   - T: The element. The zero value if the index is out of bounds.
+//   - bool: True if the index is in the range [0, list.Size()), false otherwise.
+func (list *UnrolledList[T]) Get(index int) (T, bool) {
+	if index < 0 || index >= list.size {
+		return *new(T), false
+	}
+
+	node, offset := list.locate(index)
+
+	return node.values[offset], true
+}
+
+// Set is a method of the UnrolledList type. It replaces the element at the given
+// index.
+//
+// Parameters:
+//   - index: The index of the element.
+//   - value: The new value of the element.
+//
+// Returns:
+//   - bool: True if the index is in the range [0, list.Size()), false otherwise.
+func (list *UnrolledList[T]) Set(index int, value T) bool {
+	if index < 0 || index >= list.size {
+		return false
+	}
+
+	node, offset := list.locate(index)
+	node.values[offset] = value
+
+	return true
+}
+
+// InsertAt is a method of the UnrolledList type. It inserts the value so that it
+// ends up at the given index. A full node is split in two to make room.
+//
+// Parameters:
+//   - index: The position of the value, in the range [0, list.Size()].
+//   - value: The value to insert.
+//
+// Returns:
+//   - bool: True if the value was inserted, false if the index is out of bounds or
+//     if the list is full.
+func (list *UnrolledList[T]) InsertAt(index int, value T) bool {
+	if index < 0 || index > list.size || list.IsFull() {
+		return false
+	}
+
+	if index == list.size {
+		return list.Append(value)
+	}
+
+	node, offset := list.locate(index)
+
+	if node.count == unrolled_node_size {
+		// Move the upper half of the node into a new node.
+		half := unrolled_node_size / 2
+
+		list.insertNodeAfter(node)
+		next := node.next
+
+		copy(next.values[:], node.values[half:])
+		next.count = unrolled_node_size - half
+
+		clear(node.values[half:])
+		node.count = half
+
+		if offset > half {
+			node = next
+			offset -= half
+		}
+	}
+
+	node.insert(offset, value)
+	list.size++
+
+	return true
+}
+
+// DeleteAt is a method of the UnrolledList type. It removes the element at the
+// given index. A node that becomes less than half full is merged with its next
+// node when they fit together.
+//
+// Parameters:
+//   - index: The index of the element.
+//
+// Returns:
+//   - T: The removed element. The zero value if the index is out of bounds.
+//   - bool: True if the index is in the range [0, list.Size()), false otherwise.
+func (list *UnrolledList[T]) DeleteAt(index int) (T, bool) {
+	if index < 0 || index >= list.size {
+		return *new(T), false
+	}
+
+	node, offset := list.locate(index)
+	value := node.remove(offset)
+
+	list.size--
+
+	list.compact(node)
+
+	return value, true
+}
+
+// compact unlinks the node if it is empty or merges its next node into it if both
+// fit into a single node and the node is less than half full.
+//
+// Parameters:
+//   - node: The node to compact.
+func (list *UnrolledList[T]) compact(node *unrolled_node[T]) {
+	if node.count == 0 {
+		list.unlinkNode(node)
+
+		return
+	}
+
+	next := node.next
+
+	if next == nil || node.count >= unrolled_node_size/2 || node.count+next.count > unrolled_node_size {
+		return
+	}
+
+	copy(node.values[node.count:], next.values[:next.count])
+	node.count += next.count
+
+	list.unlinkNode(next)
+}
+
+// locate returns the node holding the element at the given index and the offset of
+// the element in that node, walking from the nearest end of the list.
+//
+// Parameters:
+//   - index: The index of the element. Assumed to be in the range [0, list.size).
+//
+// Returns:
+//   - *unrolled_node[T]: The node holding the element.
+//   - int: The offset of the element in the node.
+func (list *UnrolledList[T]) locate(index int) (*unrolled_node[T], int) {
+	if index < list.size/2 {
+		node := list.front
+
+		for index >= node.count {
+			index -= node.count
+			node = node.next
+		}
+
+		return node, index
+	}
+
+	node := list.back
+	rest := list.size - 1 - index
+
+	for rest >= node.count {
+		rest -= node.count
+		node = node.prev
+	}
+
+	return node, node.count - 1 - rest
+}
+
+// insertNodeAfter links a new empty node after the given one.
+//
+// Parameters:
+//   - prev: The node to insert after. Nil to insert at the front.
+func (list *UnrolledList[T]) insertNodeAfter(prev *unrolled_node[T]) {
+	node := &unrolled_node[T]{
+		prev: prev,
+	}
+
+	if prev == nil {
+		node.next = list.front
+		list.front = node
+	} else {
+		node.next = prev.next
+		prev.next = node
+	}
+
+	if node.next == nil {
+		list.back = node
+	} else {
+		node.next.prev = node
+	}
+}
+
+// insertNodeBefore links a new empty node before the given one.
+//
+// Parameters:
+//   - next: The node to insert before. Nil to insert at the back.
+func (list *UnrolledList[T]) insertNodeBefore(next *unrolled_node[T]) {
+	if next == nil {
+		list.insertNodeAfter(list.back)
+	} else {
+		list.insertNodeAfter(next.prev)
+	}
+}
+
+// unlinkNode removes the node from the list. Its elements are not accounted for.
+//
+// Parameters:
+//   - node: The node to remove.
+func (list *UnrolledList[T]) unlinkNode(node *unrolled_node[T]) {
+	if node.prev == nil {
+		list.front = node.next
+	} else {
+		node.prev.next = node.next
+	}
+
+	if node.next == nil {
+		list.back = node.prev
+	} else {
+		node.next.prev = node.prev
+	}
+
+	node.prev = nil
+	node.next = nil
+}
+
+// IsEmpty is a method of the UnrolledList type. It checks if the list is empty.
+//
+// Returns:
+//   - bool: A boolean value that is true if the list is empty, and false otherwise.
+func (list *UnrolledList[T]) IsEmpty() bool {
+	return list.size == 0
+}
+
+// Size is a method of the UnrolledList type. It returns the number of elements in
+// the list.
+//
+// Returns:
+//   - int: An integer that represents the number of elements in the list.
+func (list *UnrolledList[T]) Size() int {
+	return list.size
+}
+
+// Capacity is a method of the UnrolledList type. It returns the maximum number of
+// elements the list can hold.
+//
+// Returns:
+//   - int: The maximum number of elements the list can hold. -1 if there is no
+//     limit.
+func (list *UnrolledList[T]) Capacity() int {
+	return list.capacity
+}
+
+// IsFull is a method of the UnrolledList type. It checks if the list is full.
+//
+// Returns:
+//   - bool: A boolean value that is true if the list is full, and false otherwise.
+func (list *UnrolledList[T]) IsFull() bool {
+	return list.capacity != -1 && list.size >= list.capacity
+}
+
+// Clear is a method of the UnrolledList type. It is used to remove all elements
+// from the list.
+func (list *UnrolledList[T]) Clear() {
+	for node := list.front; node != nil; {
+		next := node.next
+
+		node.prev = nil
+		node.next = nil
+
+		node = next
+	}
+
+	list.front = nil
+	list.back = nil
+	list.size = 0
+}
+
+// Iterator is a method of the UnrolledList type. It returns an iterator for the
+// list that walks its nodes directly.
+//
+// Returns:
+//   - *UnrolledIterator[T]: An iterator for the list.
+func (list *UnrolledList[T]) Iterator() *UnrolledIterator[T] {
+	return &UnrolledIterator[T]{
+		head:    list.front,
+		current: list.front,
+	}
+}
+
+// Slice is a method of the UnrolledList type that returns a slice of type T
+// containing the elements of the list.
+//
+// Returns:
+//   - []T: A slice of type T containing the elements of the list.
+func (list *UnrolledList[T]) Slice() []T {
+	slice := make([]T, 0, list.size)
+
+	for node := list.front; node != nil; node = node.next {
+		slice = append(slice, node.values[:node.count]...)
+	}
+
+	return slice
+}
+
+// layout returns the description of the list used to format it.
+func (list *UnrolledList[T]) layout() display.Container[T] {
+	var opts []string
+
+	if list.capacity != -1 {
+		opts = append(opts, display.Option("WithCapacity", list.capacity))
+	}
+
+	return display.Container[T]{
+		Pkg:      "list",
+		Name:     "UnrolledList",
+		TypeArgs: []string{display.TypeName[T]()},
+		Options:  opts,
+		Capacity: list.capacity,
+		Values:   list.Slice(),
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (list *UnrolledList[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, list.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (list *UnrolledList[T]) String() string {
+	return fmt.Sprint(list)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (list *UnrolledList[T]) GoString() string {
+	return list.layout().GoSyntax()
+}
+
+// Copy is a method of the UnrolledList type. It is used to create a shallow copy
+// of the list.
+//
+// Returns:
+//   - *UnrolledList[T]: A copy of the list.
+func (list *UnrolledList[T]) Copy() *UnrolledList[T] {
+	list_copy := &UnrolledList[T]{
+		size:     list.size,
+		capacity: list.capacity,
+	}
+
+	for node := list.front; node != nil; node = node.next {
+		list_copy.insertNodeAfter(list_copy.back)
+
+		list_copy.back.values = node.values
+		list_copy.back.count = node.count
+	}
+
+	return list_copy
+}
+
+// forEach implements the walker interface.
+func (list *UnrolledList[T]) forEach(f func(idx int, value T) bool) {
+	var i int
+
+	for node := list.front; node != nil; node = node.next {
+		for _, value := range node.values[:node.count] {
+			if !f(i, value) {
+				return
+			}
+
+			i++
+		}
+	}
+}
+
+// forEachReverse implements the walker interface.
+func (list *UnrolledList[T]) forEachReverse(f func(idx int, value T) bool) {
+	i := list.size - 1
+
+	for node := list.back; node != nil; node = node.prev {
+		for j := node.count - 1; j >= 0; j-- {
+			if !f(i, node.values[j]) {
+				return
+			}
+
+			i--
+		}
+	}
+}
+
+// removeFunc implements the walker interface.
+func (list *UnrolledList[T]) removeFunc(pred func(value T) bool, all bool) int {
+	var count int
+
+	node := list.front
+
+	for node != nil && (all || count == 0) {
+		kept := 0
+
+		for j := 0; j < node.count; j++ {
+			if (all || count == 0) && pred(node.values[j]) {
+				count++
+			} else {
+				node.values[kept] = node.values[j]
+				kept++
+			}
+		}
+
+		clear(node.values[kept:node.count])
+		node.count = kept
+
+		next := node.next
+
+		if node.count == 0 {
+			list.unlinkNode(node)
+		}
+
+		node = next
+	}
+
+	list.size -= count
+
+	return count
+}
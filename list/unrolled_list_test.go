@@ -0,0 +1,386 @@
+package list
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/PlayerR9/listlike/options"
+)
+
+// check_unrolled checks that the list holds the wanted values and that its nodes
+// are well formed: linked both ways, neither empty nor overfull, and summing up to
+// the size of the list.
+func check_unrolled(t *testing.T, list *UnrolledList[int], want []int) {
+	t.Helper()
+
+	if list.Size() != len(want) {
+		t.Fatalf("got size %d, want %d", list.Size(), len(want))
+	}
+
+	if got := list.Slice(); !slices.Equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	for i, w := range want {
+		got, ok := list.Get(i)
+		if !ok || got != w {
+			t.Fatalf("Get(%d) = %d, %t; want %d, true", i, got, ok, w)
+		}
+	}
+
+	if _, ok := list.Get(len(want)); ok {
+		t.Fatalf("Get(%d) succeeded past the end", len(want))
+	}
+
+	var (
+		total int
+		prev  *unrolled_node[int]
+	)
+
+	for node := list.front; node != nil; node = node.next {
+		if node.prev != prev {
+			t.Fatal("broken prev link")
+		}
+
+		if node.count <= 0 || node.count > unrolled_node_size {
+			t.Fatalf("node holds %d elements", node.count)
+		}
+
+		total += node.count
+		prev = node
+	}
+
+	if prev != list.back {
+		t.Fatal("back is not the last node")
+	}
+
+	if total != len(want) {
+		t.Fatalf("nodes hold %d elements, want %d", total, len(want))
+	}
+}
+
+// node_count returns the number of nodes of the list.
+func node_count(list *UnrolledList[int]) int {
+	var n int
+
+	for node := list.front; node != nil; node = node.next {
+		n++
+	}
+
+	return n
+}
+
+// full_unrolled returns a list of n values, from 0 to n-1, and the same values as a
+// slice.
+func full_unrolled(t *testing.T, n int) (*UnrolledList[int], []int) {
+	t.Helper()
+
+	values := make([]int, n)
+	for i := range values {
+		values[i] = i
+	}
+
+	list, err := NewUnrolledList[int](options.WithInitialValues[int](values...))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return list, values
+}
+
+// TestUnrolledListInsertAtSplit checks insertions into a full node, at both of
+// its ends and around the split point.
+func TestUnrolledListInsertAtSplit(t *testing.T) {
+	half := unrolled_node_size / 2
+
+	for _, index := range []int{0, 1, half - 1, half, half + 1, unrolled_node_size - 1, unrolled_node_size} {
+		list, want := full_unrolled(t, unrolled_node_size)
+
+		if node_count(list) != 1 {
+			t.Fatalf("got %d nodes, want 1", node_count(list))
+		}
+
+		if !list.InsertAt(index, -1) {
+			t.Fatalf("InsertAt(%d) failed", index)
+		}
+
+		want = slices.Insert(want, index, -1)
+		check_unrolled(t, list, want)
+
+		if node_count(list) != 2 {
+			t.Fatalf("InsertAt(%d): got %d nodes, want 2", index, node_count(list))
+		}
+	}
+}
+
+// TestUnrolledListInsertAtBounds checks that out of bounds insertions and
+// insertions into a full list are rejected.
+func TestUnrolledListInsertAtBounds(t *testing.T) {
+	list, want := full_unrolled(t, 3)
+
+	if list.InsertAt(-1, 0) || list.InsertAt(4, 0) {
+		t.Fatal("out of bounds insertion succeeded")
+	}
+
+	check_unrolled(t, list, want)
+
+	limited, err := NewUnrolledList[int](options.WithCapacity(2), options.WithInitialValues[int](1, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if limited.InsertAt(1, 3) {
+		t.Fatal("insertion into a full list succeeded")
+	}
+}
+
+// TestUnrolledListDeleteAtMerge checks that deleting from a split node merges it
+// with its next node once both fit into one.
+func TestUnrolledListDeleteAtMerge(t *testing.T) {
+	list, want := full_unrolled(t, unrolled_node_size)
+
+	list.InsertAt(0, -1)
+	want = slices.Insert(want, 0, -1)
+
+	// The nodes now hold half+1 and half elements.
+	for node_count(list) > 1 {
+		value, ok := list.DeleteAt(0)
+		if !ok || value != want[0] {
+			t.Fatalf("DeleteAt(0) = %d, %t; want %d, true", value, ok, want[0])
+		}
+
+		want = want[1:]
+		check_unrolled(t, list, want)
+	}
+
+	if len(want) > unrolled_node_size {
+		t.Fatalf("merged while holding %d elements", len(want))
+	}
+
+	if _, ok := list.DeleteAt(len(want)); ok {
+		t.Fatal("out of bounds deletion succeeded")
+	}
+}
+
+// TestUnrolledListDeleteAtEmptiesNode checks that a node emptied by a deletion is
+// unlinked.
+func TestUnrolledListDeleteAtEmptiesNode(t *testing.T) {
+	list, want := full_unrolled(t, 2*unrolled_node_size+1)
+
+	if node_count(list) != 3 {
+		t.Fatalf("got %d nodes, want 3", node_count(list))
+	}
+
+	value, ok := list.DeleteAt(len(want) - 1)
+	if !ok || value != want[len(want)-1] {
+		t.Fatalf("DeleteAt(last) = %d, %t", value, ok)
+	}
+
+	want = want[:len(want)-1]
+	check_unrolled(t, list, want)
+
+	if node_count(list) != 2 {
+		t.Fatalf("got %d nodes, want 2", node_count(list))
+	}
+
+	for len(want) > 0 {
+		list.DeleteAt(len(want) / 2)
+		want = slices.Delete(want, len(want)/2, len(want)/2+1)
+		check_unrolled(t, list, want)
+	}
+
+	if list.front != nil || list.back != nil {
+		t.Fatal("empty list still has nodes")
+	}
+}
+
+// TestUnrolledListRandom checks random insertions and deletions against a slice.
+func TestUnrolledListRandom(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	list, err := NewUnrolledList[int]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var want []int
+
+	for i := 0; i < 5000; i++ {
+		if len(want) == 0 || r.IntN(3) > 0 {
+			index := r.IntN(len(want) + 1)
+
+			if !list.InsertAt(index, i) {
+				t.Fatalf("InsertAt(%d) failed", index)
+			}
+
+			want = slices.Insert(want, index, i)
+		} else {
+			index := r.IntN(len(want))
+
+			value, ok := list.DeleteAt(index)
+			if !ok || value != want[index] {
+				t.Fatalf("DeleteAt(%d) = %d, %t; want %d, true", index, value, ok, want[index])
+			}
+
+			want = slices.Delete(want, index, index+1)
+		}
+
+		if i%97 == 0 {
+			check_unrolled(t, list, want)
+		}
+	}
+
+	check_unrolled(t, list, want)
+}
+
+// bench_size is the number of elements of the lists of the benchmarks.
+const bench_size int = 10000
+
+// BenchmarkAppend compares appending values.
+func BenchmarkAppend(b *testing.B) {
+	b.Run("UnrolledList", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			list, _ := NewUnrolledList[int]()
+
+			for j := 0; j < bench_size; j++ {
+				list.Append(j)
+			}
+		}
+	})
+
+	b.Run("ArrayList", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			list, _ := NewArrayList[int]()
+
+			for j := 0; j < bench_size; j++ {
+				list.Append(j)
+			}
+		}
+	})
+
+	b.Run("LinkedList", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			list, _ := NewLinkedList[int]()
+
+			for j := 0; j < bench_size; j++ {
+				list.Append(j)
+			}
+		}
+	})
+}
+
+// BenchmarkPrepend compares prepending values.
+func BenchmarkPrepend(b *testing.B) {
+	b.Run("UnrolledList", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			list, _ := NewUnrolledList[int]()
+
+			for j := 0; j < bench_size; j++ {
+				list.Prepend(j)
+			}
+		}
+	})
+
+	b.Run("ArrayList", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			list, _ := NewArrayList[int]()
+
+			for j := 0; j < bench_size; j++ {
+				list.Prepend(j)
+			}
+		}
+	})
+
+	b.Run("LinkedList", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			list, _ := NewLinkedList[int]()
+
+			for j := 0; j < bench_size; j++ {
+				list.Prepend(j)
+			}
+		}
+	})
+}
+
+// BenchmarkInsertMiddle compares inserting a value in the middle of a list of
+// bench_size elements. ArrayList and LinkedList have no InsertAt method, so the
+// former inserts into its slice and the latter splices a one-element list.
+func BenchmarkInsertMiddle(b *testing.B) {
+	values := make([]int, bench_size)
+
+	b.Run("UnrolledList", func(b *testing.B) {
+		list, _ := NewUnrolledList[int](options.WithInitialValues[int](values...))
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			list.InsertAt(list.Size()/2, i)
+			list.DeleteAt(list.Size() / 2)
+		}
+	})
+
+	b.Run("ArrayList", func(b *testing.B) {
+		list, _ := NewArrayList[int](options.WithInitialValues[int](values...))
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			mid := len(list.values) / 2
+
+			list.values = slices.Insert(list.values, mid, i)
+			list.values = slices.Delete(list.values, mid, mid+1)
+		}
+	})
+
+	b.Run("LinkedList", func(b *testing.B) {
+		list, _ := NewLinkedList[int](options.WithInitialValues[int](values...))
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			other, _ := NewLinkedList[int](options.WithInitialValues[int](i))
+
+			_ = list.SpliceAt(list.Size()/2, other)
+			list.unlink(list.nodeAt(list.Size() / 2))
+		}
+	})
+}
+
+// BenchmarkIterate compares walking over all the elements with an iterator.
+func BenchmarkIterate(b *testing.B) {
+	values := make([]int, bench_size)
+
+	b.Run("UnrolledList", func(b *testing.B) {
+		list, _ := NewUnrolledList[int](options.WithInitialValues[int](values...))
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			it := list.Iterator()
+
+			for _, err := it.Consume(); err == nil; _, err = it.Consume() {
+			}
+		}
+	})
+
+	b.Run("ArrayList", func(b *testing.B) {
+		list, _ := NewArrayList[int](options.WithInitialValues[int](values...))
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			it := list.Iterator()
+
+			for _, err := it.Consume(); err == nil; _, err = it.Consume() {
+			}
+		}
+	})
+
+	b.Run("LinkedList", func(b *testing.B) {
+		list, _ := NewLinkedList[int](options.WithInitialValues[int](values...))
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			it := list.Iterator()
+
+			for _, err := it.Consume(); err == nil; _, err = it.Consume() {
+			}
+		}
+	})
+}
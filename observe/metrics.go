@@ -0,0 +1,220 @@
+package observe
+
+import (
+	"expvar"
+	"sync/atomic"
+	"time"
+)
+
+// Metrics is an Observer that collects counters about a container. It is safe for
+// concurrent use and its zero value is ready to use.
+type Metrics struct {
+	// size is the number of elements in the container after the last operation.
+	size atomic.Int64
+
+	// high_water is the largest size the container had.
+	high_water atomic.Int64
+
+	// pushed is the total number of elements added to the container.
+	pushed atomic.Int64
+
+	// popped is the total number of elements removed from the container.
+	popped atomic.Int64
+
+	// drops is the total number of elements rejected or dropped by the container.
+	drops atomic.Int64
+
+	// clears is the number of times the container was cleared.
+	clears atomic.Int64
+
+	// wait_total is the total time, in nanoseconds, the popped elements spent in
+	// the container.
+	wait_total atomic.Int64
+
+	// wait_max is the longest time, in nanoseconds, a popped element spent in the
+	// container.
+	wait_max atomic.Int64
+}
+
+// NewMetrics is a function that creates a new Metrics.
+//
+// Returns:
+//   - *Metrics: The new metrics. Never returns nil.
+func NewMetrics() *Metrics {
+	return &Metrics{}
+}
+
+// OnPush implements the Observer interface.
+func (m *Metrics) OnPush(e Event) {
+	m.pushed.Add(1)
+	m.setSize(e.Size)
+}
+
+// OnPop implements the Observer interface.
+func (m *Metrics) OnPop(e Event) {
+	m.popped.Add(1)
+	m.setSize(e.Size)
+	m.addWait(e.Wait)
+}
+
+// OnOverflow implements the Observer interface.
+func (m *Metrics) OnOverflow(e Event) {
+	m.drops.Add(1)
+	m.setSize(e.Size)
+}
+
+// OnClear implements the Observer interface.
+func (m *Metrics) OnClear(e Event) {
+	m.clears.Add(1)
+	m.popped.Add(int64(e.Size))
+	m.size.Store(0)
+}
+
+// Size is a method of the Metrics type. It returns the number of elements in the
+// container after the last observed operation.
+//
+// Returns:
+//   - int64: The size.
+func (m *Metrics) Size() int64 {
+	return m.size.Load()
+}
+
+// HighWaterMark is a method of the Metrics type. It returns the largest size the
+// container had.
+//
+// Returns:
+//   - int64: The high-water mark.
+func (m *Metrics) HighWaterMark() int64 {
+	return m.high_water.Load()
+}
+
+// Pushed is a method of the Metrics type. It returns the total number of elements
+// added to the container.
+//
+// Returns:
+//   - int64: The number of elements.
+func (m *Metrics) Pushed() int64 {
+	return m.pushed.Load()
+}
+
+// Popped is a method of the Metrics type. It returns the total number of elements
+// removed from the container, including the ones removed by a clear.
+//
+// Returns:
+//   - int64: The number of elements.
+func (m *Metrics) Popped() int64 {
+	return m.popped.Load()
+}
+
+// Drops is a method of the Metrics type. It returns the total number of elements
+// rejected or dropped because the container was full.
+//
+// Returns:
+//   - int64: The number of elements.
+func (m *Metrics) Drops() int64 {
+	return m.drops.Load()
+}
+
+// Clears is a method of the Metrics type. It returns the number of times the
+// container was cleared.
+//
+// Returns:
+//   - int64: The number of clears.
+func (m *Metrics) Clears() int64 {
+	return m.clears.Load()
+}
+
+// TotalWait is a method of the Metrics type. It returns the total time the popped
+// elements spent in the container.
+//
+// Returns:
+//   - time.Duration: The total wait time.
+func (m *Metrics) TotalWait() time.Duration {
+	return time.Duration(m.wait_total.Load())
+}
+
+// MaxWait is a method of the Metrics type. It returns the longest time a popped
+// element spent in the container.
+//
+// Returns:
+//   - time.Duration: The longest wait time.
+func (m *Metrics) MaxWait() time.Duration {
+	return time.Duration(m.wait_max.Load())
+}
+
+// Var is a method of the Metrics type. It returns an expvar map whose entries
+// read the current value of the metrics: "size", "high_water", "pushed",
+// "popped", "drops", "clears", "wait_total_ns" and "wait_max_ns".
+//
+// The map is not published; see Publish.
+//
+// Returns:
+//   - *expvar.Map: The map. Never returns nil.
+func (m *Metrics) Var() *expvar.Map {
+	vars := new(expvar.Map).Init()
+
+	vars.Set("size", int_func(m.Size))
+	vars.Set("high_water", int_func(m.HighWaterMark))
+	vars.Set("pushed", int_func(m.Pushed))
+	vars.Set("popped", int_func(m.Popped))
+	vars.Set("drops", int_func(m.Drops))
+	vars.Set("clears", int_func(m.Clears))
+	vars.Set("wait_total_ns", int_func(m.wait_total.Load))
+	vars.Set("wait_max_ns", int_func(m.wait_max.Load))
+
+	return vars
+}
+
+// Publish is a method of the Metrics type. It publishes the map returned by Var
+// under the given name, so that it is served by the expvar handler.
+//
+// Like expvar.Publish, it panics if the name is already in use.
+//
+// Parameters:
+//   - name: The name of the map.
+func (m *Metrics) Publish(name string) {
+	expvar.Publish(name, m.Var())
+}
+
+// setSize records the size of the container and updates the high-water mark.
+//
+// Parameters:
+//   - size: The size.
+func (m *Metrics) setSize(size int) {
+	m.size.Store(int64(size))
+
+	for {
+		high := m.high_water.Load()
+		if int64(size) <= high || m.high_water.CompareAndSwap(high, int64(size)) {
+			return
+		}
+	}
+}
+
+// addWait records the time a popped element spent in the container.
+//
+// Parameters:
+//   - wait: The time.
+func (m *Metrics) addWait(wait time.Duration) {
+	m.wait_total.Add(int64(wait))
+
+	for {
+		longest := m.wait_max.Load()
+		if int64(wait) <= longest || m.wait_max.CompareAndSwap(longest, int64(wait)) {
+			return
+		}
+	}
+}
+
+// int_func returns an expvar.Var that reports the value returned by f.
+//
+// Parameters:
+//   - f: The function that returns the value.
+//
+// Returns:
+//   - expvar.Func: The variable.
+func int_func(f func() int64) expvar.Func {
+	return func() any {
+		return f()
+	}
+}
@@ -0,0 +1,56 @@
+// Package observe provides hooks to watch the operations performed on the
+// containers of this module, and a ready-made Observer that collects metrics.
+//
+// An Observer is attached with the Observe function of the stack, queue and list
+// packages, which return a container that reports every operation to it.
+package observe
+
+import (
+	"time"
+)
+
+// Event describes an operation performed on a container.
+type Event struct {
+	// Size is the number of elements in the container after the operation.
+	Size int
+
+	// Time is when the operation happened.
+	Time time.Time
+
+	// Wait is how long the removed element spent in the container. Only set for
+	// the events reported to OnPop and, when an element is dropped to make room
+	// for a new one, to OnOverflow.
+	Wait time.Duration
+}
+
+// Observer is an interface that receives the operations performed on a container.
+//
+// The methods are called synchronously, after the operation, by the goroutine
+// that performed it; thus, they must be quick and safe for concurrent use if the
+// container is shared between goroutines.
+type Observer interface {
+	// OnPush is called for each element added to the container.
+	//
+	// Parameters:
+	//   - e: The event.
+	OnPush(e Event)
+
+	// OnPop is called for each element removed from the container.
+	//
+	// Parameters:
+	//   - e: The event.
+	OnPop(e Event)
+
+	// OnOverflow is called for each element that is rejected because the container
+	// is full, and for each element dropped to make room for a new one.
+	//
+	// Parameters:
+	//   - e: The event.
+	OnOverflow(e Event)
+
+	// OnClear is called when the container is cleared.
+	//
+	// Parameters:
+	//   - e: The event. Its size is the number of elements that were removed.
+	OnClear(e Event)
+}
@@ -0,0 +1,46 @@
+package options
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ErrUnsupportedOption represents an error when an option is given to a container
+// that does not support it.
+type ErrUnsupportedOption struct {
+	// Option is the name of the option.
+	Option string
+
+	// Container is the name of the container.
+	Container string
+}
+
+// Error implements the error interface.
+//
+// Message: "option <option> is not supported by <container>"
+func (e *ErrUnsupportedOption) Error() string {
+	var builder strings.Builder
+
+	builder.WriteString("option ")
+	builder.WriteString(strconv.Quote(e.Option))
+	builder.WriteString(" is not supported by ")
+	builder.WriteString(e.Container)
+
+	return builder.String()
+}
+
+// NewErrUnsupportedOption creates a new ErrUnsupportedOption error.
+//
+// Parameters:
+//   - option: The name of the option.
+//   - container: The name of the container.
+//
+// Returns:
+//   - *ErrUnsupportedOption: A pointer to the newly created ErrUnsupportedOption.
+//     Never returns nil.
+func NewErrUnsupportedOption(option, container string) *ErrUnsupportedOption {
+	return &ErrUnsupportedOption{
+		Option:    option,
+		Container: container,
+	}
+}
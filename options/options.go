@@ -0,0 +1,391 @@
+package options
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+
+	"github.com/PlayerR9/listlike/clock"
+
+	gcers "github.com/PlayerR9/go-commons/errors"
+	gcint "github.com/PlayerR9/go-commons/ints"
+)
+
+// Settings is the configuration of a container, built from a list of options.
+//
+// Each constructor documents the options it supports and returns an
+// *ErrUnsupportedOption for the other ones.
+type Settings struct {
+	// Capacity is the maximum number of elements of the container. -1 means that
+	// the container is unlimited.
+	Capacity int
+
+	// Values are the initial values of the container, as a []T. Nil if none were
+	// given.
+	Values any
+
+	// NodePool is the maximum number of free nodes the container keeps for reuse.
+	// 0 disables pooling.
+	NodePool int
+
+	// Overflow is what the container does when a value is inserted while it is
+	// full.
+	Overflow OverflowPolicy
+
+	// ThreadSafe is true when the container must be safe for concurrent use.
+	ThreadSafe bool
+
+	// GrowthFactor is the factor by which an array-backed container grows its
+	// backing array. 0 means that the growth of the append built-in is used.
+	GrowthFactor float64
+
+	// Reserve is the capacity an array-backed container allocates up front and
+	// never shrinks below.
+	Reserve int
+
+	// ShrinkThreshold is the fraction of its backing array an array-backed
+	// container must use for it to be kept after a removal. 0 disables shrinking.
+	ShrinkThreshold float64
+
+	// Clock is the clock of the containers that depend on time. Nil means the
+	// clock of the system.
+	Clock clock.Clock
+
+	// History is the number of removed elements a deduplicating container
+	// remembers. 0 means none.
+	History int
+
+	// given are the names of the options that were given, in the order in which
+	// they were first given.
+	given []string
+}
+
+// Option is a function that configures the settings of a container.
+//
+// Parameters:
+//   - s: The settings to configure. Assumed to be non-nil.
+//
+// Returns:
+//   - error: An error if the option is invalid.
+type Option func(s *Settings) error
+
+// New is a function that applies the options, in order, to the default settings.
+// Nil options are ignored.
+//
+// Parameters:
+//   - opts: The options to apply.
+//
+// Returns:
+//   - *Settings: The resulting settings.
+//   - error: The error of the first invalid option, or an error of type
+//     *errors.ErrInvalidParameter if the reserve exceeds the capacity.
+func New(opts ...Option) (*Settings, error) {
+	s := &Settings{
+		Capacity: -1,
+	}
+
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+
+		err := opt(s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if s.Capacity != -1 && s.Reserve > s.Capacity {
+		return nil, gcers.NewErrInvalidParameter("reserve", fmt.Errorf("a reserve of %d exceeds the capacity of %d", s.Reserve, s.Capacity))
+	}
+
+	return s, nil
+}
+
+// Has is a method of the Settings type. It checks whether the option with the
+// given name was given.
+//
+// Parameters:
+//   - name: The name of the option, such as "WithCapacity".
+//
+// Returns:
+//   - bool: True if the option was given, false otherwise.
+func (s *Settings) Has(name string) bool {
+	return slices.Contains(s.given, name)
+}
+
+// Only is a method of the Settings type. It checks that no option other than the
+// given ones was given.
+//
+// Parameters:
+//   - container: The name of the container, used in the error message.
+//   - names: The names of the supported options.
+//
+// Returns:
+//   - error: An error of type *ErrUnsupportedOption for the first unsupported
+//     option, in the order in which the options were given.
+func (s *Settings) Only(container string, names ...string) error {
+	for _, name := range s.given {
+		if !slices.Contains(names, name) {
+			return NewErrUnsupportedOption(name, container)
+		}
+	}
+
+	return nil
+}
+
+// mark records that the option with the given name was given.
+//
+// Parameters:
+//   - name: The name of the option.
+func (s *Settings) mark(name string) {
+	if !slices.Contains(s.given, name) {
+		s.given = append(s.given, name)
+	}
+}
+
+// InitialValues is a function that returns the initial values of the settings.
+//
+// Parameters:
+//   - s: The settings. Assumed to be non-nil.
+//
+// Returns:
+//   - []T: The initial values. Nil if none were given.
+//   - error: An error of type *errors.ErrInvalidParameter if the values are not of
+//     type []T or if there are more of them than the capacity.
+func InitialValues[T any](s *Settings) ([]T, error) {
+	if s.Values == nil {
+		return nil, nil
+	}
+
+	values, ok := s.Values.([]T)
+	if !ok {
+		want := reflect.TypeFor[T]()
+		got := reflect.TypeOf(s.Values).Elem()
+
+		return nil, gcers.NewErrInvalidParameter("values", fmt.Errorf("the initial values are of type %s but the elements are of type %s; use WithInitialValues[%s]", got, want, want))
+	}
+
+	if s.Capacity != -1 && len(values) > s.Capacity {
+		return nil, gcers.NewErrInvalidParameter("values", fmt.Errorf("%d values do not fit in a capacity of %d", len(values), s.Capacity))
+	}
+
+	return values, nil
+}
+
+// WithCapacity is an option that sets the maximum number of elements of the
+// container.
+//
+// Parameters:
+//   - capacity: The capacity. Must be non-negative.
+//
+// Returns:
+//   - Option: The option. Never returns nil.
+func WithCapacity(capacity int) Option {
+	return func(s *Settings) error {
+		if capacity < 0 {
+			return gcers.NewErrInvalidParameter("capacity", gcint.NewErrGTE(0))
+		}
+
+		s.Capacity = capacity
+		s.mark("WithCapacity")
+
+		return nil
+	}
+}
+
+// WithInitialValues is an option that sets the initial values of the container,
+// in the order in which they would be inserted one by one.
+//
+// T must be the element type of the container, or the constructor fails with an
+// *errors.ErrInvalidParameter. As T is otherwise inferred from the values, give it
+// whenever they are untyped constants; for instance, WithInitialValues(1, 2) gives
+// ints, so a container of int64 needs WithInitialValues[int64](1, 2).
+//
+// Parameters:
+//   - values: The initial values.
+//
+// Returns:
+//   - Option: The option. Never returns nil.
+func WithInitialValues[T any](values ...T) Option {
+	return func(s *Settings) error {
+		s.Values = values
+		s.mark("WithInitialValues")
+
+		return nil
+	}
+}
+
+// WithNodePool is an option that makes a linked container keep up to size free
+// nodes and reuse them instead of allocating new ones. Pooled nodes are zeroed so
+// that they do not retain removed values.
+//
+// Parameters:
+//   - size: The maximum number of free nodes. Must be non-negative; 0 disables
+//     pooling.
+//
+// Returns:
+//   - Option: The option. Never returns nil.
+func WithNodePool(size int) Option {
+	return func(s *Settings) error {
+		if size < 0 {
+			return gcers.NewErrInvalidParameter("size", gcint.NewErrGTE(0))
+		}
+
+		s.NodePool = size
+		s.mark("WithNodePool")
+
+		return nil
+	}
+}
+
+// WithOverflowPolicy is an option that sets what a container with a capacity does
+// when a value is inserted while it is full. The default is Reject.
+//
+// Parameters:
+//   - policy: The policy.
+//
+// Returns:
+//   - Option: The option. Never returns nil.
+func WithOverflowPolicy(policy OverflowPolicy) Option {
+	return func(s *Settings) error {
+		if policy != Reject && policy != DropOldest {
+			return gcers.NewErrInvalidParameter("policy", fmt.Errorf("unknown overflow policy %d", policy))
+		}
+
+		s.Overflow = policy
+		s.mark("WithOverflowPolicy")
+
+		return nil
+	}
+}
+
+// WithThreadSafety is an option that requires the container to be safe for
+// concurrent use. It is only supported by the New factories, which pick a
+// thread-safe implementation when enabled.
+//
+// Parameters:
+//   - enabled: Whether the container must be thread-safe.
+//
+// Returns:
+//   - Option: The option. Never returns nil.
+func WithThreadSafety(enabled bool) Option {
+	return func(s *Settings) error {
+		s.ThreadSafe = enabled
+		s.mark("WithThreadSafety")
+
+		return nil
+	}
+}
+
+// WithGrowthFactor is an option that sets the factor by which an array-backed
+// container grows its backing array when it runs out of room. Containers with a
+// capacity never grow past it.
+//
+// Parameters:
+//   - factor: The growth factor. Must be a finite number greater than 1.
+//
+// Returns:
+//   - Option: The option. Never returns nil.
+func WithGrowthFactor(factor float64) Option {
+	return func(s *Settings) error {
+		if math.IsNaN(factor) || math.IsInf(factor, 0) || factor <= 1 {
+			return gcers.NewErrInvalidParameter("factor", errors.New("value must be a finite number greater than 1"))
+		}
+
+		s.GrowthFactor = factor
+		s.mark("WithGrowthFactor")
+
+		return nil
+	}
+}
+
+// WithReserve is an option that makes an array-backed container allocate room for
+// n elements up front. The container never shrinks its backing array below it.
+//
+// Parameters:
+//   - n: The number of elements. Must be non-negative and not exceed the capacity.
+//
+// Returns:
+//   - Option: The option. Never returns nil.
+func WithReserve(n int) Option {
+	return func(s *Settings) error {
+		if n < 0 {
+			return gcers.NewErrInvalidParameter("n", gcint.NewErrGTE(0))
+		}
+
+		s.Reserve = n
+		s.mark("WithReserve")
+
+		return nil
+	}
+}
+
+// WithShrinkThreshold is an option that makes an array-backed container release
+// the unused part of its backing array as soon as, after a removal, less than the
+// given fraction of it is in use.
+//
+// Parameters:
+//   - threshold: The fraction. Must be a number strictly between 0 and 1.
+//
+// Returns:
+//   - Option: The option. Never returns nil.
+func WithShrinkThreshold(threshold float64) Option {
+	return func(s *Settings) error {
+		if !(threshold > 0 && threshold < 1) {
+			return gcers.NewErrInvalidParameter("threshold", errors.New("value must be strictly between 0 and 1"))
+		}
+
+		s.ShrinkThreshold = threshold
+		s.mark("WithShrinkThreshold")
+
+		return nil
+	}
+}
+
+// WithClock is an option that sets the clock used by a container that depends on
+// time, such as a DelayQueue. It is mostly useful to drive such containers with a
+// clock.Fake in tests.
+//
+// Parameters:
+//   - c: The clock. Must not be nil.
+//
+// Returns:
+//   - Option: The option. Never returns nil.
+func WithClock(c clock.Clock) Option {
+	return func(s *Settings) error {
+		if c == nil {
+			return gcers.NewErrNilParameter("c")
+		}
+
+		s.Clock = c
+		s.mark("WithClock")
+
+		return nil
+	}
+}
+
+// WithHistory is an option that makes a deduplicating container, such as a
+// UniqueQueue, remember the last size elements it removed and treat them as
+// duplicates. The least recently seen ones are forgotten first.
+//
+// Parameters:
+//   - size: The number of elements to remember. Must be non-negative; 0 disables
+//     the history.
+//
+// Returns:
+//   - Option: The option. Never returns nil.
+func WithHistory(size int) Option {
+	return func(s *Settings) error {
+		if size < 0 {
+			return gcers.NewErrInvalidParameter("size", gcint.NewErrGTE(0))
+		}
+
+		s.History = size
+		s.mark("WithHistory")
+
+		return nil
+	}
+}
@@ -0,0 +1,172 @@
+package options
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+// TestNew checks the settings built from valid options.
+func TestNew(t *testing.T) {
+	s, err := New(
+		WithCapacity(8),
+		nil,
+		WithReserve(4),
+		WithGrowthFactor(1.5),
+		WithShrinkThreshold(0.25),
+		WithOverflowPolicy(DropOldest),
+		WithNodePool(16),
+		WithHistory(3),
+		WithThreadSafety(true),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Capacity != 8 || s.Reserve != 4 || s.GrowthFactor != 1.5 || s.ShrinkThreshold != 0.25 ||
+		s.Overflow != DropOldest || s.NodePool != 16 || s.History != 3 || !s.ThreadSafe {
+		t.Fatalf("got %+v", *s)
+	}
+
+	if !s.Has("WithReserve") || s.Has("WithInitialValues") || s.Has("WithClock") {
+		t.Fatal("Has does not match the given options")
+	}
+
+	s, err = New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Capacity != -1 || s.Values != nil || s.Overflow != Reject || s.Clock != nil {
+		t.Fatalf("got %+v, want the default settings", *s)
+	}
+}
+
+// TestNewErrors checks that invalid options are rejected.
+func TestNewErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{"negative capacity", []Option{WithCapacity(-1)}, "capacity"},
+		{"negative node pool", []Option{WithNodePool(-1)}, "size"},
+		{"negative history", []Option{WithHistory(-2)}, "size"},
+		{"negative reserve", []Option{WithReserve(-1)}, "n"},
+		{"unknown overflow policy", []Option{WithOverflowPolicy(OverflowPolicy(7))}, "policy"},
+		{"growth factor of 1", []Option{WithGrowthFactor(1)}, "factor"},
+		{"growth factor below 1", []Option{WithGrowthFactor(0.5)}, "factor"},
+		{"NaN growth factor", []Option{WithGrowthFactor(math.NaN())}, "factor"},
+		{"infinite growth factor", []Option{WithGrowthFactor(math.Inf(1))}, "factor"},
+		{"shrink threshold of 0", []Option{WithShrinkThreshold(0)}, "threshold"},
+		{"shrink threshold of 1", []Option{WithShrinkThreshold(1)}, "threshold"},
+		{"NaN shrink threshold", []Option{WithShrinkThreshold(math.NaN())}, "threshold"},
+		{"nil clock", []Option{WithClock(nil)}, "c"},
+		{"reserve over capacity", []Option{WithReserve(5), WithCapacity(4)}, "reserve"},
+		{"first invalid option", []Option{WithCapacity(2), WithNodePool(-1), WithCapacity(-1)}, "size"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.opts...)
+			if err == nil {
+				t.Fatal("got no error")
+			}
+
+			if !strings.Contains(err.Error(), `("`+tt.want+`")`) {
+				t.Fatalf("got %q, want an error about %q", err.Error(), tt.want)
+			}
+		})
+	}
+}
+
+// TestOnly checks that the first unsupported option is reported, in the order in
+// which the options were given.
+func TestOnly(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{"none", nil, ""},
+		{"supported", []Option{WithCapacity(1), WithInitialValues[int](1)}, ""},
+		{"one unsupported", []Option{WithCapacity(1), WithHistory(1)}, "WithHistory"},
+		{"first unsupported", []Option{WithNodePool(1), WithHistory(1), WithReserve(1), WithThreadSafety(true)}, "WithNodePool"},
+		{"first unsupported, repeated", []Option{WithReserve(1), WithHistory(1), WithReserve(2)}, "WithReserve"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := New(tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Repeated, as the order must not change from one call to the next.
+			for i := 0; i < 10; i++ {
+				err = s.Only("Container", "WithCapacity", "WithInitialValues")
+
+				if tt.want == "" {
+					if err != nil {
+						t.Fatalf("got %q, want no error", err.Error())
+					}
+
+					continue
+				}
+
+				var unsupported *ErrUnsupportedOption
+
+				if !errors.As(err, &unsupported) || unsupported.Option != tt.want || unsupported.Container != "Container" {
+					t.Fatalf("got %v, want %s to be unsupported", err, tt.want)
+				}
+			}
+		})
+	}
+}
+
+// TestInitialValues checks the initial values read back with their element type.
+func TestInitialValues(t *testing.T) {
+	s, err := New(WithInitialValues[int64](1, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := InitialValues[int64](s)
+	if err != nil || len(values) != 2 || values[1] != 2 {
+		t.Fatalf("got %v, %v, want [1 2]", values, err)
+	}
+
+	values, err = InitialValues[int64](&Settings{Capacity: -1})
+	if err != nil || values != nil {
+		t.Fatalf("got %v, %v, want no values", values, err)
+	}
+}
+
+// TestInitialValuesErrors checks the errors of the initial values: a mismatched
+// element type, such as that of untyped constants, and too many values.
+func TestInitialValuesErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{"untyped constants", []Option{WithInitialValues(1, 2)}, "of type int but the elements are of type int64; use WithInitialValues[int64]"},
+		{"other type", []Option{WithInitialValues("a")}, "of type string but the elements are of type int64"},
+		{"over capacity", []Option{WithCapacity(1), WithInitialValues[int64](1, 2)}, "2 values do not fit in a capacity of 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := New(tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = InitialValues[int64](s)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("got %v, want an error containing %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package options
+
+import "strconv"
+
+// OverflowPolicy is what a container with a capacity does when a value is
+// inserted while it is full.
+type OverflowPolicy int
+
+const (
+	// Reject refuses the new value; the insertion reports failure.
+	Reject OverflowPolicy = iota
+
+	// DropOldest removes the oldest value to make room for the new one. For stacks
+	// and queues, it is the value that would be removed last by Pop or first by
+	// Dequeue, respectively; for lists, it is the value at the opposite end of the
+	// insertion.
+	DropOldest
+)
+
+// String implements the fmt.Stringer interface.
+func (p OverflowPolicy) String() string {
+	switch p {
+	case Reject:
+		return "Reject"
+	case DropOldest:
+		return "DropOldest"
+	default:
+		return "OverflowPolicy(" + strconv.Itoa(int(p)) + ")"
+	}
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (p OverflowPolicy) GoString() string {
+	return "options." + p.String()
+}
@@ -1,10 +1,12 @@
 package queue
 
 import (
-	"strconv"
-	"strings"
+	"fmt"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/internal/growth"
+	"github.com/PlayerR9/listlike/options"
 
-	gcstr "github.com/PlayerR9/go-commons/strings"
 	itrs "github.com/PlayerR9/iterators/simple"
 )
 
@@ -13,12 +15,16 @@ import (
 type ArrayQueue[T any] struct {
 	// values is a slice of type T that stores the elements in the queue.
 	values []T
+
+	// policy is the capacity policy of the values.
+	policy growth.Policy
 }
 
 // Enqueue implements the Queuer interface.
 //
 // Always returns true.
 func (queue *ArrayQueue[T]) Enqueue(value T) bool {
+	queue.values = growth.Grow(queue.policy, queue.values, 1)
 	queue.values = append(queue.values, value)
 
 	return true
@@ -32,6 +38,7 @@ func (queue *ArrayQueue[T]) EnqueueMany(values []T) int {
 		return 0
 	}
 
+	queue.values = growth.Grow(queue.policy, queue.values, len(values))
 	queue.values = append(queue.values, values...)
 
 	return len(values)
@@ -45,6 +52,7 @@ func (queue *ArrayQueue[T]) Dequeue() (T, bool) {
 
 	toRemove := queue.values[0]
 	queue.values = queue.values[1:]
+	queue.values = growth.Shrink(queue.policy, queue.values)
 	return toRemove, true
 }
 
@@ -74,25 +82,35 @@ func (queue *ArrayQueue[T]) Iterator() itrs.Iterater[T] {
 
 // Clear implements the Queuer interface.
 func (queue *ArrayQueue[T]) Clear() {
-	queue.values = make([]T, 0)
+	queue.values = make([]T, 0, queue.policy.Reserve)
 }
 
-// GoString implements the Queuer interface.
-func (queue *ArrayQueue[T]) GoString() string {
-	values := make([]string, 0, len(queue.values))
-	for _, value := range queue.values {
-		values = append(values, gcstr.GoStringOf(value))
+// layout returns the description of the queue used to format it.
+func (queue *ArrayQueue[T]) layout() display.Container[T] {
+	return display.Container[T]{
+		Pkg:      "queue",
+		Name:     "ArrayQueue",
+		TypeArgs: []string{display.TypeName[T]()},
+		Options:  queue.policy.Options(),
+		Capacity: -1,
+		Marker:   display.Front,
+		Values:   queue.values,
 	}
+}
 
-	var builder strings.Builder
+// Format implements the fmt.Formatter interface.
+func (queue *ArrayQueue[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, queue.layout())
+}
 
-	builder.WriteString("ArrayQueue{size=")
-	builder.WriteString(strconv.Itoa(len(queue.values)))
-	builder.WriteString(", values=[← ")
-	builder.WriteString(strings.Join(values, ", "))
-	builder.WriteString("]}")
+// String implements the fmt.Stringer interface.
+func (queue *ArrayQueue[T]) String() string {
+	return fmt.Sprint(queue)
+}
 
-	return builder.String()
+// GoString implements the fmt.GoStringer interface.
+func (queue *ArrayQueue[T]) GoString() string {
+	return queue.layout().GoSyntax()
 }
 
 // Slice implements the Queuer interface.
@@ -116,12 +134,50 @@ func (queue *ArrayQueue[T]) IsFull() bool {
 // NewArrayQueue is a function that creates and returns a new instance of a
 // ArrayQueue.
 //
+// Parameters:
+//   - opts: The options of the queue. Supported options are WithInitialValues,
+//     whose first value ends up at the front, WithGrowthFactor, WithReserve and
+//     WithShrinkThreshold.
+//
 // Returns:
-//   - *ArrayQueue[T]: A pointer to the newly created ArrayQueue. Never returns nil.
-func NewArrayQueue[T any]() *ArrayQueue[T] {
-	return &ArrayQueue[T]{
-		values: make([]T, 0),
+//   - *ArrayQueue[T]: A pointer to the newly created ArrayQueue.
+//   - error: An error if the options are invalid.
+func NewArrayQueue[T any](opts ...options.Option) (*ArrayQueue[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
 	}
+
+	err = s.Only("ArrayQueue", array_queue_options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new_array_queue[T](s)
+}
+
+// new_array_queue creates a new ArrayQueue from validated settings.
+//
+// Parameters:
+//   - s: The settings. Assumed to be non-nil.
+//
+// Returns:
+//   - *ArrayQueue[T]: The new queue.
+//   - error: An error if the initial values are invalid.
+func new_array_queue[T any](s *options.Settings) (*ArrayQueue[T], error) {
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := growth.New(s)
+
+	queue := &ArrayQueue[T]{
+		values: growth.Clone(policy, values),
+		policy: policy,
+	}
+
+	return queue, nil
 }
 
 // Copy is a method of the ArrayQueue type. It is used to create a shallow copy
@@ -131,9 +187,43 @@ func NewArrayQueue[T any]() *ArrayQueue[T] {
 //   - *ArrayQueue[T]: A shallow copy of the queue.
 func (queue *ArrayQueue[T]) Copy() *ArrayQueue[T] {
 	queue_copy := &ArrayQueue[T]{
-		values: make([]T, len(queue.values)),
+		values: growth.Clone(queue.policy, queue.values),
+		policy: queue.policy,
 	}
-	copy(queue_copy.values, queue.values)
 
 	return queue_copy
 }
+
+// forEach implements the walker interface.
+func (queue *ArrayQueue[T]) forEach(f func(idx int, value T) bool) {
+	for i, value := range queue.values {
+		if !f(i, value) {
+			return
+		}
+	}
+}
+
+// removeFunc implements the walker interface.
+func (queue *ArrayQueue[T]) removeFunc(pred func(value T) bool, all bool) int {
+	var count int
+
+	queue.values, count = remove_values(queue.values, pred, all)
+	queue.values = growth.Shrink(queue.policy, queue.values)
+
+	return count
+}
+
+// Reserve is a method of the ArrayQueue type. It makes room for at least n more
+// elements so that adding them does not reallocate the backing array.
+//
+// Parameters:
+//   - n: The number of elements to make room for. Non-positive values are a no-op.
+func (queue *ArrayQueue[T]) Reserve(n int) {
+	queue.values = growth.Reserve(queue.policy, queue.values, n)
+}
+
+// ShrinkToFit is a method of the ArrayQueue type. It releases the unused part of
+// the backing array, except for the reserve the queue was created with.
+func (queue *ArrayQueue[T]) ShrinkToFit() {
+	queue.values = growth.Fit(queue.policy, queue.values)
+}
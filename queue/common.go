@@ -3,6 +3,8 @@ package queue
 import (
 	"fmt"
 
+	"github.com/PlayerR9/listlike/display"
+
 	itrs "github.com/PlayerR9/iterators/simple"
 )
 
@@ -87,6 +89,39 @@ type Queuer[T any] interface {
 	fmt.GoStringer
 }
 
+// layouter is implemented by the queues that describe themselves to be formatted.
+type layouter[T any] interface {
+	// layout returns the description of the queue used to format it.
+	layout() display.Container[T]
+}
+
+// format_wrapped formats a queue that wraps another one. If the wrapped queue
+// describes itself, the detailed form gives the label prefix; otherwise, it
+// formats itself.
+//
+// Parameters:
+//   - f: The state of the formatter.
+//   - verb: The verb.
+//   - prefix: The prefix of the label of the detailed form, such as "Observed".
+//   - queue: The wrapped queue.
+func format_wrapped[T any](f fmt.State, verb rune, prefix string, queue Queuer[T]) {
+	l, ok := queue.(layouter[T])
+	if !ok {
+		fmt.Fprintf(f, fmt.FormatString(f, verb), queue)
+		return
+	}
+
+	c := l.layout()
+
+	if c.Label == "" {
+		c.Label = c.Name
+	}
+
+	c.Label = prefix + c.Label
+
+	display.Format(f, verb, c)
+}
+
 // queue_node represents a node in a linked queue.
 type queue_node[T any] struct {
 	// value is the value stored in the node.
@@ -104,3 +139,15 @@ type queue_safe_node[T any] struct {
 	// next is a pointer to the next queueLinkedNode in the queue.
 	next *queue_safe_node[T]
 }
+
+// unique_node represents a node in a UniqueQueue.
+type unique_node[T any, K comparable] struct {
+	// value is the value stored in the node.
+	value T
+
+	// key is the key of the value, computed once when it was enqueued.
+	key K
+
+	// next is a pointer to the next node in the queue.
+	next *unique_node[T, K]
+}
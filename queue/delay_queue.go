@@ -0,0 +1,578 @@
+package queue
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/PlayerR9/listlike/clock"
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+
+	itrs "github.com/PlayerR9/iterators/simple"
+)
+
+// delay_item is an element of a DelayQueue.
+type delay_item[T any] struct {
+	// value is the value of the element.
+	value T
+
+	// at is the time from which the element can be dequeued.
+	at time.Time
+
+	// seq is the order in which the element was enqueued. It breaks the ties
+	// between equal deadlines.
+	seq uint64
+}
+
+// Format implements the fmt.Formatter interface.
+//
+// The value is formatted with the verb, followed by "@" and its deadline; such as
+// "5@2024-01-02T15:04:05Z".
+func (item delay_item[T]) Format(f fmt.State, verb rune) {
+	fmt.Fprintf(f, fmt.FormatString(f, verb)+"@%s", item.value, item.at.Format(time.RFC3339Nano))
+}
+
+// delay_heap is a min-heap of delay items, ordered by deadline and then by
+// enqueue order. It implements heap.Interface.
+type delay_heap[T any] []delay_item[T]
+
+// Len implements the heap.Interface interface.
+func (h delay_heap[T]) Len() int {
+	return len(h)
+}
+
+// Less implements the heap.Interface interface.
+func (h delay_heap[T]) Less(i, j int) bool {
+	if h[i].at.Equal(h[j].at) {
+		return h[i].seq < h[j].seq
+	}
+
+	return h[i].at.Before(h[j].at)
+}
+
+// Swap implements the heap.Interface interface.
+func (h delay_heap[T]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+// Push implements the heap.Interface interface.
+func (h *delay_heap[T]) Push(x any) {
+	*h = append(*h, x.(delay_item[T]))
+}
+
+// Pop implements the heap.Interface interface.
+func (h *delay_heap[T]) Pop() any {
+	old := *h
+	n := len(old)
+
+	item := old[n-1]
+	old[n-1] = delay_item[T]{} // Release the reference to the value.
+
+	*h = old[:n-1]
+
+	return item
+}
+
+// DelayQueue is a generic type that represents a thread-safe queue whose elements
+// can only be dequeued once their deadline has passed. Due elements are dequeued
+// in the order of their deadlines, and in the order in which they were enqueued
+// when the deadlines are equal.
+//
+// It does not implement the Queuer interface itself since its elements are not
+// always visible; see View for a Queuer that only sees the due elements.
+type DelayQueue[T any] struct {
+	// items is the heap of the elements.
+	items delay_heap[T]
+
+	// seq is the sequence number of the next element.
+	seq uint64
+
+	// capacity is the maximum number of elements the queue can hold. -1 means
+	// that the queue is unlimited.
+	capacity int
+
+	// clock is the clock that tells when the elements are due.
+	clock clock.Clock
+
+	// changed is closed, then replaced, whenever an element is enqueued, so that the
+	// goroutines waiting in DequeueCtx look at the new deadline.
+	changed chan struct{}
+
+	// mu guards the fields of the queue.
+	mu sync.Mutex
+}
+
+// NewDelayQueue is a function that creates and returns a new instance of a
+// DelayQueue.
+//
+// Parameters:
+//   - opts: The options of the queue. Supported options are WithCapacity, without
+//     which the queue is unlimited, WithInitialValues, which are due immediately,
+//     and WithClock, without which the clock of the system is used.
+//
+// Returns:
+//   - *DelayQueue[T]: A pointer to the newly created DelayQueue.
+//   - error: An error if the options are invalid.
+func NewDelayQueue[T any](opts ...options.Option) (*DelayQueue[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Only("DelayQueue", "WithCapacity", "WithInitialValues", "WithClock")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
+	}
+
+	c := s.Clock
+	if c == nil {
+		c = clock.System()
+	}
+
+	queue := &DelayQueue[T]{
+		capacity: s.Capacity,
+		clock:    c,
+		changed:  make(chan struct{}),
+	}
+
+	now := c.Now()
+
+	for _, value := range values {
+		queue.EnqueueAt(value, now)
+	}
+
+	return queue, nil
+}
+
+// EnqueueAt is a method of the DelayQueue type. It adds a value that becomes due at
+// the given time.
+//
+// Parameters:
+//   - value: The value to add.
+//   - at: The time from which the value can be dequeued.
+//
+// Returns:
+//   - bool: True if the value was added, false if the queue is full.
+func (queue *DelayQueue[T]) EnqueueAt(value T, at time.Time) bool {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if queue.capacity != -1 && len(queue.items) >= queue.capacity {
+		return false
+	}
+
+	heap.Push(&queue.items, delay_item[T]{
+		value: value,
+		at:    at,
+		seq:   queue.seq,
+	})
+
+	queue.seq++
+
+	close(queue.changed)
+	queue.changed = make(chan struct{})
+
+	return true
+}
+
+// EnqueueAfter is a method of the DelayQueue type. It adds a value that becomes
+// due once the given duration has elapsed.
+//
+// Parameters:
+//   - value: The value to add.
+//   - d: The delay. Non-positive delays make the value due immediately.
+//
+// Returns:
+//   - bool: True if the value was added, false if the queue is full.
+func (queue *DelayQueue[T]) EnqueueAfter(value T, d time.Duration) bool {
+	return queue.EnqueueAt(value, queue.clock.Now().Add(d))
+}
+
+// TryDequeue is a method of the DelayQueue type. It removes the next due element
+// without waiting.
+//
+// Returns:
+//   - T: The value of the element.
+//   - bool: True if an element was due, false otherwise.
+func (queue *DelayQueue[T]) TryDequeue() (T, bool) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	return queue.popDue(queue.clock.Now())
+}
+
+// DequeueCtx is a method of the DelayQueue type. It removes the next element,
+// waiting until one is due or until the context is done.
+//
+// Parameters:
+//   - ctx: The context.
+//
+// Returns:
+//   - T: The value of the element.
+//   - error: The error of the context if it is done before an element is due.
+func (queue *DelayQueue[T]) DequeueCtx(ctx context.Context) (T, error) {
+	for {
+		queue.mu.Lock()
+
+		now := queue.clock.Now()
+
+		value, ok := queue.popDue(now)
+		if ok {
+			queue.mu.Unlock()
+			return value, nil
+		}
+
+		changed := queue.changed
+
+		var timer clock.Timer
+
+		if len(queue.items) > 0 {
+			timer = queue.clock.NewTimer(queue.items[0].at.Sub(now))
+		}
+
+		queue.mu.Unlock()
+
+		var fired <-chan time.Time
+
+		if timer != nil {
+			fired = timer.C()
+		}
+
+		select {
+		case <-ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+
+			return *new(T), ctx.Err()
+		case <-changed:
+			if timer != nil {
+				timer.Stop()
+			}
+		case <-fired:
+		}
+	}
+}
+
+// PeekNext is a method of the DelayQueue type. It returns the element with the
+// earliest deadline, whether it is due or not.
+//
+// Returns:
+//   - T: The value of the element.
+//   - time.Time: The deadline of the element.
+//   - bool: True if the queue is not empty, false otherwise.
+func (queue *DelayQueue[T]) PeekNext() (T, time.Time, bool) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if len(queue.items) == 0 {
+		return *new(T), time.Time{}, false
+	}
+
+	return queue.items[0].value, queue.items[0].at, true
+}
+
+// Size is a method of the DelayQueue type. It returns the number of elements in
+// the queue, due or not.
+//
+// Returns:
+//   - int: The number of elements.
+func (queue *DelayQueue[T]) Size() int {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	return len(queue.items)
+}
+
+// Capacity is a method of the DelayQueue type. It returns the maximum number of
+// elements the queue can hold.
+//
+// Returns:
+//   - int: The capacity. -1 if the queue is unlimited.
+func (queue *DelayQueue[T]) Capacity() int {
+	return queue.capacity
+}
+
+// Clear is a method of the DelayQueue type. It removes all the elements, due or
+// not.
+func (queue *DelayQueue[T]) Clear() {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	queue.items = nil
+}
+
+// layout returns the description of the queue used to format it.
+func (queue *DelayQueue[T]) layout() display.Container[delay_item[T]] {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	var opts []string
+
+	if queue.capacity != -1 {
+		opts = append(opts, display.Option("WithCapacity", queue.capacity))
+	}
+
+	// The clock cannot be rebuilt, so the elements are enqueued at their deadline.
+	return display.Container[delay_item[T]]{
+		Pkg:      "queue",
+		Name:     "DelayQueue",
+		TypeArgs: []string{display.TypeName[T]()},
+		Options:  opts,
+		Insert:   "EnqueueAt",
+		GoArgs: func(item delay_item[T]) string {
+			return fmt.Sprintf("%#v, time.Unix(0, %d)", item.value, item.at.UnixNano())
+		},
+		Capacity: queue.capacity,
+		Marker:   display.Front,
+		Values:   queue.sorted(),
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (queue *DelayQueue[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, queue.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (queue *DelayQueue[T]) String() string {
+	return fmt.Sprint(queue)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (queue *DelayQueue[T]) GoString() string {
+	return queue.layout().GoSyntax()
+}
+
+// View is a method of the DelayQueue type. It returns a Queuer that only sees the
+// due elements of the queue: Peek, Dequeue, Size, Slice and the like ignore the
+// elements whose deadline has not passed yet. Values enqueued through the view are
+// due immediately.
+//
+// The view shares the elements of the queue and is safe for concurrent use.
+//
+// Returns:
+//   - Queuer[T]: The view. Never returns nil.
+func (queue *DelayQueue[T]) View() Queuer[T] {
+	return &delay_view[T]{
+		queue: queue,
+	}
+}
+
+// popDue removes the first element if it is due. The caller must hold the lock.
+//
+// Parameters:
+//   - now: The current time.
+//
+// Returns:
+//   - T: The value of the element.
+//   - bool: True if an element was due, false otherwise.
+func (queue *DelayQueue[T]) popDue(now time.Time) (T, bool) {
+	if len(queue.items) == 0 || queue.items[0].at.After(now) {
+		return *new(T), false
+	}
+
+	item := heap.Pop(&queue.items).(delay_item[T])
+
+	return item.value, true
+}
+
+// sorted returns the elements in the order in which they would be dequeued. The
+// caller must hold the lock.
+//
+// Returns:
+//   - []delay_item[T]: The elements.
+func (queue *DelayQueue[T]) sorted() []delay_item[T] {
+	items := make(delay_heap[T], len(queue.items))
+	copy(items, queue.items)
+
+	sorted := make([]delay_item[T], 0, len(items))
+
+	for len(items) > 0 {
+		sorted = append(sorted, heap.Pop(&items).(delay_item[T]))
+	}
+
+	return sorted
+}
+
+// due returns the values of the due elements, in the order in which they would be
+// dequeued. The caller must hold the lock.
+//
+// Parameters:
+//   - now: The current time.
+//
+// Returns:
+//   - []T: The values.
+func (queue *DelayQueue[T]) due(now time.Time) []T {
+	var values []T
+
+	for _, item := range queue.sorted() {
+		if item.at.After(now) {
+			break
+		}
+
+		values = append(values, item.value)
+	}
+
+	return values
+}
+
+// delay_view is the Queuer returned by DelayQueue.View.
+type delay_view[T any] struct {
+	// queue is the viewed queue.
+	queue *DelayQueue[T]
+}
+
+// Enqueue implements the Queuer interface.
+//
+// The value is due immediately.
+func (v *delay_view[T]) Enqueue(value T) bool {
+	return v.queue.EnqueueAfter(value, 0)
+}
+
+// EnqueueMany implements the Queuer interface.
+//
+// The values are due immediately.
+func (v *delay_view[T]) EnqueueMany(values []T) int {
+	for i, value := range values {
+		ok := v.Enqueue(value)
+		if !ok {
+			return i
+		}
+	}
+
+	return len(values)
+}
+
+// Dequeue implements the Queuer interface.
+//
+// It does not wait; see DelayQueue.DequeueCtx.
+func (v *delay_view[T]) Dequeue() (T, bool) {
+	return v.queue.TryDequeue()
+}
+
+// Peek implements the Queuer interface.
+//
+// Only due elements are returned.
+func (v *delay_view[T]) Peek() (T, bool) {
+	v.queue.mu.Lock()
+	defer v.queue.mu.Unlock()
+
+	items := v.queue.items
+
+	if len(items) == 0 || items[0].at.After(v.queue.clock.Now()) {
+		return *new(T), false
+	}
+
+	return items[0].value, true
+}
+
+// IsEmpty implements the Queuer interface.
+//
+// Returns true if no element is due.
+func (v *delay_view[T]) IsEmpty() bool {
+	_, ok := v.Peek()
+	return !ok
+}
+
+// Size implements the Queuer interface.
+//
+// Only due elements are counted.
+func (v *delay_view[T]) Size() int {
+	v.queue.mu.Lock()
+	defer v.queue.mu.Unlock()
+
+	now := v.queue.clock.Now()
+
+	var count int
+
+	for _, item := range v.queue.items {
+		if !item.at.After(now) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Clear implements the Queuer interface.
+//
+// All the elements are removed, due or not.
+func (v *delay_view[T]) Clear() {
+	v.queue.Clear()
+}
+
+// Capacity implements the Queuer interface.
+func (v *delay_view[T]) Capacity() int {
+	return v.queue.capacity
+}
+
+// IsFull implements the Queuer interface.
+//
+// Elements that are not due count towards the capacity.
+func (v *delay_view[T]) IsFull() bool {
+	return v.queue.capacity != -1 && v.queue.Size() >= v.queue.capacity
+}
+
+// Slice implements the Queuer interface.
+//
+// Only due elements are returned.
+func (v *delay_view[T]) Slice() []T {
+	v.queue.mu.Lock()
+	defer v.queue.mu.Unlock()
+
+	return v.queue.due(v.queue.clock.Now())
+}
+
+// Iterator implements the Queuer interface.
+//
+// Only due elements are iterated over.
+func (v *delay_view[T]) Iterator() itrs.Iterater[T] {
+	return itrs.NewSimpleIterator(v.Slice())
+}
+
+// layout returns the description of the view used to format it.
+func (v *delay_view[T]) layout() display.Container[T] {
+	c := display.Container[T]{
+		Pkg:      "queue",
+		Name:     "DelayQueue",
+		Label:    "DelayQueueView",
+		TypeArgs: []string{display.TypeName[T]()},
+		Iface:    "Queuer",
+		Method:   "View",
+		Capacity: v.queue.capacity,
+		Marker:   display.Front,
+		Values:   v.Slice(),
+	}
+
+	// The view only holds the due elements, which are due as soon as they are
+	// enqueued in the rebuilt queue.
+	if c.Capacity != -1 {
+		c.Options = []string{display.Option("WithCapacity", c.Capacity)}
+	}
+
+	return c
+}
+
+// Format implements the fmt.Formatter interface.
+func (v *delay_view[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, v.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (v *delay_view[T]) String() string {
+	return fmt.Sprint(v)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (v *delay_view[T]) GoString() string {
+	return v.layout().GoSyntax()
+}
@@ -0,0 +1,210 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/PlayerR9/listlike/clock"
+	"github.com/PlayerR9/listlike/options"
+)
+
+// new_delay_queue returns a delay queue driven by a fake clock.
+func new_delay_queue(t *testing.T) (*DelayQueue[int], *clock.Fake) {
+	t.Helper()
+
+	fake := clock.NewFake(time.Unix(0, 0))
+
+	queue, err := NewDelayQueue[int](options.WithClock(fake))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return queue, fake
+}
+
+// wait_timers waits until n timers of the clock are pending; that is, until a
+// goroutine is blocked in DequeueCtx.
+func wait_timers(t *testing.T, fake *clock.Fake, n int) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+
+	for fake.Timers() != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d pending timers, want %d", fake.Timers(), n)
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// TestDelayQueueEnqueueAfterOrder checks that elements are dequeued by deadline
+// and, for equal deadlines, in the order in which they were enqueued.
+func TestDelayQueueEnqueueAfterOrder(t *testing.T) {
+	queue, fake := new_delay_queue(t)
+
+	queue.EnqueueAfter(1, 2*time.Second)
+	queue.EnqueueAfter(2, time.Second)
+	queue.EnqueueAfter(3, 2*time.Second)
+	queue.EnqueueAfter(4, time.Second)
+	queue.EnqueueAfter(5, 2*time.Second)
+
+	if _, ok := queue.TryDequeue(); ok {
+		t.Fatal("an element was due before its deadline")
+	}
+
+	fake.Advance(2 * time.Second)
+
+	want := []int{2, 4, 1, 3, 5}
+
+	for _, w := range want {
+		got, ok := queue.TryDequeue()
+		if !ok || got != w {
+			t.Fatalf("TryDequeue() = %d, %t; want %d, true", got, ok, w)
+		}
+	}
+
+	if _, ok := queue.TryDequeue(); ok {
+		t.Fatal("TryDequeue succeeded on an empty queue")
+	}
+}
+
+// TestDelayQueueDequeueCtxAdvance checks that DequeueCtx waits until the clock
+// reaches the deadline of the next element.
+func TestDelayQueueDequeueCtxAdvance(t *testing.T) {
+	queue, fake := new_delay_queue(t)
+
+	queue.EnqueueAfter(7, time.Minute)
+
+	type result struct {
+		value int
+		err   error
+	}
+
+	done := make(chan result, 1)
+
+	go func() {
+		value, err := queue.DequeueCtx(context.Background())
+		done <- result{value, err}
+	}()
+
+	wait_timers(t, fake, 1)
+
+	fake.Advance(30 * time.Second)
+
+	select {
+	case r := <-done:
+		t.Fatalf("DequeueCtx returned %v before the deadline", r)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	fake.Advance(30 * time.Second)
+
+	select {
+	case r := <-done:
+		if r.err != nil || r.value != 7 {
+			t.Fatalf("DequeueCtx() = %d, %v; want 7, nil", r.value, r.err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("DequeueCtx did not wake up at the deadline")
+	}
+}
+
+// TestDelayQueueDequeueCtxEnqueue checks that DequeueCtx on an empty queue wakes up
+// when an element is enqueued.
+func TestDelayQueueDequeueCtxEnqueue(t *testing.T) {
+	queue, fake := new_delay_queue(t)
+
+	done := make(chan int, 1)
+
+	go func() {
+		value, _ := queue.DequeueCtx(context.Background())
+		done <- value
+	}()
+
+	// Give the goroutine the time to block; no timer is created for an empty queue.
+	time.Sleep(20 * time.Millisecond)
+
+	queue.EnqueueAfter(3, time.Second)
+	wait_timers(t, fake, 1)
+	fake.Advance(time.Second)
+
+	select {
+	case got := <-done:
+		if got != 3 {
+			t.Fatalf("got %d, want 3", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("DequeueCtx did not wake up")
+	}
+}
+
+// TestDelayQueueDequeueCtxCancel checks that DequeueCtx returns the error of the
+// context when it is canceled before an element is due.
+func TestDelayQueueDequeueCtxCancel(t *testing.T) {
+	queue, fake := new_delay_queue(t)
+
+	queue.EnqueueAfter(1, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error, 1)
+
+	go func() {
+		_, err := queue.DequeueCtx(ctx)
+		done <- err
+	}()
+
+	wait_timers(t, fake, 1)
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("got error %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("DequeueCtx did not return on cancel")
+	}
+
+	wait_timers(t, fake, 0)
+
+	if queue.Size() != 1 {
+		t.Fatalf("got size %d, want 1", queue.Size())
+	}
+}
+
+// TestDelayQueueViewPeek checks that the view ignores the elements that are not
+// due yet.
+func TestDelayQueueViewPeek(t *testing.T) {
+	queue, fake := new_delay_queue(t)
+	view := queue.View()
+
+	queue.EnqueueAfter(1, time.Minute)
+
+	if _, ok := view.Peek(); ok {
+		t.Fatal("Peek returned an element that is not due")
+	}
+
+	if !view.IsEmpty() || view.Size() != 0 {
+		t.Fatal("the view counts an element that is not due")
+	}
+
+	queue.EnqueueAfter(2, time.Second)
+	fake.Advance(time.Second)
+
+	got, ok := view.Peek()
+	if !ok || got != 2 {
+		t.Fatalf("Peek() = %d, %t; want 2, true", got, ok)
+	}
+
+	if view.Size() != 1 {
+		t.Fatalf("got size %d, want 1", view.Size())
+	}
+
+	if queue.Size() != 2 {
+		t.Fatalf("got queue size %d, want 2", queue.Size())
+	}
+}
@@ -1,12 +1,12 @@
 package queue
 
 import (
-	"strconv"
-	"strings"
+	"fmt"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/internal/growth"
+	"github.com/PlayerR9/listlike/options"
 
-	gcers "github.com/PlayerR9/go-commons/errors"
-	gcint "github.com/PlayerR9/go-commons/ints"
-	gcstr "github.com/PlayerR9/go-commons/strings"
 	itrs "github.com/PlayerR9/iterators/simple"
 )
 
@@ -16,16 +16,28 @@ type LimitedArrayQueue[T any] struct {
 	// values is a slice of type T that stores the elements in the queue.
 	values []T
 
-	// capacity is the maximum number of elements the queue can hold.
+	// capacity is the maximum number of elements the queue can hold. -1 means
+	// that the queue is unlimited.
 	capacity int
+
+	// overflow is what the queue does when a value is enqueued while it is full.
+	overflow options.OverflowPolicy
+
+	// policy is the capacity policy of the values.
+	policy growth.Policy
 }
 
 // Enqueue implements the Queuer interface.
 func (queue *LimitedArrayQueue[T]) Enqueue(value T) bool {
-	if len(queue.values) >= queue.capacity {
-		return false
+	if queue.capacity != -1 && len(queue.values) >= queue.capacity {
+		if queue.overflow != options.DropOldest || queue.capacity == 0 {
+			return false
+		}
+
+		queue.Dequeue()
 	}
 
+	queue.values = growth.Grow(queue.policy, queue.values, 1)
 	queue.values = append(queue.values, value)
 
 	return true
@@ -55,6 +67,7 @@ func (queue *LimitedArrayQueue[T]) Dequeue() (T, bool) {
 
 	toRemove := queue.values[0]
 	queue.values = queue.values[1:]
+	queue.values = growth.Shrink(queue.policy, queue.values)
 	return toRemove, true
 }
 
@@ -89,32 +102,50 @@ func (queue *LimitedArrayQueue[T]) Iterator() itrs.Iterater[T] {
 
 // Clear implements the Queuer interface.
 func (queue *LimitedArrayQueue[T]) Clear() {
-	queue.values = make([]T, 0, queue.capacity)
+	queue.values = make([]T, 0, queue.policy.Reserve)
 }
 
 // IsFull implements the Queuer interface.
 func (queue *LimitedArrayQueue[T]) IsFull() bool {
-	return len(queue.values) >= queue.capacity
+	return queue.capacity != -1 && len(queue.values) >= queue.capacity
 }
 
-// GoString implements the fmt.GoStringer interface.
-func (queue *LimitedArrayQueue[T]) GoString() string {
-	values := make([]string, 0, len(queue.values))
-	for _, value := range queue.values {
-		values = append(values, gcstr.GoStringOf(value))
+// layout returns the description of the queue used to format it.
+func (queue *LimitedArrayQueue[T]) layout() display.Container[T] {
+	var opts []string
+
+	if queue.capacity != -1 {
+		opts = append(opts, display.Option("WithCapacity", queue.capacity))
+	}
+
+	if queue.overflow != options.Reject {
+		opts = append(opts, display.Option("WithOverflowPolicy", queue.overflow))
+	}
+
+	return display.Container[T]{
+		Pkg:      "queue",
+		Name:     "LimitedArrayQueue",
+		TypeArgs: []string{display.TypeName[T]()},
+		Options:  append(opts, queue.policy.Options()...),
+		Capacity: queue.capacity,
+		Marker:   display.Front,
+		Values:   queue.values,
 	}
+}
 
-	var builder strings.Builder
+// Format implements the fmt.Formatter interface.
+func (queue *LimitedArrayQueue[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, queue.layout())
+}
 
-	builder.WriteString("LimitedArrayQueue[capacity=")
-	builder.WriteString(strconv.Itoa(queue.capacity))
-	builder.WriteString(", size=")
-	builder.WriteString(strconv.Itoa(len(queue.values)))
-	builder.WriteString(", values=[← ")
-	builder.WriteString(strings.Join(values, ", "))
-	builder.WriteString("]]")
+// String implements the fmt.Stringer interface.
+func (queue *LimitedArrayQueue[T]) String() string {
+	return fmt.Sprint(queue)
+}
 
-	return builder.String()
+// GoString implements the fmt.GoStringer interface.
+func (queue *LimitedArrayQueue[T]) GoString() string {
+	return queue.layout().GoSyntax()
 }
 
 // Slice implements the Queuer interface.
@@ -129,20 +160,52 @@ func (queue *LimitedArrayQueue[T]) Slice() []T {
 // LimitedArrayQueue.
 //
 // Parameters:
-//   - capacity: The maximum number of elements the queue can hold.
+//   - opts: The options of the queue. Supported options are WithCapacity, without
+//     which the queue is unlimited, WithInitialValues, whose first value ends up at
+//     the front, WithOverflowPolicy, WithGrowthFactor, WithReserve and
+//     WithShrinkThreshold.
 //
 // Returns:
 //   - *LimitedArrayQueue[T]: A pointer to the newly created LimitedArrayQueue.
-//   - error: An error of type *common.ErrInvalidParameter if the capacity is less
-//     than 0.
-func NewLimitedArrayQueue[T any](capacity int) (*LimitedArrayQueue[T], error) {
-	if capacity < 0 {
-		return nil, gcers.NewErrInvalidParameter("capacity", gcint.NewErrGTE(0))
+//   - error: An error if the options are invalid.
+func NewLimitedArrayQueue[T any](opts ...options.Option) (*LimitedArrayQueue[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Only("LimitedArrayQueue", limited_array_queue_options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new_limited_array_queue[T](s)
+}
+
+// new_limited_array_queue creates a new LimitedArrayQueue from validated settings.
+//
+// Parameters:
+//   - s: The settings. Assumed to be non-nil.
+//
+// Returns:
+//   - *LimitedArrayQueue[T]: The new queue.
+//   - error: An error if the initial values are invalid.
+func new_limited_array_queue[T any](s *options.Settings) (*LimitedArrayQueue[T], error) {
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
 	}
 
-	return &LimitedArrayQueue[T]{
-		values: make([]T, 0, capacity),
-	}, nil
+	policy := growth.New(s)
+
+	queue := &LimitedArrayQueue[T]{
+		values:   growth.Clone(policy, values),
+		capacity: s.Capacity,
+		overflow: s.Overflow,
+		policy:   policy,
+	}
+
+	return queue, nil
 }
 
 // Copy is a method of the LimitedArrayQueue type. It is used to create a shallow
@@ -152,10 +215,46 @@ func NewLimitedArrayQueue[T any](capacity int) (*LimitedArrayQueue[T], error) {
 //   - *LimitedArrayQueue[T]: A shallow copy of the queue.
 func (queue *LimitedArrayQueue[T]) Copy() *LimitedArrayQueue[T] {
 	queue_copy := &LimitedArrayQueue[T]{
-		values:   make([]T, len(queue.values)),
+		values:   growth.Clone(queue.policy, queue.values),
 		capacity: queue.capacity,
+		overflow: queue.overflow,
+		policy:   queue.policy,
 	}
-	copy(queue_copy.values, queue.values)
 
 	return queue_copy
 }
+
+// forEach implements the walker interface.
+func (queue *LimitedArrayQueue[T]) forEach(f func(idx int, value T) bool) {
+	for i, value := range queue.values {
+		if !f(i, value) {
+			return
+		}
+	}
+}
+
+// removeFunc implements the walker interface.
+func (queue *LimitedArrayQueue[T]) removeFunc(pred func(value T) bool, all bool) int {
+	var count int
+
+	queue.values, count = remove_values(queue.values, pred, all)
+	queue.values = growth.Shrink(queue.policy, queue.values)
+
+	return count
+}
+
+// Reserve is a method of the LimitedArrayQueue type. It makes room for at least n
+// more elements so that adding them does not reallocate the backing array. The room
+// is capped at the capacity of the queue.
+//
+// Parameters:
+//   - n: The number of elements to make room for. Non-positive values are a no-op.
+func (queue *LimitedArrayQueue[T]) Reserve(n int) {
+	queue.values = growth.Reserve(queue.policy, queue.values, n)
+}
+
+// ShrinkToFit is a method of the LimitedArrayQueue type. It releases the unused
+// part of the backing array, except for the reserve the queue was created with.
+func (queue *LimitedArrayQueue[T]) ShrinkToFit() {
+	queue.values = growth.Fit(queue.policy, queue.values)
+}
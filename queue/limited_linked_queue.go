@@ -1,12 +1,11 @@
 package queue
 
 import (
-	"strconv"
-	"strings"
+	"fmt"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
 
-	gcers "github.com/PlayerR9/go-commons/errors"
-	gcint "github.com/PlayerR9/go-commons/ints"
-	gcstr "github.com/PlayerR9/go-commons/strings"
 	itrs "github.com/PlayerR9/iterators/simple"
 )
 
@@ -20,14 +19,22 @@ type LimitedLinkedQueue[T any] struct {
 	// size is the current number of elements in the queue.
 	size int
 
-	// capacity is the maximum number of elements the queue can hold.
+	// capacity is the maximum number of elements the queue can hold. -1 means
+	// that the queue is unlimited.
 	capacity int
+
+	// overflow is what the queue does when a value is enqueued while it is full.
+	overflow options.OverflowPolicy
 }
 
 // Enqueue implements the Queuer interface.
 func (queue *LimitedLinkedQueue[T]) Enqueue(value T) bool {
-	if queue.size >= queue.capacity {
-		return false
+	if queue.capacity != -1 && queue.size >= queue.capacity {
+		if queue.overflow != options.DropOldest || queue.capacity == 0 {
+			return false
+		}
+
+		queue.Dequeue()
 	}
 
 	queue_node := &queue_node[T]{
@@ -142,27 +149,45 @@ func (queue *LimitedLinkedQueue[T]) Clear() {
 
 // IsFull implements the Queuer interface.
 func (queue *LimitedLinkedQueue[T]) IsFull() bool {
-	return queue.size >= queue.capacity
+	return queue.capacity != -1 && queue.size >= queue.capacity
 }
 
-// GoString implements the fmt.GoStringer interface.
-func (queue *LimitedLinkedQueue[T]) GoString() string {
-	values := make([]string, 0, queue.size)
-	for queue_node := queue.front; queue_node != nil; queue_node = queue_node.next {
-		values = append(values, gcstr.GoStringOf(queue_node.value))
+// layout returns the description of the queue used to format it.
+func (queue *LimitedLinkedQueue[T]) layout() display.Container[T] {
+	var opts []string
+
+	if queue.capacity != -1 {
+		opts = append(opts, display.Option("WithCapacity", queue.capacity))
+	}
+
+	if queue.overflow != options.Reject {
+		opts = append(opts, display.Option("WithOverflowPolicy", queue.overflow))
 	}
 
-	var builder strings.Builder
+	return display.Container[T]{
+		Pkg:      "queue",
+		Name:     "LimitedLinkedQueue",
+		TypeArgs: []string{display.TypeName[T]()},
+		Options:  opts,
+		Capacity: queue.capacity,
+		Marker:   display.Front,
+		Values:   queue.Slice(),
+	}
+}
 
-	builder.WriteString("LimitedLinkedQueue[capacity=")
-	builder.WriteString(strconv.Itoa(queue.capacity))
-	builder.WriteString(", size=")
-	builder.WriteString(strconv.Itoa(queue.size))
-	builder.WriteString(", values=[← ")
-	builder.WriteString(strings.Join(values, ", "))
-	builder.WriteString("]]")
+// Format implements the fmt.Formatter interface.
+func (queue *LimitedLinkedQueue[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, queue.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (queue *LimitedLinkedQueue[T]) String() string {
+	return fmt.Sprint(queue)
+}
 
-	return builder.String()
+// GoString implements the fmt.GoStringer interface.
+func (queue *LimitedLinkedQueue[T]) GoString() string {
+	return queue.layout().GoSyntax()
 }
 
 // Slice implements the Queuer interface.
@@ -180,20 +205,50 @@ func (queue *LimitedLinkedQueue[T]) Slice() []T {
 // LimitedLinkedQueue.
 //
 // Parameters:
-//   - capacity: The maximum number of elements the queue can hold.
+//   - opts: The options of the queue. Supported options are WithCapacity, without
+//     which the queue is unlimited, WithInitialValues, whose first value ends up at
+//     the front, and WithOverflowPolicy.
 //
 // Returns:
 //   - *LimitedLinkedQueue[T]: A pointer to the newly created LimitedLinkedQueue.
-//   - error: An error of type *common.ErrInvalidParameter if the capacity is less
-//     than 0.
-func NewLimitedLinkedQueue[T any](capacity int) (*LimitedLinkedQueue[T], error) {
-	if capacity < 0 {
-		return nil, gcers.NewErrInvalidParameter("capacity", gcint.NewErrGTE(0))
+//   - error: An error if the options are invalid.
+func NewLimitedLinkedQueue[T any](opts ...options.Option) (*LimitedLinkedQueue[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Only("LimitedLinkedQueue", limited_linked_queue_options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new_limited_linked_queue[T](s)
+}
+
+// new_limited_linked_queue creates a new LimitedLinkedQueue from validated
+// settings.
+//
+// Parameters:
+//   - s: The settings. Assumed to be non-nil.
+//
+// Returns:
+//   - *LimitedLinkedQueue[T]: The new queue.
+//   - error: An error if the initial values are invalid.
+func new_limited_linked_queue[T any](s *options.Settings) (*LimitedLinkedQueue[T], error) {
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
 	}
 
-	return &LimitedLinkedQueue[T]{
-		capacity: capacity,
-	}, nil
+	queue := &LimitedLinkedQueue[T]{
+		capacity: s.Capacity,
+		overflow: s.Overflow,
+	}
+
+	queue.EnqueueMany(values)
+
+	return queue, nil
 }
 
 // Copy is a method of the LimitedLinkedQueue type. It is used to create a shallow
@@ -205,6 +260,7 @@ func (queue *LimitedLinkedQueue[T]) Copy() *LimitedLinkedQueue[T] {
 	queue_copy := &LimitedLinkedQueue[T]{
 		size:     queue.size,
 		capacity: queue.capacity,
+		overflow: queue.overflow,
 	}
 
 	if queue.size == 0 {
@@ -231,3 +287,24 @@ func (queue *LimitedLinkedQueue[T]) Copy() *LimitedLinkedQueue[T] {
 
 	return queue_copy
 }
+
+// forEach implements the walker interface.
+func (queue *LimitedLinkedQueue[T]) forEach(f func(idx int, value T) bool) {
+	var i int
+
+	for queue_node := queue.front; queue_node != nil; queue_node = queue_node.next {
+		if !f(i, queue_node.value) {
+			return
+		}
+
+		i++
+	}
+}
+
+// removeFunc implements the walker interface.
+func (queue *LimitedLinkedQueue[T]) removeFunc(pred func(value T) bool, all bool) int {
+	count := remove_nodes(&queue.front, &queue.back, pred, all)
+	queue.size -= count
+
+	return count
+}
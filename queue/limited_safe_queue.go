@@ -1,13 +1,13 @@
 package queue
 
 import (
-	"strconv"
-	"strings"
+	"fmt"
 	"sync"
+	"sync/atomic"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
 
-	gcers "github.com/PlayerR9/go-commons/errors"
-	gcint "github.com/PlayerR9/go-commons/ints"
-	gcstr "github.com/PlayerR9/go-commons/strings"
 	itrs "github.com/PlayerR9/iterators/simple"
 )
 
@@ -22,40 +22,134 @@ type LimitedSafeQueue[T any] struct {
 	// concurrent reads and writes to the front and back nodes are thread-safe.
 	frontMutex, backMutex sync.RWMutex
 
-	// size is the current number of elements in the queue.
-	size int
+	// size is the current number of elements in the queue. It is updated
+	// atomically as Enqueue only holds the back lock.
+	size atomic.Int64
 
-	// capacity is the maximum number of elements that the queue can hold.
+	// capacity is the maximum number of elements that the queue can hold. -1
+	// means that the queue is unlimited.
 	capacity int
+
+	// overflow is what the queue does when a value is enqueued while it is full.
+	overflow options.OverflowPolicy
+
+	// shared is true when the nodes may be shared with a snapshot.
+	shared bool
 }
 
 // Enqueue implements the Queuer interface.
 func (queue *LimitedSafeQueue[T]) Enqueue(value T) bool {
 	queue.backMutex.Lock()
+
+	if queue.back == nil {
+		// The queue is empty, so the front changes as well. The front lock must be
+		// taken before the back one.
+		queue.backMutex.Unlock()
+
+		return queue.enqueueLocked(value)
+	}
+
+	if !queue.reserve() {
+		queue.backMutex.Unlock()
+
+		if queue.overflow != options.DropOldest || queue.capacity == 0 {
+			return false
+		}
+
+		return queue.enqueueLocked(value)
+	}
+
 	defer queue.backMutex.Unlock()
 
-	if queue.size >= queue.capacity {
-		return false
+	node := &queue_safe_node[T]{
+		value: value,
+	}
+
+	queue.back.next = node
+	queue.back = node
+
+	return true
+}
+
+// enqueueLocked enqueues the value while holding both locks. It is used when the
+// front may change as well; that is, when the queue is empty or when its front
+// element is dropped to make room. As the back lock was released to take the
+// locks in order, the state of the queue is checked again.
+//
+// Parameters:
+//   - value: The value to enqueue.
+//
+// Returns:
+//   - bool: True if the value was enqueued, false if the queue is full and does
+//     not drop its elements.
+func (queue *LimitedSafeQueue[T]) enqueueLocked(value T) bool {
+	queue.lock()
+	defer queue.unlock()
+
+	if queue.capacity != -1 && queue.length() >= queue.capacity {
+		if queue.overflow != options.DropOldest || queue.capacity == 0 {
+			return false
+		}
+
+		queue.dropFront()
 	}
 
 	node := &queue_safe_node[T]{
 		value: value,
 	}
 
-	if queue.back == nil {
-		queue.frontMutex.Lock()
-		queue.front = node
-		queue.frontMutex.Unlock()
-	} else {
+	if queue.back != nil {
 		queue.back.next = node
+	} else {
+		queue.front = node
 	}
 
 	queue.back = node
-	queue.size++
+
+	queue.size.Add(1)
 
 	return true
 }
 
+// reserve counts a new element if the queue is not full. The caller must hold
+// the back lock.
+//
+// Returns:
+//   - bool: True if the element was counted, false if the queue is full.
+func (queue *LimitedSafeQueue[T]) reserve() bool {
+	for {
+		size := queue.size.Load()
+
+		if queue.capacity != -1 && size >= int64(queue.capacity) {
+			return false
+		}
+
+		if queue.size.CompareAndSwap(size, size+1) {
+			return true
+		}
+	}
+}
+
+// length returns the number of elements of the queue.
+//
+// Returns:
+//   - int: The number of elements.
+func (queue *LimitedSafeQueue[T]) length() int {
+	return int(queue.size.Load())
+}
+
+// lock locks both ends of the queue, the front before the back.
+func (queue *LimitedSafeQueue[T]) lock() {
+	queue.frontMutex.Lock()
+	queue.backMutex.Lock()
+}
+
+// unlock unlocks both ends of the queue.
+func (queue *LimitedSafeQueue[T]) unlock() {
+	queue.backMutex.Unlock()
+	queue.frontMutex.Unlock()
+}
+
 // EnqueueMany implements the Queuer interface.
 func (queue *LimitedSafeQueue[T]) EnqueueMany(values []T) int {
 	if len(values) == 0 {
@@ -83,18 +177,22 @@ func (queue *LimitedSafeQueue[T]) Dequeue() (T, bool) {
 
 	toRemove := queue.front
 
-	if queue.front.next == nil {
-		queue.front = nil
+	// The back lock is also held while the front node is unlinked, as Enqueue
+	// writes the next node of the back one, which may be the front one.
+	queue.backMutex.Lock()
 
-		queue.backMutex.Lock()
+	queue.front = queue.front.next
+	if queue.front == nil {
 		queue.back = nil
-		queue.backMutex.Unlock()
-	} else {
-		queue.front = queue.front.next
 	}
 
-	queue.size--
-	toRemove.next = nil
+	queue.backMutex.Unlock()
+
+	queue.size.Add(-1)
+
+	if !queue.shared {
+		toRemove.next = nil
+	}
 
 	return toRemove.value, true
 }
@@ -127,7 +225,7 @@ func (queue *LimitedSafeQueue[T]) Size() int {
 	queue.backMutex.RLock()
 	defer queue.backMutex.RUnlock()
 
-	return queue.size
+	return queue.length()
 }
 
 // Capacity implements the Queuer interface.
@@ -164,55 +262,67 @@ func (queue *LimitedSafeQueue[T]) Clear() {
 		return // Queue is already empty
 	}
 
-	// 1. First node
-	prev := queue.front
+	if !queue.shared {
+		// 1. First node
+		prev := queue.front
+
+		// 2. Subsequent nodes
+		for node := queue.front.next; node != nil; node = node.next {
+			prev = node
+			prev.next = nil
+		}
 
-	// 2. Subsequent nodes
-	for node := queue.front.next; node != nil; node = node.next {
-		prev = node
 		prev.next = nil
 	}
 
-	prev.next = nil
-
 	// 3. Reset queue fields
 	queue.front = nil
 	queue.back = nil
-	queue.size = 0
+	queue.size.Store(0)
+	queue.shared = false
 }
 
 // IsFull implements the Queuer interface.
 func (queue *LimitedSafeQueue[T]) IsFull() (isFull bool) {
-	queue.backMutex.RLock()
-	defer queue.backMutex.RUnlock()
-
-	return queue.size >= queue.capacity
+	return queue.capacity != -1 && queue.length() >= queue.capacity
 }
 
-// GoString implements the fmt.GoStringer interface.
-func (queue *LimitedSafeQueue[T]) GoString() string {
-	queue.frontMutex.RLock()
-	defer queue.frontMutex.RUnlock()
+// layout returns the description of the queue used to format it.
+func (queue *LimitedSafeQueue[T]) layout() display.Container[T] {
+	var opts []string
 
-	queue.backMutex.RLock()
-	defer queue.backMutex.RUnlock()
+	if queue.capacity != -1 {
+		opts = append(opts, display.Option("WithCapacity", queue.capacity))
+	}
 
-	values := make([]string, 0, queue.size)
-	for node := queue.front; node != nil; node = node.next {
-		values = append(values, gcstr.GoStringOf(node.value))
+	if queue.overflow != options.Reject {
+		opts = append(opts, display.Option("WithOverflowPolicy", queue.overflow))
+	}
+
+	return display.Container[T]{
+		Pkg:      "queue",
+		Name:     "LimitedSafeQueue",
+		TypeArgs: []string{display.TypeName[T]()},
+		Options:  opts,
+		Capacity: queue.capacity,
+		Marker:   display.Front,
+		Values:   queue.Slice(),
 	}
+}
 
-	var builder strings.Builder
+// Format implements the fmt.Formatter interface.
+func (queue *LimitedSafeQueue[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, queue.layout())
+}
 
-	builder.WriteString("LimitedSafeQueue[capacity=")
-	builder.WriteString(strconv.Itoa(queue.capacity))
-	builder.WriteString(", size=")
-	builder.WriteString(strconv.Itoa(queue.size))
-	builder.WriteString(", values=[← ")
-	builder.WriteString(strings.Join(values, ", "))
-	builder.WriteString("]]")
+// String implements the fmt.Stringer interface.
+func (queue *LimitedSafeQueue[T]) String() string {
+	return fmt.Sprint(queue)
+}
 
-	return builder.String()
+// GoString implements the fmt.GoStringer interface.
+func (queue *LimitedSafeQueue[T]) GoString() string {
+	return queue.layout().GoSyntax()
 }
 
 // Slice implements the Queuer interface.
@@ -223,7 +333,7 @@ func (queue *LimitedSafeQueue[T]) Slice() []T {
 	queue.backMutex.RLock()
 	defer queue.backMutex.RUnlock()
 
-	slice := make([]T, 0, queue.size)
+	slice := make([]T, 0, queue.length())
 
 	for node := queue.front; node != nil; node = node.next {
 		slice = append(slice, node.value)
@@ -236,18 +346,49 @@ func (queue *LimitedSafeQueue[T]) Slice() []T {
 // LimitedSafeQueue.
 //
 // Parameters:
-//   - capacity: The capacity of the queue.
+//   - opts: The options of the queue. Supported options are WithCapacity, without
+//     which the queue is unlimited, WithInitialValues, whose first value ends up at
+//     the front, and WithOverflowPolicy.
 //
-// Return:
+// Returns:
 //   - *LimitedSafeQueue[T]: A pointer to the newly created LimitedSafeQueue.
-func NewLimitedSafeQueue[T any](capacity int) (*LimitedSafeQueue[T], error) {
-	if capacity < 0 {
-		return nil, gcers.NewErrInvalidParameter("capacity", gcint.NewErrGTE(0))
+//   - error: An error if the options are invalid.
+func NewLimitedSafeQueue[T any](opts ...options.Option) (*LimitedSafeQueue[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Only("LimitedSafeQueue", limited_safe_queue_options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new_limited_safe_queue[T](s)
+}
+
+// new_limited_safe_queue creates a new LimitedSafeQueue from validated settings.
+//
+// Parameters:
+//   - s: The settings. Assumed to be non-nil.
+//
+// Returns:
+//   - *LimitedSafeQueue[T]: The new queue.
+//   - error: An error if the initial values are invalid.
+func new_limited_safe_queue[T any](s *options.Settings) (*LimitedSafeQueue[T], error) {
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := &LimitedSafeQueue[T]{
+		capacity: s.Capacity,
+		overflow: s.Overflow,
 	}
 
-	return &LimitedSafeQueue[T]{
-		capacity: capacity,
-	}, nil
+	queue.EnqueueMany(values)
+
+	return queue, nil
 }
 
 // Copy is a method of the LimitedSafeQueue type. It is used to create a shallow
@@ -263,9 +404,12 @@ func (queue *LimitedSafeQueue[T]) Copy() *LimitedSafeQueue[T] {
 	defer queue.backMutex.RUnlock()
 
 	queue_copy := &LimitedSafeQueue[T]{
-		size: queue.size,
+		capacity: queue.capacity,
+		overflow: queue.overflow,
 	}
 
+	queue_copy.size.Store(queue.size.Load())
+
 	if queue.front == nil {
 		return queue_copy
 	}
@@ -290,3 +434,59 @@ func (queue *LimitedSafeQueue[T]) Copy() *LimitedSafeQueue[T] {
 
 	return queue_copy
 }
+
+// forEach implements the walker interface.
+//
+// The queue is read-locked during the whole walk, so f must not modify it.
+func (queue *LimitedSafeQueue[T]) forEach(f func(idx int, value T) bool) {
+	queue.frontMutex.RLock()
+	defer queue.frontMutex.RUnlock()
+
+	queue.backMutex.RLock()
+	defer queue.backMutex.RUnlock()
+
+	var i int
+
+	for node := queue.front; node != nil; node = node.next {
+		if !f(i, node.value) {
+			return
+		}
+
+		i++
+	}
+}
+
+// removeFunc implements the walker interface.
+//
+// The queue is locked during the whole walk, so pred must not access it.
+func (queue *LimitedSafeQueue[T]) removeFunc(pred func(value T) bool, all bool) int {
+	queue.frontMutex.Lock()
+	defer queue.frontMutex.Unlock()
+
+	queue.backMutex.Lock()
+	defer queue.backMutex.Unlock()
+
+	queue.unshare()
+
+	count := remove_safe_nodes(&queue.front, &queue.back, pred, all)
+	queue.size.Add(-int64(count))
+
+	return count
+}
+
+// dropFront removes the front element of the queue. The caller must hold both
+// locks and the queue is assumed to be non-empty.
+func (queue *LimitedSafeQueue[T]) dropFront() {
+	to_remove := queue.front
+
+	queue.front = to_remove.next
+	if queue.front == nil {
+		queue.back = nil
+	}
+
+	queue.size.Add(-1)
+
+	if !queue.shared {
+		to_remove.next = nil
+	}
+}
@@ -0,0 +1,116 @@
+package queue
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PlayerR9/listlike/options"
+)
+
+// TestLimitedSafeQueueEnqueueDropOldest checks that enqueuing into a full queue
+// drops its front element.
+func TestLimitedSafeQueueEnqueueDropOldest(t *testing.T) {
+	queue, err := NewLimitedSafeQueue[int](
+		options.WithCapacity(3),
+		options.WithOverflowPolicy(options.DropOldest),
+		options.WithInitialValues[int](1, 2, 3),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queue.Enqueue(4) {
+		t.Fatal("Enqueue on a full DropOldest queue failed")
+	}
+
+	got := queue.Slice()
+	if len(got) != 3 || got[0] != 2 || got[2] != 4 || queue.Size() != 3 {
+		t.Fatalf("got %v of size %d, want [2 3 4]", got, queue.Size())
+	}
+}
+
+// TestLimitedSafeQueueConcurrent checks that Enqueue, Dequeue and Snapshot do not
+// deadlock nor go past the capacity when called concurrently, with and without
+// dropping the oldest elements.
+func TestLimitedSafeQueueConcurrent(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []options.Option
+	}{
+		{"reject", []options.Option{options.WithCapacity(1)}},
+		{"drop oldest", []options.Option{options.WithCapacity(1), options.WithOverflowPolicy(options.DropOldest)}},
+		{"drop oldest 4", []options.Option{options.WithCapacity(4), options.WithOverflowPolicy(options.DropOldest)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := New[int](append(tt.opts, options.WithThreadSafety(true))...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			queue, ok := q.(*LimitedSafeQueue[int])
+			if !ok {
+				t.Fatalf("got %T, want *LimitedSafeQueue[int]", q)
+			}
+
+			capacity := queue.Capacity()
+
+			done := make(chan struct{})
+
+			go func() {
+				defer close(done)
+
+				var wg sync.WaitGroup
+
+				for g := 0; g < 4; g++ {
+					wg.Add(3)
+
+					go func() {
+						defer wg.Done()
+
+						for i := 0; i < 2000; i++ {
+							queue.Enqueue(i)
+						}
+					}()
+
+					go func() {
+						defer wg.Done()
+
+						for i := 0; i < 2000; i++ {
+							queue.Dequeue()
+						}
+					}()
+
+					go func() {
+						defer wg.Done()
+
+						for i := 0; i < 500; i++ {
+							snapshot := queue.Snapshot()
+
+							if n := len(snapshot.Slice()); n != snapshot.Size() || n > capacity {
+								t.Errorf("snapshot of size %d has %d values", snapshot.Size(), n)
+								return
+							}
+						}
+					}()
+				}
+
+				wg.Wait()
+			}()
+
+			select {
+			case <-done:
+			case <-time.After(20 * time.Second):
+				t.Fatal("deadlock between Enqueue, Dequeue and Snapshot")
+			}
+
+			values := queue.Slice()
+
+			if len(values) != queue.Size() || len(values) > capacity {
+				t.Fatalf("got %d values and size %d, want at most %d", len(values), queue.Size(), capacity)
+			}
+		})
+	}
+}
@@ -1,10 +1,11 @@
 package queue
 
 import (
-	"strconv"
-	"strings"
+	"fmt"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
 
-	gcstr "github.com/PlayerR9/go-commons/strings"
 	itrs "github.com/PlayerR9/iterators/simple"
 )
 
@@ -17,15 +18,16 @@ type LinkedQueue[T any] struct {
 
 	// size is the current number of elements in the queue.
 	size int
+
+	// pool is the pool of free nodes. Nil if pooling is disabled.
+	pool *node_pool[T]
 }
 
 // Enqueue implements the Queuer interface.
 //
 // Always returns true.
 func (queue *LinkedQueue[T]) Enqueue(value T) bool {
-	node := &queue_node[T]{
-		value: value,
-	}
+	node := queue.pool.get(value)
 
 	if queue.back == nil {
 		queue.front = node
@@ -72,9 +74,13 @@ func (queue *LinkedQueue[T]) Dequeue() (T, bool) {
 	}
 
 	queue.size--
+
+	value := toRemove.value
 	toRemove.next = nil
 
-	return toRemove.value, true
+	queue.pool.put(toRemove)
+
+	return value, true
 }
 
 // Peek implements the Queuer interface.
@@ -113,39 +119,54 @@ func (queue *LinkedQueue[T]) Clear() {
 		return // Queue is already empty
 	}
 
-	// 1. First node
-	prev := queue.front
+	// 1. Unlink the nodes
+	for node := queue.front; node != nil; {
+		next := node.next
 
-	// 2. Subsequent nodes
-	for node := queue.front.next; node != nil; node = node.next {
-		prev = node
-		prev.next = nil
-	}
+		node.next = nil
+		queue.pool.put(node)
 
-	prev.next = nil
+		node = next
+	}
 
-	// 3. Reset queue fields
+	// 2. Reset queue fields
 	queue.front = nil
 	queue.back = nil
 	queue.size = 0
 }
 
-// GoString implements the fmt.GoStringer interface.
-func (queue *LinkedQueue[T]) GoString() string {
-	values := make([]string, 0, queue.size)
-	for queue_node := queue.front; queue_node != nil; queue_node = queue_node.next {
-		values = append(values, gcstr.GoStringOf(queue_node.value))
+// layout returns the description of the queue used to format it.
+func (queue *LinkedQueue[T]) layout() display.Container[T] {
+	var opts []string
+
+	if queue.pool != nil {
+		opts = append(opts, display.Option("WithNodePool", queue.pool.limit))
 	}
 
-	var builder strings.Builder
+	return display.Container[T]{
+		Pkg:      "queue",
+		Name:     "LinkedQueue",
+		TypeArgs: []string{display.TypeName[T]()},
+		Options:  opts,
+		Capacity: -1,
+		Marker:   display.Front,
+		Values:   queue.Slice(),
+	}
+}
 
-	builder.WriteString("LinkedQueue{size=")
-	builder.WriteString(strconv.Itoa(queue.size))
-	builder.WriteString(", values=[← ")
-	builder.WriteString(strings.Join(values, ", "))
-	builder.WriteString("]}")
+// Format implements the fmt.Formatter interface.
+func (queue *LinkedQueue[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, queue.layout())
+}
 
-	return builder.String()
+// String implements the fmt.Stringer interface.
+func (queue *LinkedQueue[T]) String() string {
+	return fmt.Sprint(queue)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (queue *LinkedQueue[T]) GoString() string {
+	return queue.layout().GoSyntax()
 }
 
 // Slice implements the Queuer interface.
@@ -176,12 +197,48 @@ func (queue *LinkedQueue[T]) IsFull() bool {
 // NewLinkedQueue is a function that creates and returns a new instance of a
 // LinkedQueue.
 //
+// Parameters:
+//   - opts: The options of the queue. Supported options are WithInitialValues,
+//     whose first value ends up at the front, and WithNodePool.
+//
 // Returns:
-//   - *LinkedQueue[T]: A pointer to the newly created LinkedQueue. Never returns nil.
-func NewLinkedQueue[T any]() *LinkedQueue[T] {
-	return &LinkedQueue[T]{
-		size: 0,
+//   - *LinkedQueue[T]: A pointer to the newly created LinkedQueue.
+//   - error: An error if the options are invalid.
+func NewLinkedQueue[T any](opts ...options.Option) (*LinkedQueue[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Only("LinkedQueue", linked_queue_options...)
+	if err != nil {
+		return nil, err
 	}
+
+	return new_linked_queue[T](s)
+}
+
+// new_linked_queue creates a new LinkedQueue from validated settings.
+//
+// Parameters:
+//   - s: The settings. Assumed to be non-nil.
+//
+// Returns:
+//   - *LinkedQueue[T]: The new queue.
+//   - error: An error if the initial values are invalid.
+func new_linked_queue[T any](s *options.Settings) (*LinkedQueue[T], error) {
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := &LinkedQueue[T]{
+		pool: new_node_pool[T](s.NodePool),
+	}
+
+	queue.EnqueueMany(values)
+
+	return queue, nil
 }
 
 // Copy is a method that returns a copy of the LinkedQueue.
@@ -191,6 +248,7 @@ func NewLinkedQueue[T any]() *LinkedQueue[T] {
 func (queue *LinkedQueue[T]) Copy() *LinkedQueue[T] {
 	queue_copy := &LinkedQueue[T]{
 		size: queue.size,
+		pool: queue.pool.copy(),
 	}
 
 	if queue.size == 0 {
@@ -217,3 +275,24 @@ func (queue *LinkedQueue[T]) Copy() *LinkedQueue[T] {
 
 	return queue_copy
 }
+
+// forEach implements the walker interface.
+func (queue *LinkedQueue[T]) forEach(f func(idx int, value T) bool) {
+	var i int
+
+	for queue_node := queue.front; queue_node != nil; queue_node = queue_node.next {
+		if !f(i, queue_node.value) {
+			return
+		}
+
+		i++
+	}
+}
+
+// removeFunc implements the walker interface.
+func (queue *LinkedQueue[T]) removeFunc(pred func(value T) bool, all bool) int {
+	count := remove_nodes(&queue.front, &queue.back, pred, all)
+	queue.size -= count
+
+	return count
+}
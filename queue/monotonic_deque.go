@@ -0,0 +1,304 @@
+package queue
+
+import (
+	"fmt"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+
+	gcers "github.com/PlayerR9/go-commons/errors"
+	itrs "github.com/PlayerR9/iterators/simple"
+)
+
+// MonotonicDeque is a generic type that represents a double-ended queue whose
+// values are kept in non-increasing order, from the front to the back, according
+// to a comparator. Pushing a value at the back first evicts the values at the back
+// that are smaller than it; these are said to be dominated by it. Values equal to
+// it are kept.
+//
+// With cmp.Compare, the front is the largest value of the deque, which is what
+// sliding-window maxima need; pass a reversed comparator for minima. The evicted
+// values can be observed with OnEvict.
+type MonotonicDeque[T any] struct {
+	// values is a slice of type T that stores the elements in the deque, from
+	// the front to the back.
+	values []T
+
+	// cmp returns a negative number when a < b, 0 when a == b and a positive
+	// number when a > b.
+	cmp func(a, b T) int
+
+	// on_evict is called with each evicted value and the value that evicted it.
+	// Nil if not set.
+	on_evict func(evicted, by T)
+}
+
+// NewMonotonicDeque is a function that creates and returns a new instance of a
+// MonotonicDeque.
+//
+// Parameters:
+//   - cmp: The comparison function. It returns a negative number when a < b, 0
+//     when a == b and a positive number when a > b.
+//   - opts: The options of the deque. The only supported option is
+//     WithInitialValues, which are pushed in order; dominated values are evicted.
+//
+// Returns:
+//   - *MonotonicDeque[T]: A pointer to the newly created MonotonicDeque.
+//   - error: An error of type *errors.ErrInvalidParameter if cmp is nil, or an
+//     error if the options are invalid.
+func NewMonotonicDeque[T any](cmp func(a, b T) int, opts ...options.Option) (*MonotonicDeque[T], error) {
+	if cmp == nil {
+		return nil, gcers.NewErrNilParameter("cmp")
+	}
+
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Only("MonotonicDeque", "WithInitialValues")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
+	}
+
+	deque := &MonotonicDeque[T]{
+		cmp: cmp,
+	}
+
+	deque.EnqueueMany(values)
+
+	return deque, nil
+}
+
+// OnEvict is a method of the MonotonicDeque type. It sets the function called,
+// from the back forward, with each value evicted by a push.
+//
+// Parameters:
+//   - f: The function, called with the evicted value and the value being pushed.
+//     Nil disables the callback.
+func (deque *MonotonicDeque[T]) OnEvict(f func(evicted, by T)) {
+	deque.on_evict = f
+}
+
+// PushBack is a method of the MonotonicDeque type. It adds a value at the back of
+// the deque after evicting the values at the back that are smaller than it.
+//
+// Parameters:
+//   - value: The value to add.
+func (deque *MonotonicDeque[T]) PushBack(value T) {
+	for len(deque.values) > 0 {
+		back := deque.values[len(deque.values)-1]
+		if deque.cmp(back, value) >= 0 {
+			break
+		}
+
+		deque.values[len(deque.values)-1] = *new(T)
+		deque.values = deque.values[:len(deque.values)-1]
+
+		if deque.on_evict != nil {
+			deque.on_evict(back, value)
+		}
+	}
+
+	deque.values = append(deque.values, value)
+}
+
+// PopFront is a method of the MonotonicDeque type. It removes the value at the
+// front of the deque, which is the largest one.
+//
+// Returns:
+//   - T: The removed value.
+//   - bool: True if a value was removed, false if the deque is empty.
+func (deque *MonotonicDeque[T]) PopFront() (T, bool) {
+	if len(deque.values) == 0 {
+		return *new(T), false
+	}
+
+	toRemove := deque.values[0]
+
+	deque.values[0] = *new(T)
+	deque.values = deque.values[1:]
+
+	return toRemove, true
+}
+
+// PopBack is a method of the MonotonicDeque type. It removes the value at the back
+// of the deque, which is the smallest one.
+//
+// Returns:
+//   - T: The removed value.
+//   - bool: True if a value was removed, false if the deque is empty.
+func (deque *MonotonicDeque[T]) PopBack() (T, bool) {
+	if len(deque.values) == 0 {
+		return *new(T), false
+	}
+
+	toRemove := deque.values[len(deque.values)-1]
+
+	deque.values[len(deque.values)-1] = *new(T)
+	deque.values = deque.values[:len(deque.values)-1]
+
+	return toRemove, true
+}
+
+// PeekFront is a method of the MonotonicDeque type. It returns the value at the
+// front of the deque, which is the largest one, without removing it.
+//
+// Returns:
+//   - T: The value at the front.
+//   - bool: True if the deque is not empty, false otherwise.
+func (deque *MonotonicDeque[T]) PeekFront() (T, bool) {
+	if len(deque.values) == 0 {
+		return *new(T), false
+	}
+
+	return deque.values[0], true
+}
+
+// PeekBack is a method of the MonotonicDeque type. It returns the value at the
+// back of the deque, which is the smallest one, without removing it.
+//
+// Returns:
+//   - T: The value at the back.
+//   - bool: True if the deque is not empty, false otherwise.
+func (deque *MonotonicDeque[T]) PeekBack() (T, bool) {
+	if len(deque.values) == 0 {
+		return *new(T), false
+	}
+
+	return deque.values[len(deque.values)-1], true
+}
+
+// Evict is a method of the MonotonicDeque type. It removes the value at the front
+// of the deque if it is equal to the given one; that is, if it is the value
+// leaving a sliding window. Values that are not at the front were already evicted
+// by a push.
+//
+// Parameters:
+//   - value: The value leaving the window.
+//
+// Returns:
+//   - bool: True if the front value was removed, false otherwise.
+func (deque *MonotonicDeque[T]) Evict(value T) bool {
+	if len(deque.values) == 0 || deque.cmp(deque.values[0], value) != 0 {
+		return false
+	}
+
+	deque.PopFront()
+
+	return true
+}
+
+// Enqueue implements the Queuer interface.
+//
+// It is the same as PushBack. Always returns true.
+func (deque *MonotonicDeque[T]) Enqueue(value T) bool {
+	deque.PushBack(value)
+
+	return true
+}
+
+// EnqueueMany implements the Queuer interface.
+//
+// Always returns the number of values pushed, evicted or not.
+func (deque *MonotonicDeque[T]) EnqueueMany(values []T) int {
+	for _, value := range values {
+		deque.PushBack(value)
+	}
+
+	return len(values)
+}
+
+// Dequeue implements the Queuer interface.
+//
+// It is the same as PopFront.
+func (deque *MonotonicDeque[T]) Dequeue() (T, bool) {
+	return deque.PopFront()
+}
+
+// Peek implements the Queuer interface.
+//
+// It is the same as PeekFront.
+func (deque *MonotonicDeque[T]) Peek() (T, bool) {
+	return deque.PeekFront()
+}
+
+// IsEmpty implements the Queuer interface.
+func (deque *MonotonicDeque[T]) IsEmpty() bool {
+	return len(deque.values) == 0
+}
+
+// Size implements the Queuer interface.
+func (deque *MonotonicDeque[T]) Size() int {
+	return len(deque.values)
+}
+
+// Iterator implements the Queuer interface.
+func (deque *MonotonicDeque[T]) Iterator() itrs.Iterater[T] {
+	return itrs.NewSimpleIterator(deque.Slice())
+}
+
+// Clear implements the Queuer interface.
+//
+// Cleared values are not reported to the OnEvict callback.
+func (deque *MonotonicDeque[T]) Clear() {
+	deque.values = nil
+}
+
+// layout returns the description of the deque used to format it.
+func (deque *MonotonicDeque[T]) layout() display.Container[T] {
+	return display.Container[T]{
+		Pkg:      "queue",
+		Name:     "MonotonicDeque",
+		TypeArgs: []string{display.TypeName[T]()},
+		Opaque:   true,
+		Capacity: -1,
+		Marker:   display.Front,
+		Values:   deque.values,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+//
+// The %#v verb cannot rebuild the deque, as its comparison function has no Go
+// syntax; it gives the detailed form instead.
+func (deque *MonotonicDeque[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, deque.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (deque *MonotonicDeque[T]) String() string {
+	return fmt.Sprint(deque)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (deque *MonotonicDeque[T]) GoString() string {
+	return deque.layout().GoSyntax()
+}
+
+// Slice implements the Queuer interface.
+func (deque *MonotonicDeque[T]) Slice() []T {
+	slice := make([]T, len(deque.values))
+	copy(slice, deque.values)
+
+	return slice
+}
+
+// Capacity implements the Queuer interface.
+//
+// Always returns -1.
+func (deque *MonotonicDeque[T]) Capacity() int {
+	return -1
+}
+
+// IsFull implements the Queuer interface.
+//
+// Always returns false.
+func (deque *MonotonicDeque[T]) IsFull() bool {
+	return false
+}
@@ -0,0 +1,82 @@
+package queue
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+// TestMonotonicDequeEvictDuplicates checks that equal values are all kept, and
+// that Evict only removes one of them at a time.
+func TestMonotonicDequeEvictDuplicates(t *testing.T) {
+	deque, err := NewMonotonicDeque(cmp.Compare[int])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, value := range []int{5, 3, 5, 5, 1} {
+		deque.PushBack(value)
+	}
+
+	if got := deque.Slice(); !slices.Equal(got, []int{5, 5, 5, 1}) {
+		t.Fatalf("got %v, want [5 5 5 1]", got)
+	}
+
+	if deque.Evict(3) {
+		t.Fatal("Evict(3) removed a value that was already evicted")
+	}
+
+	for i := 2; i >= 0; i-- {
+		if !deque.Evict(5) {
+			t.Fatalf("Evict(5) failed with %d fives left", i+1)
+		}
+
+		if got := deque.Size(); got != i+1 {
+			t.Fatalf("got size %d, want %d", got, i+1)
+		}
+	}
+
+	if deque.Evict(5) {
+		t.Fatal("Evict(5) succeeded with no five left")
+	}
+
+	if front, ok := deque.PeekFront(); !ok || front != 1 {
+		t.Fatalf("got front %d, %t, want 1", front, ok)
+	}
+}
+
+// TestMonotonicDequeOnEvict checks that the evicted values are reported from the
+// back forward, with the value that evicted them.
+func TestMonotonicDequeOnEvict(t *testing.T) {
+	deque, err := NewMonotonicDeque(cmp.Compare[int])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got [][2]int
+
+	deque.OnEvict(func(evicted, by int) {
+		got = append(got, [2]int{evicted, by})
+	})
+
+	for _, value := range []int{9, 4, 2, 2, 3, 7} {
+		deque.PushBack(value)
+	}
+
+	want := [][2]int{{2, 3}, {2, 3}, {3, 7}, {4, 7}}
+
+	if !slices.Equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if values := deque.Slice(); !slices.Equal(values, []int{9, 7}) {
+		t.Fatalf("got %v, want [9 7]", values)
+	}
+
+	deque.PopBack()
+	deque.Clear()
+
+	if len(got) != len(want) {
+		t.Fatal("PopBack or Clear called OnEvict")
+	}
+}
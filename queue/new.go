@@ -0,0 +1,109 @@
+package queue
+
+import (
+	"github.com/PlayerR9/listlike/options"
+)
+
+var (
+	// array_queue_options are the options supported by NewArrayQueue.
+	array_queue_options = []string{"WithInitialValues", "WithGrowthFactor", "WithReserve", "WithShrinkThreshold"}
+
+	// limited_array_queue_options are the options supported by NewLimitedArrayQueue.
+	limited_array_queue_options = []string{"WithCapacity", "WithInitialValues", "WithOverflowPolicy", "WithGrowthFactor", "WithReserve", "WithShrinkThreshold"}
+
+	// linked_queue_options are the options supported by NewLinkedQueue.
+	linked_queue_options = []string{"WithInitialValues", "WithNodePool"}
+
+	// limited_linked_queue_options are the options supported by NewLimitedLinkedQueue.
+	limited_linked_queue_options = []string{"WithCapacity", "WithInitialValues", "WithOverflowPolicy"}
+
+	// safe_queue_options are the options supported by NewSafeQueue.
+	safe_queue_options = []string{"WithInitialValues"}
+
+	// limited_safe_queue_options are the options supported by NewLimitedSafeQueue.
+	limited_safe_queue_options = []string{"WithCapacity", "WithInitialValues", "WithOverflowPolicy"}
+)
+
+// New is a function that creates a queue whose implementation is picked from the
+// options:
+//   - a LimitedSafeQueue if WithThreadSafety is enabled and WithCapacity or
+//     WithOverflowPolicy is given;
+//   - a SafeQueue if WithThreadSafety is enabled otherwise;
+//   - a LinkedQueue if WithNodePool is given;
+//   - a LimitedArrayQueue if WithCapacity or WithOverflowPolicy is given;
+//   - an ArrayQueue otherwise.
+//
+// Options that the picked implementation does not support are rejected with an
+// *options.ErrUnsupportedOption.
+//
+// Parameters:
+//   - opts: The options of the queue.
+//
+// Returns:
+//   - Queuer[T]: The new queue.
+//   - error: An error if the options are invalid.
+func New[T any](opts ...options.Option) (Queuer[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	limited := s.Has("WithCapacity") || s.Has("WithOverflowPolicy")
+
+	var queue Queuer[T]
+
+	switch {
+	case s.ThreadSafe && limited:
+		err := s.Only("LimitedSafeQueue", append(limited_safe_queue_options, "WithThreadSafety")...)
+		if err != nil {
+			return nil, err
+		}
+
+		queue, err = new_limited_safe_queue[T](s)
+		if err != nil {
+			return nil, err
+		}
+	case s.ThreadSafe:
+		err := s.Only("SafeQueue", append(safe_queue_options, "WithThreadSafety")...)
+		if err != nil {
+			return nil, err
+		}
+
+		queue, err = new_safe_queue[T](s)
+		if err != nil {
+			return nil, err
+		}
+	case s.Has("WithNodePool"):
+		err := s.Only("LinkedQueue", append(linked_queue_options, "WithThreadSafety")...)
+		if err != nil {
+			return nil, err
+		}
+
+		queue, err = new_linked_queue[T](s)
+		if err != nil {
+			return nil, err
+		}
+	case limited:
+		err := s.Only("LimitedArrayQueue", append(limited_array_queue_options, "WithThreadSafety")...)
+		if err != nil {
+			return nil, err
+		}
+
+		queue, err = new_limited_array_queue[T](s)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		err := s.Only("ArrayQueue", append(array_queue_options, "WithThreadSafety")...)
+		if err != nil {
+			return nil, err
+		}
+
+		queue, err = new_array_queue[T](s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return queue, nil
+}
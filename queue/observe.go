@@ -0,0 +1,204 @@
+package queue
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/PlayerR9/listlike/internal/stamps"
+	"github.com/PlayerR9/listlike/observe"
+
+	gcers "github.com/PlayerR9/go-commons/errors"
+	itrs "github.com/PlayerR9/iterators/simple"
+)
+
+// Observe is a function that returns a queue that reports every operation
+// performed through it to an observer. The elements already in the queue are
+// considered enqueued at the time of the call.
+//
+// The queue must only be modified through the returned one afterwards; otherwise,
+// the reported wait times are wrong. The returned queue is safe for concurrent use
+// if the queue is.
+//
+// Parameters:
+//   - queue: The queue to observe.
+//   - o: The observer.
+//
+// Returns:
+//   - Queuer[T]: The observed queue.
+//   - error: An error of type *errors.ErrInvalidParameter if queue or o is nil.
+func Observe[T any](queue Queuer[T], o observe.Observer) (Queuer[T], error) {
+	if queue == nil {
+		return nil, gcers.NewErrNilParameter("queue")
+	} else if o == nil {
+		return nil, gcers.NewErrNilParameter("o")
+	}
+
+	s := &observed_queue[T]{
+		queue:    queue,
+		observer: o,
+	}
+
+	s.stamps.Fill(queue.Size(), time.Now())
+
+	return s, nil
+}
+
+// observed_queue is a Queuer that reports the operations performed on another one
+// to an observer.
+type observed_queue[T any] struct {
+	// queue is the observed queue.
+	queue Queuer[T]
+
+	// observer is the observer to report to.
+	observer observe.Observer
+
+	// stamps are the times at which the elements were enqueued, from the front to
+	// the back.
+	stamps stamps.Deque
+
+	// mu is the mutex that serializes the modifications, so that the stamps match
+	// the elements.
+	mu sync.Mutex
+}
+
+// Enqueue implements the Queuer interface.
+func (s *observed_queue[T]) Enqueue(value T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	size := s.queue.Size()
+
+	ok := s.queue.Enqueue(value)
+	if !ok {
+		s.observer.OnOverflow(observe.Event{Size: size, Time: time.Now()})
+	} else {
+		s.enqueued(size, 1)
+	}
+
+	return ok
+}
+
+// EnqueueMany implements the Queuer interface.
+func (s *observed_queue[T]) EnqueueMany(values []T) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	size := s.queue.Size()
+
+	n := s.queue.EnqueueMany(values)
+	s.enqueued(size, n)
+
+	now := time.Now()
+
+	for i := n; i < len(values); i++ {
+		s.observer.OnOverflow(observe.Event{Size: s.queue.Size(), Time: now})
+	}
+
+	return n
+}
+
+// Dequeue implements the Queuer interface.
+func (s *observed_queue[T]) Dequeue() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.queue.Dequeue()
+	if ok {
+		now := time.Now()
+
+		s.observer.OnPop(observe.Event{Size: s.queue.Size(), Time: now, Wait: s.stamps.PopFront(now)})
+	}
+
+	return value, ok
+}
+
+// Peek implements the Queuer interface.
+func (s *observed_queue[T]) Peek() (T, bool) {
+	return s.queue.Peek()
+}
+
+// IsEmpty implements the Queuer interface.
+func (s *observed_queue[T]) IsEmpty() bool {
+	return s.queue.IsEmpty()
+}
+
+// Size implements the Queuer interface.
+func (s *observed_queue[T]) Size() int {
+	return s.queue.Size()
+}
+
+// Clear implements the Queuer interface.
+func (s *observed_queue[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	size := s.queue.Size()
+
+	s.queue.Clear()
+	s.stamps.Clear()
+
+	s.observer.OnClear(observe.Event{Size: size, Time: time.Now()})
+}
+
+// Capacity implements the Queuer interface.
+func (s *observed_queue[T]) Capacity() int {
+	return s.queue.Capacity()
+}
+
+// IsFull implements the Queuer interface.
+func (s *observed_queue[T]) IsFull() bool {
+	return s.queue.IsFull()
+}
+
+// Slice implements the Queuer interface.
+func (s *observed_queue[T]) Slice() []T {
+	return s.queue.Slice()
+}
+
+// Iterator implements the Queuer interface.
+func (s *observed_queue[T]) Iterator() itrs.Iterater[T] {
+	return s.queue.Iterator()
+}
+
+// Format implements the fmt.Formatter interface.
+//
+// The queue is formatted as the observed one.
+func (s *observed_queue[T]) Format(f fmt.State, verb rune) {
+	format_wrapped(f, verb, "Observed", s.queue)
+}
+
+// String implements the fmt.Stringer interface.
+func (s *observed_queue[T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString implements the fmt.GoStringer interface.
+//
+// The observer cannot be rebuilt, so the Go syntax is the one of the observed
+// queue.
+func (s *observed_queue[T]) GoString() string {
+	return s.queue.GoString()
+}
+
+// enqueued reports that n values were enqueued in a queue of the given size, along
+// with the elements the queue dropped to make room for them. The caller must hold
+// the lock.
+//
+// Parameters:
+//   - size: The size of the queue before the enqueue.
+//   - n: The number of values enqueued.
+func (s *observed_queue[T]) enqueued(size, n int) {
+	now := time.Now()
+	after := s.queue.Size()
+
+	s.stamps.Fill(n, now)
+
+	for i := 0; i < n; i++ {
+		s.observer.OnPush(observe.Event{Size: after, Time: now})
+	}
+
+	for i := after; i < size+n; i++ {
+		s.observer.OnOverflow(observe.Event{Size: after, Time: now, Wait: s.stamps.PopFront(now)})
+	}
+}
@@ -0,0 +1,94 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/PlayerR9/listlike/options"
+)
+
+// TestNodePoolZeroes checks that a recycled node holds neither its value nor a link
+// into the queue, so a pooled node does not keep a value alive.
+func TestNodePoolZeroes(t *testing.T) {
+	value := new(int)
+
+	queue, err := NewLinkedQueue[*int](options.WithNodePool(8))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	queue.Enqueue(value)
+	queue.Enqueue(new(int))
+
+	if got, _ := queue.Dequeue(); got != value {
+		t.Fatal("Dequeue did not return the enqueued value")
+	}
+
+	node := queue.pool.free
+	if node == nil {
+		t.Fatal("the dequeued node was not pooled")
+	}
+
+	if node.value != nil {
+		t.Error("the pooled node still holds its value")
+	}
+
+	if node.next != nil {
+		t.Error("the pooled node still links to the queue")
+	}
+
+	reused := queue.pool.get(nil)
+	if reused != node || reused.next != nil {
+		t.Error("get did not reuse the pooled node with a cleared link")
+	}
+}
+
+// TestNodePoolNoAllocs checks that enqueuing and dequeuing on a warmed up queue
+// does not allocate.
+func TestNodePoolNoAllocs(t *testing.T) {
+	queue, err := NewLinkedQueue[int](options.WithNodePool(8))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	run := func() {
+		queue.Enqueue(1)
+		queue.Dequeue()
+	}
+
+	// Warm up the pool.
+	run()
+
+	if allocs := testing.AllocsPerRun(100, run); allocs != 0 {
+		t.Errorf("got %v allocations per enqueue and dequeue, want 0", allocs)
+	}
+}
+
+// BenchmarkNodePool measures an enqueue and a dequeue on a queue with and without
+// a node pool.
+func BenchmarkNodePool(b *testing.B) {
+	for _, size := range []int{0, 8} {
+		name := "NoPool"
+		if size > 0 {
+			name = "Pool"
+		}
+
+		b.Run(name, func(b *testing.B) {
+			var opts []options.Option
+			if size > 0 {
+				opts = append(opts, options.WithNodePool(size))
+			}
+
+			queue, err := NewLinkedQueue[int](opts...)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				queue.Enqueue(i)
+				queue.Dequeue()
+			}
+		})
+	}
+}
@@ -1,11 +1,12 @@
 package queue
 
 import (
-	"strconv"
-	"strings"
+	"fmt"
 	"sync"
 
-	gcstr "github.com/PlayerR9/go-commons/strings"
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+
 	itrs "github.com/PlayerR9/iterators/simple"
 )
 
@@ -22,17 +23,54 @@ type SafeQueue[T any] struct {
 
 	// size is the size that observers observe.
 	size int
+
+	// shared is true when the nodes may be shared with a snapshot.
+	shared bool
 }
 
 // NewSafeQueue is a function that creates and returns a new instance of a
 // SafeQueue.
 //
-// Return:
-//   - *SafeQueue[T]: A pointer to the newly created SafeQueue. Never returns nil.
-func NewSafeQueue[T any]() *SafeQueue[T] {
-	return &SafeQueue[T]{
-		size: 0,
+// Parameters:
+//   - opts: The options of the queue. The only supported option is
+//     WithInitialValues, whose first value ends up at the front.
+//
+// Returns:
+//   - *SafeQueue[T]: A pointer to the newly created SafeQueue.
+//   - error: An error if the options are invalid.
+func NewSafeQueue[T any](opts ...options.Option) (*SafeQueue[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Only("SafeQueue", safe_queue_options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new_safe_queue[T](s)
+}
+
+// new_safe_queue creates a new SafeQueue from validated settings.
+//
+// Parameters:
+//   - s: The settings. Assumed to be non-nil.
+//
+// Returns:
+//   - *SafeQueue[T]: The new queue.
+//   - error: An error if the initial values are invalid.
+func new_safe_queue[T any](s *options.Settings) (*SafeQueue[T], error) {
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
 	}
+
+	queue := &SafeQueue[T]{}
+
+	queue.EnqueueMany(values)
+
+	return queue, nil
 }
 
 // Enqueue implements the Queuer interface.
@@ -154,29 +192,34 @@ func (queue *SafeQueue[T]) Clear() {
 	queue.back = nil
 
 	queue.size = 0
+	queue.shared = false
 }
 
-// GoString implements the fmt.GoStringer interface.
-func (queue *SafeQueue[T]) GoString() string {
-	queue.mu.RLock()
-	defer queue.mu.RUnlock()
-
-	size := queue.size
-
-	values := make([]string, 0, size)
-	for node := queue.front; node != nil; node = node.next {
-		values = append(values, gcstr.GoStringOf(node.value))
+// layout returns the description of the queue used to format it.
+func (queue *SafeQueue[T]) layout() display.Container[T] {
+	return display.Container[T]{
+		Pkg:      "queue",
+		Name:     "SafeQueue",
+		TypeArgs: []string{display.TypeName[T]()},
+		Capacity: -1,
+		Marker:   display.Front,
+		Values:   queue.Slice(),
 	}
+}
 
-	var builder strings.Builder
+// Format implements the fmt.Formatter interface.
+func (queue *SafeQueue[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, queue.layout())
+}
 
-	builder.WriteString("SafeQueue{size=")
-	builder.WriteString(strconv.Itoa(size))
-	builder.WriteString(", values=[← ")
-	builder.WriteString(strings.Join(values, ", "))
-	builder.WriteString("]}")
+// String implements the fmt.Stringer interface.
+func (queue *SafeQueue[T]) String() string {
+	return fmt.Sprint(queue)
+}
 
-	return builder.String()
+// GoString implements the fmt.GoStringer interface.
+func (queue *SafeQueue[T]) GoString() string {
+	return queue.layout().GoSyntax()
 }
 
 // Slice implements the Queuer interface.
@@ -243,3 +286,36 @@ func (queue *SafeQueue[T]) Copy() *SafeQueue[T] {
 
 	return queue_copy
 }
+
+// forEach implements the walker interface.
+//
+// The queue is read-locked during the whole walk, so f must not modify it.
+func (queue *SafeQueue[T]) forEach(f func(idx int, value T) bool) {
+	queue.mu.RLock()
+	defer queue.mu.RUnlock()
+
+	var i int
+
+	for node := queue.front; node != nil; node = node.next {
+		if !f(i, node.value) {
+			return
+		}
+
+		i++
+	}
+}
+
+// removeFunc implements the walker interface.
+//
+// The queue is locked during the whole walk, so pred must not access it.
+func (queue *SafeQueue[T]) removeFunc(pred func(value T) bool, all bool) int {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	queue.unshare()
+
+	count := remove_safe_nodes(&queue.front, &queue.back, pred, all)
+	queue.size -= count
+
+	return count
+}
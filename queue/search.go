@@ -0,0 +1,476 @@
+package queue
+
+// walker is implemented by the queues that can be searched and pruned in place,
+// without copying their elements.
+type walker[T any] interface {
+	// forEach calls f on every element of the queue, from the front to the back,
+	// until f returns false.
+	//
+	// Parameters:
+	//   - f: The function to call with the index and the value of each element.
+	forEach(f func(idx int, value T) bool)
+
+	// removeFunc removes, from the front to the back, the elements that satisfy
+	// the predicate. The predicate is called exactly once per visited element.
+	//
+	// Parameters:
+	//   - pred: The predicate that selects the elements to remove.
+	//   - all: Whether to remove every selected element or only the first one.
+	//
+	// Returns:
+	//   - int: The number of elements removed.
+	removeFunc(pred func(value T) bool, all bool) int
+}
+
+// queuer_walker is the walker used for the Queuer implementations that are not
+// part of this package. It relies on the methods of the Queuer interface.
+type queuer_walker[T any] struct {
+	// queue is the underlying queue.
+	queue Queuer[T]
+}
+
+// forEach implements the walker interface.
+func (w queuer_walker[T]) forEach(f func(idx int, value T) bool) {
+	for i, value := range w.queue.Slice() {
+		if !f(i, value) {
+			return
+		}
+	}
+}
+
+// removeFunc implements the walker interface.
+//
+// The queue is emptied and the kept elements are enqueued back in order.
+func (w queuer_walker[T]) removeFunc(pred func(value T) bool, all bool) int {
+	values := w.queue.Slice()
+	w.queue.Clear()
+
+	var count int
+
+	for _, value := range values {
+		if (all || count == 0) && pred(value) {
+			count++
+		} else {
+			w.queue.Enqueue(value)
+		}
+	}
+
+	return count
+}
+
+// walker_of returns the walker of the given queue.
+//
+// Parameters:
+//   - queue: The queue to walk.
+//
+// Returns:
+//   - walker[T]: The walker of the queue.
+func walker_of[T any](queue Queuer[T]) walker[T] {
+	w, ok := queue.(walker[T])
+	if ok {
+		return w
+	}
+
+	return queuer_walker[T]{
+		queue: queue,
+	}
+}
+
+// equals is the equality function of comparable types.
+func equals[T comparable](a, b T) bool {
+	return a == b
+}
+
+// Contains is a function that checks whether the queue holds the given value.
+//
+// Parameters:
+//   - queue: The queue to search.
+//   - value: The value to search for.
+//
+// Returns:
+//   - bool: True if the value is in the queue, false otherwise.
+func Contains[T comparable](queue Queuer[T], value T) bool {
+	return IndexOfFunc(queue, value, equals[T]) != -1
+}
+
+// ContainsFunc is like Contains but uses the given equality function.
+//
+// Parameters:
+//   - queue: The queue to search.
+//   - value: The value to search for.
+//   - equal: The function that checks whether two values are equal.
+//
+// Returns:
+//   - bool: True if the value is in the queue, false otherwise.
+func ContainsFunc[T any](queue Queuer[T], value T, equal func(a, b T) bool) bool {
+	return IndexOfFunc(queue, value, equal) != -1
+}
+
+// IndexOf is a function that returns the index of the first occurrence of the value
+// in the queue, starting from the front.
+//
+// Parameters:
+//   - queue: The queue to search.
+//   - value: The value to search for.
+//
+// Returns:
+//   - int: The index of the value. -1 if the value is not in the queue.
+func IndexOf[T comparable](queue Queuer[T], value T) int {
+	return IndexOfFunc(queue, value, equals[T])
+}
+
+// IndexOfFunc is like IndexOf but uses the given equality function.
+//
+// Parameters:
+//   - queue: The queue to search.
+//   - value: The value to search for.
+//   - equal: The function that checks whether two values are equal.
+//
+// Returns:
+//   - int: The index of the value. -1 if the value is not in the queue.
+func IndexOfFunc[T any](queue Queuer[T], value T, equal func(a, b T) bool) int {
+	if queue == nil || equal == nil {
+		return -1
+	}
+
+	idx := -1
+
+	walker_of(queue).forEach(func(i int, elem T) bool {
+		if !equal(elem, value) {
+			return true
+		}
+
+		idx = i
+
+		return false
+	})
+
+	return idx
+}
+
+// LastIndexOf is a function that returns the index of the last occurrence of the
+// value in the queue.
+//
+// Parameters:
+//   - queue: The queue to search.
+//   - value: The value to search for.
+//
+// Returns:
+//   - int: The index of the value. -1 if the value is not in the queue.
+func LastIndexOf[T comparable](queue Queuer[T], value T) int {
+	return LastIndexOfFunc(queue, value, equals[T])
+}
+
+// LastIndexOfFunc is like LastIndexOf but uses the given equality function.
+//
+// Parameters:
+//   - queue: The queue to search.
+//   - value: The value to search for.
+//   - equal: The function that checks whether two values are equal.
+//
+// Returns:
+//   - int: The index of the value. -1 if the value is not in the queue.
+func LastIndexOfFunc[T any](queue Queuer[T], value T, equal func(a, b T) bool) int {
+	if queue == nil || equal == nil {
+		return -1
+	}
+
+	idx := -1
+
+	// Queues are singly linked, so the whole queue is walked.
+	walker_of(queue).forEach(func(i int, elem T) bool {
+		if equal(elem, value) {
+			idx = i
+		}
+
+		return true
+	})
+
+	return idx
+}
+
+// Count is a function that returns the number of occurrences of the value in the
+// queue.
+//
+// Parameters:
+//   - queue: The queue to search.
+//   - value: The value to count.
+//
+// Returns:
+//   - int: The number of occurrences of the value.
+func Count[T comparable](queue Queuer[T], value T) int {
+	return CountFunc(queue, value, equals[T])
+}
+
+// CountFunc is like Count but uses the given equality function.
+//
+// Parameters:
+//   - queue: The queue to search.
+//   - value: The value to count.
+//   - equal: The function that checks whether two values are equal.
+//
+// Returns:
+//   - int: The number of occurrences of the value.
+func CountFunc[T any](queue Queuer[T], value T, equal func(a, b T) bool) int {
+	if queue == nil || equal == nil {
+		return 0
+	}
+
+	var count int
+
+	walker_of(queue).forEach(func(_ int, elem T) bool {
+		if equal(elem, value) {
+			count++
+		}
+
+		return true
+	})
+
+	return count
+}
+
+// RemoveFirst is a function that removes the first occurrence of the value from
+// the queue.
+//
+// Parameters:
+//   - queue: The queue to modify.
+//   - value: The value to remove.
+//
+// Returns:
+//   - bool: True if the value was removed, false if it was not in the queue.
+func RemoveFirst[T comparable](queue Queuer[T], value T) bool {
+	return RemoveFirstFunc(queue, value, equals[T])
+}
+
+// RemoveFirstFunc is like RemoveFirst but uses the given equality function.
+//
+// Parameters:
+//   - queue: The queue to modify.
+//   - value: The value to remove.
+//   - equal: The function that checks whether two values are equal.
+//
+// Returns:
+//   - bool: True if the value was removed, false if it was not in the queue.
+func RemoveFirstFunc[T any](queue Queuer[T], value T, equal func(a, b T) bool) bool {
+	if queue == nil || equal == nil {
+		return false
+	}
+
+	count := walker_of(queue).removeFunc(func(elem T) bool {
+		return equal(elem, value)
+	}, false)
+
+	return count > 0
+}
+
+// RemoveAll is a function that removes every occurrence of the value from the queue.
+//
+// Parameters:
+//   - queue: The queue to modify.
+//   - value: The value to remove.
+//
+// Returns:
+//   - int: The number of elements removed.
+func RemoveAll[T comparable](queue Queuer[T], value T) int {
+	return RemoveAllFunc(queue, value, equals[T])
+}
+
+// RemoveAllFunc is like RemoveAll but uses the given equality function.
+//
+// Parameters:
+//   - queue: The queue to modify.
+//   - value: The value to remove.
+//   - equal: The function that checks whether two values are equal.
+//
+// Returns:
+//   - int: The number of elements removed.
+func RemoveAllFunc[T any](queue Queuer[T], value T, equal func(a, b T) bool) int {
+	if queue == nil || equal == nil {
+		return 0
+	}
+
+	return walker_of(queue).removeFunc(func(elem T) bool {
+		return equal(elem, value)
+	}, true)
+}
+
+// Dedupe is a function that removes the duplicates from the queue. Only the first
+// occurrence of each value is kept, so the relative order of the queue is preserved.
+//
+// Parameters:
+//   - queue: The queue to modify.
+//
+// Returns:
+//   - int: The number of elements removed.
+func Dedupe[T comparable](queue Queuer[T]) int {
+	if queue == nil {
+		return 0
+	}
+
+	seen := make(map[T]struct{})
+
+	return walker_of(queue).removeFunc(func(elem T) bool {
+		_, ok := seen[elem]
+		if ok {
+			return true
+		}
+
+		seen[elem] = struct{}{}
+
+		return false
+	}, true)
+}
+
+// DedupeFunc is like Dedupe but uses the given equality function. Because values
+// cannot be hashed, this takes quadratic time.
+//
+// Parameters:
+//   - queue: The queue to modify.
+//   - equal: The function that checks whether two values are equal.
+//
+// Returns:
+//   - int: The number of elements removed.
+func DedupeFunc[T any](queue Queuer[T], equal func(a, b T) bool) int {
+	if queue == nil || equal == nil {
+		return 0
+	}
+
+	var kept []T
+
+	return walker_of(queue).removeFunc(func(elem T) bool {
+		for _, k := range kept {
+			if equal(k, elem) {
+				return true
+			}
+		}
+
+		kept = append(kept, elem)
+
+		return false
+	}, true)
+}
+
+// remove_nodes removes, from the front to the back, the nodes whose value satisfies
+// the predicate and updates the front and back pointers accordingly.
+//
+// Parameters:
+//   - front: A pointer to the front of the queue.
+//   - back: A pointer to the back of the queue.
+//   - pred: The predicate that selects the nodes to remove.
+//   - all: Whether to remove every selected node or only the first one.
+//
+// Returns:
+//   - int: The number of nodes removed.
+func remove_nodes[T any](front, back **queue_node[T], pred func(value T) bool, all bool) int {
+	var (
+		count int
+		prev  *queue_node[T]
+	)
+
+	node := *front
+
+	for node != nil && (all || count == 0) {
+		next := node.next
+
+		if !pred(node.value) {
+			prev = node
+			node = next
+
+			continue
+		}
+
+		if prev == nil {
+			*front = next
+		} else {
+			prev.next = next
+		}
+
+		if next == nil {
+			*back = prev
+		}
+
+		node.next = nil
+		count++
+
+		node = next
+	}
+
+	return count
+}
+
+// remove_safe_nodes is like remove_nodes but for the nodes of the thread-safe
+// queues.
+//
+// Parameters:
+//   - front: A pointer to the front of the queue.
+//   - back: A pointer to the back of the queue.
+//   - pred: The predicate that selects the nodes to remove.
+//   - all: Whether to remove every selected node or only the first one.
+//
+// Returns:
+//   - int: The number of nodes removed.
+func remove_safe_nodes[T any](front, back **queue_safe_node[T], pred func(value T) bool, all bool) int {
+	var (
+		count int
+		prev  *queue_safe_node[T]
+	)
+
+	node := *front
+
+	for node != nil && (all || count == 0) {
+		next := node.next
+
+		if !pred(node.value) {
+			prev = node
+			node = next
+
+			continue
+		}
+
+		if prev == nil {
+			*front = next
+		} else {
+			prev.next = next
+		}
+
+		if next == nil {
+			*back = prev
+		}
+
+		node.next = nil
+		count++
+
+		node = next
+	}
+
+	return count
+}
+
+// remove_values removes, in place, the values that satisfy the predicate.
+//
+// Parameters:
+//   - values: The values to filter.
+//   - pred: The predicate that selects the values to remove.
+//   - all: Whether to remove every selected value or only the first one.
+//
+// Returns:
+//   - []T: The kept values.
+//   - int: The number of values removed.
+func remove_values[T any](values []T, pred func(value T) bool, all bool) ([]T, int) {
+	var count int
+
+	kept := values[:0]
+
+	for _, value := range values {
+		if (all || count == 0) && pred(value) {
+			count++
+		} else {
+			kept = append(kept, value)
+		}
+	}
+
+	// Release the references held by the unused tail.
+	clear(values[len(kept):])
+
+	return kept, count
+}
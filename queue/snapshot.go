@@ -0,0 +1,264 @@
+package queue
+
+import (
+	"fmt"
+
+	"github.com/PlayerR9/listlike/display"
+
+	itrs "github.com/PlayerR9/iterators/simple"
+)
+
+// QueueSnapshot is a read-only view of a safe queue at the time it was taken.
+//
+// A snapshot is produced in O(1) time and shares its nodes with the queue. It
+// needs no locking, so it can be read from any goroutine while the queue keeps
+// being modified; the queue copies its nodes the first time it needs to rewrite
+// a link they share.
+type QueueSnapshot[T any] struct {
+	// front is a pointer to the first node of the snapshot.
+	front *queue_safe_node[T]
+
+	// size is the number of elements in the snapshot.
+	size int
+}
+
+// Peek is a method of the QueueSnapshot type. It returns the value at the front of
+// the snapshot.
+//
+// Returns:
+//   - T: The value at the front of the snapshot.
+//   - bool: True if the snapshot is not empty, false otherwise.
+func (s *QueueSnapshot[T]) Peek() (T, bool) {
+	if s.size == 0 {
+		return *new(T), false
+	}
+
+	return s.front.value, true
+}
+
+// IsEmpty is a method of the QueueSnapshot type. It is used to check if the
+// snapshot is empty.
+//
+// Returns:
+//   - bool: true if the snapshot is empty, and false otherwise.
+func (s *QueueSnapshot[T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Size is a method of the QueueSnapshot type. It is used to return the number of
+// elements in the snapshot.
+//
+// Returns:
+//   - int: The number of elements in the snapshot.
+func (s *QueueSnapshot[T]) Size() int {
+	return s.size
+}
+
+// Iterator is a method of the QueueSnapshot type. It is used to return an iterator
+// over the elements in the snapshot, from the front to the back. Unlike the
+// iterators of the queues, it does not copy the elements.
+//
+// Returns:
+//   - itrs.Iterater[T]: An iterator for the elements in the snapshot.
+func (s *QueueSnapshot[T]) Iterator() itrs.Iterater[T] {
+	return &snapshot_iterator[T]{
+		snapshot: s,
+	}
+}
+
+// Slice is a method of the QueueSnapshot type. It is used to return a slice of the
+// elements in the snapshot.
+//
+// Returns:
+//   - []T: A slice of the elements in the snapshot. The 0th element is the front of
+//     the snapshot.
+func (s *QueueSnapshot[T]) Slice() []T {
+	slice := make([]T, 0, s.size)
+
+	s.forEach(func(_ int, value T) bool {
+		slice = append(slice, value)
+
+		return true
+	})
+
+	return slice
+}
+
+// layout returns the description of the snapshot used to format it.
+func (s *QueueSnapshot[T]) layout() display.Container[T] {
+	values := make([]T, 0, s.size)
+
+	s.forEach(func(_ int, value T) bool {
+		values = append(values, value)
+
+		return true
+	})
+
+	// A snapshot cannot be built directly, so it is rebuilt as the snapshot of a
+	// safe queue with the same values.
+	return display.Container[T]{
+		Pkg:      "queue",
+		Name:     "QueueSnapshot",
+		TypeArgs: []string{display.TypeName[T]()},
+		Ctor:     "NewSafeQueue",
+		Method:   "Snapshot",
+		Capacity: -1,
+		Marker:   display.Front,
+		Values:   values,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (s *QueueSnapshot[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, s.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (s *QueueSnapshot[T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (s *QueueSnapshot[T]) GoString() string {
+	return s.layout().GoSyntax()
+}
+
+// forEach calls f on each element of the snapshot, from the front to the back,
+// until f returns false.
+//
+// The walk is bounded by the size of the snapshot, so it never follows the link
+// of its last node, which the queue may still be writing to.
+func (s *QueueSnapshot[T]) forEach(f func(idx int, value T) bool) {
+	node := s.front
+
+	for i := 0; i < s.size; i++ {
+		if !f(i, node.value) {
+			return
+		}
+
+		if i+1 < s.size {
+			node = node.next
+		}
+	}
+}
+
+// snapshot_iterator is an iterator over the elements of a QueueSnapshot.
+type snapshot_iterator[T any] struct {
+	// snapshot is the snapshot to iterate over.
+	snapshot *QueueSnapshot[T]
+
+	// node is the node of the next element. Nil if the iterator is not started.
+	node *queue_safe_node[T]
+
+	// index is the index of the next element.
+	index int
+}
+
+// Consume implements the itrs.Iterater interface.
+func (iter *snapshot_iterator[T]) Consume() (T, error) {
+	if iter.index >= iter.snapshot.size {
+		return *new(T), itrs.Exhausted
+	}
+
+	if iter.node == nil {
+		iter.node = iter.snapshot.front
+	} else {
+		iter.node = iter.node.next
+	}
+
+	iter.index++
+
+	return iter.node.value, nil
+}
+
+// Restart implements the itrs.Iterater interface.
+func (iter *snapshot_iterator[T]) Restart() {
+	iter.node = nil
+	iter.index = 0
+}
+
+// copy_safe_nodes copies a chain of nodes.
+//
+// Parameters:
+//   - front: The first node of the chain.
+//
+// Returns:
+//   - *queue_safe_node[T]: The first node of the copy.
+//   - *queue_safe_node[T]: The last node of the copy.
+func copy_safe_nodes[T any](front *queue_safe_node[T]) (*queue_safe_node[T], *queue_safe_node[T]) {
+	if front == nil {
+		return nil, nil
+	}
+
+	first := &queue_safe_node[T]{
+		value: front.value,
+	}
+
+	last := first
+
+	for node := front.next; node != nil; node = node.next {
+		last.next = &queue_safe_node[T]{
+			value: node.value,
+		}
+
+		last = last.next
+	}
+
+	return first, last
+}
+
+// Snapshot is a method of the SafeQueue type. It returns a read-only view of the
+// queue in O(1) time. See QueueSnapshot.
+//
+// Returns:
+//   - *QueueSnapshot[T]: The snapshot. Never returns nil.
+func (queue *SafeQueue[T]) Snapshot() *QueueSnapshot[T] {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	queue.shared = queue.front != nil
+
+	return &QueueSnapshot[T]{
+		front: queue.front,
+		size:  queue.size,
+	}
+}
+
+// unshare replaces the nodes of the queue by copies so that the links can be
+// rewritten without affecting the snapshots. The caller must hold the lock.
+func (queue *SafeQueue[T]) unshare() {
+	if !queue.shared {
+		return
+	}
+
+	queue.front, queue.back = copy_safe_nodes(queue.front)
+	queue.shared = false
+}
+
+// Snapshot is a method of the LimitedSafeQueue type. It returns a read-only view
+// of the queue in O(1) time. See QueueSnapshot.
+//
+// Returns:
+//   - *QueueSnapshot[T]: The snapshot. Never returns nil.
+func (queue *LimitedSafeQueue[T]) Snapshot() *QueueSnapshot[T] {
+	queue.lock()
+	defer queue.unlock()
+
+	queue.shared = queue.front != nil
+
+	return &QueueSnapshot[T]{
+		front: queue.front,
+		size:  queue.length(),
+	}
+}
+
+// unshare replaces the nodes of the queue by copies so that the links can be
+// rewritten without affecting the snapshots. The caller must hold both locks.
+func (queue *LimitedSafeQueue[T]) unshare() {
+	if !queue.shared {
+		return
+	}
+
+	queue.front, queue.back = copy_safe_nodes(queue.front)
+	queue.shared = false
+}
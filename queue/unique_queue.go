@@ -0,0 +1,495 @@
+package queue
+
+import (
+	"container/list"
+	"fmt"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+
+	gcers "github.com/PlayerR9/go-commons/errors"
+	itrs "github.com/PlayerR9/iterators/simple"
+)
+
+// UniqueQueue is a generic type that represents a queue that ignores the values
+// that are already pending, implemented using a linked list and an index of the
+// pending keys. Two values are the same if they have the same key.
+//
+// Optionally, it also remembers the keys of the last dequeued values (see
+// options.WithHistory) and ignores them as well until they are forgotten.
+type UniqueQueue[T any, K comparable] struct {
+	// front and back are pointers to the first and last nodes in the queue,
+	// respectively.
+	front, back *unique_node[T, K]
+
+	// size is the current number of elements in the queue.
+	size int
+
+	// capacity is the maximum number of elements the queue can hold. -1 means
+	// that the queue is unlimited.
+	capacity int
+
+	// key is the function that returns the key of a value.
+	key func(value T) K
+
+	// identity tells whether the values are their own keys, as given by
+	// NewUniqueQueue.
+	identity bool
+
+	// pending is the set of the keys of the elements in the queue.
+	pending map[K]struct{}
+
+	// history is the LRU of the keys of the dequeued values. Nil if disabled.
+	history *key_lru[K]
+}
+
+// NewUniqueQueue is a function that creates and returns a new instance of a
+// UniqueQueue whose values are their own keys.
+//
+// Parameters:
+//   - opts: The options of the queue. Supported options are WithCapacity, without
+//     which the queue is unlimited, WithInitialValues, whose duplicates are
+//     ignored, and WithHistory.
+//
+// Returns:
+//   - *UniqueQueue[T, T]: A pointer to the newly created UniqueQueue.
+//   - error: An error if the options are invalid.
+func NewUniqueQueue[T comparable](opts ...options.Option) (*UniqueQueue[T, T], error) {
+	queue, err := new_unique_queue(func(value T) T { return value }, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	queue.identity = true
+
+	return queue, nil
+}
+
+// NewUniqueQueueFunc is a function that creates and returns a new instance of a
+// UniqueQueue whose values are compared by the given key.
+//
+// Parameters:
+//   - key: The function that returns the key of a value.
+//   - opts: The options of the queue. See NewUniqueQueue.
+//
+// Returns:
+//   - *UniqueQueue[T, K]: A pointer to the newly created UniqueQueue.
+//   - error: An error of type *errors.ErrInvalidParameter if key is nil, or an
+//     error if the options are invalid.
+func NewUniqueQueueFunc[T any, K comparable](key func(value T) K, opts ...options.Option) (*UniqueQueue[T, K], error) {
+	if key == nil {
+		return nil, gcers.NewErrNilParameter("key")
+	}
+
+	return new_unique_queue(key, opts)
+}
+
+// new_unique_queue creates a new UniqueQueue.
+//
+// Parameters:
+//   - key: The key function. Assumed to be non-nil.
+//   - opts: The options of the queue.
+//
+// Returns:
+//   - *UniqueQueue[T, K]: The new queue.
+//   - error: An error if the options are invalid.
+func new_unique_queue[T any, K comparable](key func(value T) K, opts []options.Option) (*UniqueQueue[T, K], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Only("UniqueQueue", "WithCapacity", "WithInitialValues", "WithHistory")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := &UniqueQueue[T, K]{
+		capacity: s.Capacity,
+		key:      key,
+		pending:  make(map[K]struct{}),
+		history:  new_key_lru[K](s.History),
+	}
+
+	queue.EnqueueMany(values)
+
+	return queue, nil
+}
+
+// EnqueueUnique is a method of the UniqueQueue type. It adds a value to the back
+// of the queue unless a value with the same key is pending or remembered.
+//
+// Parameters:
+//   - value: The value to add.
+//
+// Returns:
+//   - bool: True if the value was added, false otherwise.
+//   - bool: True if the value was ignored because it is a duplicate.
+func (queue *UniqueQueue[T, K]) EnqueueUnique(value T) (bool, bool) {
+	k := queue.key(value)
+
+	if _, ok := queue.pending[k]; ok {
+		return false, true
+	}
+
+	if queue.history.touch(k) {
+		return false, true
+	}
+
+	if queue.capacity != -1 && queue.size >= queue.capacity {
+		return false, false
+	}
+
+	node := &unique_node[T, K]{
+		value: value,
+		key:   k,
+	}
+
+	if queue.back == nil {
+		queue.front = node
+	} else {
+		queue.back.next = node
+	}
+
+	queue.back = node
+	queue.size++
+
+	queue.pending[k] = struct{}{}
+
+	return true, false
+}
+
+// Enqueue implements the Queuer interface.
+//
+// Returns false if the value is a duplicate; see EnqueueUnique to tell it apart
+// from a full queue.
+func (queue *UniqueQueue[T, K]) Enqueue(value T) bool {
+	ok, _ := queue.EnqueueUnique(value)
+	return ok
+}
+
+// EnqueueMany implements the Queuer interface.
+//
+// Duplicates are skipped and not counted; it stops at the first value that does
+// not fit.
+func (queue *UniqueQueue[T, K]) EnqueueMany(values []T) int {
+	var count int
+
+	for _, value := range values {
+		ok, duplicate := queue.EnqueueUnique(value)
+		if ok {
+			count++
+		} else if !duplicate {
+			break
+		}
+	}
+
+	return count
+}
+
+// Dequeue implements the Queuer interface.
+//
+// The key of the value is remembered if the history is enabled.
+func (queue *UniqueQueue[T, K]) Dequeue() (T, bool) {
+	if queue.front == nil {
+		return *new(T), false
+	}
+
+	to_remove := queue.front
+
+	queue.front = to_remove.next
+	if queue.front == nil {
+		queue.back = nil
+	}
+
+	queue.size--
+
+	to_remove.next = nil
+
+	// The key is the one computed by EnqueueUnique: the value may have changed
+	// since, and its new key would leave the old one pending forever.
+	delete(queue.pending, to_remove.key)
+	queue.history.add(to_remove.key)
+
+	return to_remove.value, true
+}
+
+// Peek implements the Queuer interface.
+func (queue *UniqueQueue[T, K]) Peek() (T, bool) {
+	if queue.front == nil {
+		return *new(T), false
+	}
+
+	return queue.front.value, true
+}
+
+// Contains is a method of the UniqueQueue type. It checks whether a value with the
+// same key is pending.
+//
+// Parameters:
+//   - value: The value to check.
+//
+// Returns:
+//   - bool: True if the value is pending, false otherwise.
+func (queue *UniqueQueue[T, K]) Contains(value T) bool {
+	_, ok := queue.pending[queue.key(value)]
+	return ok
+}
+
+// Seen is a method of the UniqueQueue type. It checks whether a value with the
+// same key is pending or remembered; that is, whether enqueuing the value would be
+// ignored. Unlike EnqueueUnique, it does not refresh the history.
+//
+// Parameters:
+//   - value: The value to check.
+//
+// Returns:
+//   - bool: True if the value has been seen, false otherwise.
+func (queue *UniqueQueue[T, K]) Seen(value T) bool {
+	k := queue.key(value)
+
+	if _, ok := queue.pending[k]; ok {
+		return true
+	}
+
+	return queue.history.contains(k)
+}
+
+// Forget is a method of the UniqueQueue type. It removes the key of the value from
+// the history so that the value can be enqueued again. Pending values are not
+// affected.
+//
+// Parameters:
+//   - value: The value to forget.
+//
+// Returns:
+//   - bool: True if the key was in the history, false otherwise.
+func (queue *UniqueQueue[T, K]) Forget(value T) bool {
+	return queue.history.remove(queue.key(value))
+}
+
+// ClearHistory is a method of the UniqueQueue type. It forgets all the dequeued
+// values.
+func (queue *UniqueQueue[T, K]) ClearHistory() {
+	queue.history.clear()
+}
+
+// IsEmpty implements the Queuer interface.
+func (queue *UniqueQueue[T, K]) IsEmpty() bool {
+	return queue.front == nil
+}
+
+// Size implements the Queuer interface.
+func (queue *UniqueQueue[T, K]) Size() int {
+	return queue.size
+}
+
+// Iterator implements the Queuer interface.
+func (queue *UniqueQueue[T, K]) Iterator() itrs.Iterater[T] {
+	return itrs.NewSimpleIterator(queue.Slice())
+}
+
+// Clear implements the Queuer interface.
+//
+// The pending values are dropped without being added to the history.
+func (queue *UniqueQueue[T, K]) Clear() {
+	for node := queue.front; node != nil; {
+		next := node.next
+		node.next = nil
+		node = next
+	}
+
+	queue.front = nil
+	queue.back = nil
+	queue.size = 0
+
+	clear(queue.pending)
+}
+
+// layout returns the description of the queue used to format it.
+func (queue *UniqueQueue[T, K]) layout() display.Container[T] {
+	var opts []string
+
+	if queue.capacity != -1 {
+		opts = append(opts, display.Option("WithCapacity", queue.capacity))
+	}
+
+	if queue.history != nil {
+		opts = append(opts, display.Option("WithHistory", queue.history.limit))
+	}
+
+	return display.Container[T]{
+		Pkg:          "queue",
+		Name:         "UniqueQueue",
+		TypeArgs:     []string{display.TypeName[T](), display.TypeName[K]()},
+		CtorTypeArgs: []string{display.TypeName[T]()},
+		Options:      opts,
+		Opaque:       !queue.identity,
+		Capacity:     queue.capacity,
+		Marker:       display.Front,
+		Values:       queue.Slice(),
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+//
+// The %#v verb cannot rebuild a queue created with NewUniqueQueueFunc, as its key
+// function has no Go syntax; it gives the detailed form instead.
+func (queue *UniqueQueue[T, K]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, queue.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (queue *UniqueQueue[T, K]) String() string {
+	return fmt.Sprint(queue)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (queue *UniqueQueue[T, K]) GoString() string {
+	return queue.layout().GoSyntax()
+}
+
+// Slice implements the Queuer interface.
+func (queue *UniqueQueue[T, K]) Slice() []T {
+	slice := make([]T, 0, queue.size)
+
+	for node := queue.front; node != nil; node = node.next {
+		slice = append(slice, node.value)
+	}
+
+	return slice
+}
+
+// Capacity implements the Queuer interface.
+func (queue *UniqueQueue[T, K]) Capacity() int {
+	return queue.capacity
+}
+
+// IsFull implements the Queuer interface.
+func (queue *UniqueQueue[T, K]) IsFull() bool {
+	return queue.capacity != -1 && queue.size >= queue.capacity
+}
+
+// key_lru is a bounded set of keys that forgets the least recently used one when
+// it is full. The nil pointer is a valid set that remembers nothing.
+type key_lru[K comparable] struct {
+	// order holds the keys, from the most recently used to the least.
+	order *list.List
+
+	// index maps the keys to their element in order.
+	index map[K]*list.Element
+
+	// limit is the maximum number of keys.
+	limit int
+}
+
+// new_key_lru creates a new LRU set of keys.
+//
+// Parameters:
+//   - limit: The maximum number of keys.
+//
+// Returns:
+//   - *key_lru[K]: The new set. Nil if limit is not positive.
+func new_key_lru[K comparable](limit int) *key_lru[K] {
+	if limit <= 0 {
+		return nil
+	}
+
+	return &key_lru[K]{
+		order: list.New(),
+		index: make(map[K]*list.Element),
+		limit: limit,
+	}
+}
+
+// add adds the key as the most recently used one, forgetting the least recently
+// used key if the set is full.
+//
+// Parameters:
+//   - k: The key.
+func (lru *key_lru[K]) add(k K) {
+	if lru == nil || lru.touch(k) {
+		return
+	}
+
+	if lru.order.Len() >= lru.limit {
+		oldest := lru.order.Back()
+
+		lru.order.Remove(oldest)
+		delete(lru.index, oldest.Value.(K))
+	}
+
+	lru.index[k] = lru.order.PushFront(k)
+}
+
+// touch marks the key as the most recently used one if it is in the set.
+//
+// Parameters:
+//   - k: The key.
+//
+// Returns:
+//   - bool: True if the key is in the set, false otherwise.
+func (lru *key_lru[K]) touch(k K) bool {
+	if lru == nil {
+		return false
+	}
+
+	elem, ok := lru.index[k]
+	if ok {
+		lru.order.MoveToFront(elem)
+	}
+
+	return ok
+}
+
+// contains checks whether the key is in the set.
+//
+// Parameters:
+//   - k: The key.
+//
+// Returns:
+//   - bool: True if the key is in the set, false otherwise.
+func (lru *key_lru[K]) contains(k K) bool {
+	if lru == nil {
+		return false
+	}
+
+	_, ok := lru.index[k]
+	return ok
+}
+
+// remove removes the key from the set.
+//
+// Parameters:
+//   - k: The key.
+//
+// Returns:
+//   - bool: True if the key was in the set, false otherwise.
+func (lru *key_lru[K]) remove(k K) bool {
+	if lru == nil {
+		return false
+	}
+
+	elem, ok := lru.index[k]
+	if ok {
+		lru.order.Remove(elem)
+		delete(lru.index, k)
+	}
+
+	return ok
+}
+
+// clear removes all the keys from the set.
+func (lru *key_lru[K]) clear() {
+	if lru == nil {
+		return
+	}
+
+	lru.order.Init()
+	clear(lru.index)
+}
@@ -0,0 +1,138 @@
+package queue
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/PlayerR9/listlike/options"
+)
+
+// TestUniqueQueueEnqueueUnique checks that EnqueueUnique tells the duplicates
+// apart from a full queue.
+func TestUniqueQueueEnqueueUnique(t *testing.T) {
+	queue, err := NewUniqueQueue[int](options.WithCapacity(2), options.WithInitialValues[int](1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		value     int
+		ok        bool
+		duplicate bool
+	}{
+		{1, false, true},
+		{2, true, false},
+		{2, false, true},
+		{3, false, false},
+		{1, false, true},
+	}
+
+	for _, test := range tests {
+		ok, duplicate := queue.EnqueueUnique(test.value)
+		if ok != test.ok || duplicate != test.duplicate {
+			t.Fatalf("EnqueueUnique(%d): got %t, %t, want %t, %t", test.value, ok, duplicate, test.ok, test.duplicate)
+		}
+	}
+
+	if got := queue.Slice(); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("got %v, want [1 2]", got)
+	}
+
+	if n := queue.EnqueueMany([]int{1, 2, 3}); n != 0 {
+		t.Fatalf("EnqueueMany added %d values to a full queue", n)
+	}
+
+	queue.Dequeue()
+
+	if !queue.Enqueue(1) {
+		t.Fatal("a dequeued value was still a duplicate without history")
+	}
+
+	if got := queue.Slice(); !slices.Equal(got, []int{2, 1}) {
+		t.Fatalf("got %v, want [2 1]", got)
+	}
+}
+
+// TestUniqueQueueHistory checks that the history forgets the least recently
+// used keys, where trying to enqueue a remembered value uses its key.
+func TestUniqueQueueHistory(t *testing.T) {
+	queue, err := NewUniqueQueue[int](options.WithHistory(2), options.WithInitialValues[int](1, 2, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		queue.Dequeue()
+	}
+
+	// The history is now 2, 1 from the most recently used.
+	if queue.Enqueue(1) {
+		t.Fatal("enqueued the remembered value 1")
+	}
+
+	// Touching 1 made 2 the least recently used, which dequeuing 3 forgets.
+	queue.Dequeue()
+
+	if queue.Seen(2) {
+		t.Fatal("2 was remembered after it was the least recently used")
+	}
+
+	for _, value := range []int{1, 3} {
+		if !queue.Seen(value) {
+			t.Fatalf("%d was not remembered", value)
+		}
+	}
+
+	if !queue.Forget(1) || queue.Forget(1) {
+		t.Fatal("Forget(1) did not remove 1 exactly once")
+	}
+
+	if !queue.Enqueue(1) || !queue.Enqueue(2) || queue.Enqueue(3) {
+		t.Fatalf("got %v, want [1 2] with 3 remembered", queue.Slice())
+	}
+
+	queue.ClearHistory()
+
+	if !queue.Enqueue(3) {
+		t.Fatal("3 was remembered after ClearHistory")
+	}
+
+	queue.Clear()
+
+	if queue.Seen(1) {
+		t.Fatal("Clear added the pending values to the history")
+	}
+}
+
+// TestUniqueQueueMutatedKey checks that a value whose key changed while it was
+// pending does not stay pending once it is dequeued.
+func TestUniqueQueueMutatedKey(t *testing.T) {
+	type job struct {
+		name string
+	}
+
+	queue, err := NewUniqueQueueFunc(func(j *job) string { return j.name })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	j := &job{name: "a"}
+
+	if !queue.Enqueue(j) {
+		t.Fatal("could not enqueue the job")
+	}
+
+	j.name = "b"
+
+	if _, ok := queue.Dequeue(); !ok {
+		t.Fatal("could not dequeue the job")
+	}
+
+	if queue.Contains(&job{name: "a"}) {
+		t.Fatal("the old key of the job is still pending")
+	}
+
+	if !queue.Enqueue(&job{name: "a"}) {
+		t.Fatal("could not enqueue a new job with the old key")
+	}
+}
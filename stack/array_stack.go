@@ -1,11 +1,12 @@
 package stack
 
 import (
-	"slices"
-	"strconv"
-	"strings"
+	"fmt"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/internal/growth"
+	"github.com/PlayerR9/listlike/options"
 
-	gcstr "github.com/PlayerR9/go-commons/strings"
 	itrs "github.com/PlayerR9/iterators/simple"
 )
 
@@ -14,34 +15,65 @@ import (
 type ArrayStack[T any] struct {
 	// values is a slice of type T that stores the elements in the stack.
 	values []T
+
+	// policy is the capacity policy of the values.
+	policy growth.Policy
 }
 
 // NewArrayStack is a function that creates and returns a new instance of a
 // ArrayStack.
 //
 // Parameters:
-//
-//   - values: A variadic parameter of type T, which represents the initial values to be
-//     stored in the stack.
+//   - opts: The options of the stack. Supported options are WithInitialValues,
+//     which are pushed in order so that the last one is on top, WithGrowthFactor,
+//     WithReserve and WithShrinkThreshold.
 //
 // Returns:
-//
 //   - *ArrayStack[T]: A pointer to the newly created ArrayStack.
-func NewArrayStack[T any](values ...T) *ArrayStack[T] {
-	slices.Reverse(values)
+//   - error: An error if the options are invalid.
+func NewArrayStack[T any](opts ...options.Option) (*ArrayStack[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Only("ArrayStack", array_stack_options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new_array_stack[T](s)
+}
+
+// new_array_stack creates a new ArrayStack from validated settings.
+//
+// Parameters:
+//   - s: The settings. Assumed to be non-nil.
+//
+// Returns:
+//   - *ArrayStack[T]: The new stack.
+//   - error: An error if the initial values are invalid.
+func new_array_stack[T any](s *options.Settings) (*ArrayStack[T], error) {
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := growth.New(s)
 
 	stack := &ArrayStack[T]{
-		values: make([]T, len(values)),
+		values: growth.Clone(policy, values),
+		policy: policy,
 	}
-	copy(stack.values, values)
 
-	return stack
+	return stack, nil
 }
 
 // Push implements the Stacker interface.
 //
 // Always returns true.
 func (stack *ArrayStack[T]) Push(value T) bool {
+	stack.values = growth.Grow(stack.policy, stack.values, 1)
 	stack.values = append(stack.values, value)
 
 	return true
@@ -49,6 +81,7 @@ func (stack *ArrayStack[T]) Push(value T) bool {
 
 // PushMany implements the Stacker interface.
 func (stack *ArrayStack[T]) PushMany(values []T) int {
+	stack.values = growth.Grow(stack.policy, stack.values, len(values))
 	stack.values = append(stack.values, values...)
 
 	return len(values)
@@ -62,6 +95,7 @@ func (stack *ArrayStack[T]) Pop() (T, bool) {
 
 	toRemove := stack.values[len(stack.values)-1]
 	stack.values = stack.values[:len(stack.values)-1]
+	stack.values = growth.Shrink(stack.policy, stack.values)
 
 	return toRemove, true
 }
@@ -116,25 +150,35 @@ func (stack *ArrayStack[T]) Iterator() itrs.Iterater[T] {
 // Clear is a method of the ArrayStack type. It is used to remove aCommon elements from the
 // stack, making it empty.
 func (stack *ArrayStack[T]) Clear() {
-	stack.values = make([]T, 0)
+	stack.values = make([]T, 0, stack.policy.Reserve)
 }
 
-// GoString implements the fmt.GoStringer interface.
-func (stack *ArrayStack[T]) GoString() string {
-	values := make([]string, 0, len(stack.values))
-	for _, value := range stack.values {
-		values = append(values, gcstr.GoStringOf(value))
+// layout returns the description of the stack used to format it.
+func (stack *ArrayStack[T]) layout() display.Container[T] {
+	return display.Container[T]{
+		Pkg:      "stack",
+		Name:     "ArrayStack",
+		TypeArgs: []string{display.TypeName[T]()},
+		Options:  stack.policy.Options(),
+		Capacity: -1,
+		Marker:   display.Top,
+		Values:   stack.values,
 	}
+}
 
-	var builder strings.Builder
+// Format implements the fmt.Formatter interface.
+func (stack *ArrayStack[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, stack.layout())
+}
 
-	builder.WriteString("ArrayStack{size=")
-	builder.WriteString(strconv.Itoa(len(stack.values)))
-	builder.WriteString(", values=[")
-	builder.WriteString(strings.Join(values, ", "))
-	builder.WriteString(" →]}")
+// String implements the fmt.Stringer interface.
+func (stack *ArrayStack[T]) String() string {
+	return fmt.Sprint(stack)
+}
 
-	return builder.String()
+// GoString implements the fmt.GoStringer interface.
+func (stack *ArrayStack[T]) GoString() string {
+	return stack.layout().GoSyntax()
 }
 
 // Slice is a method of the ArrayStack type. It is used to return a slice of the
@@ -174,9 +218,24 @@ func (stack *ArrayStack[T]) IsFull() bool {
 //   - uc.Copier: A copy of the stack.
 func (stack *ArrayStack[T]) Copy() *ArrayStack[T] {
 	stack_copy := &ArrayStack[T]{
-		values: make([]T, len(stack.values)),
+		values: growth.Clone(stack.policy, stack.values),
+		policy: stack.policy,
 	}
-	copy(stack_copy.values, stack.values)
 
 	return stack_copy
 }
+
+// Reserve is a method of the ArrayStack type. It makes room for at least n more
+// elements so that adding them does not reallocate the backing array.
+//
+// Parameters:
+//   - n: The number of elements to make room for. Non-positive values are a no-op.
+func (stack *ArrayStack[T]) Reserve(n int) {
+	stack.values = growth.Reserve(stack.policy, stack.values, n)
+}
+
+// ShrinkToFit is a method of the ArrayStack type. It releases the unused part of
+// the backing array, except for the reserve the stack was created with.
+func (stack *ArrayStack[T]) ShrinkToFit() {
+	stack.values = growth.Fit(stack.policy, stack.values)
+}
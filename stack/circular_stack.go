@@ -0,0 +1,273 @@
+package stack
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+
+	gcers "github.com/PlayerR9/go-commons/errors"
+	gcint "github.com/PlayerR9/go-commons/ints"
+	itrs "github.com/PlayerR9/iterators/simple"
+)
+
+// CircularStack is a generic type that represents a stack that keeps the most
+// recent values pushed onto it. It is implemented using a ring buffer: when it is
+// full, pushing a value overwrites the value at the bottom of the stack, that is,
+// the oldest one.
+type CircularStack[T any] struct {
+	// values is the ring buffer. Its length is the capacity of the stack.
+	values []T
+
+	// next is the index of the slot above the top of the stack.
+	next int
+
+	// size is the number of elements in the stack.
+	size int
+}
+
+// NewCircularStack is a function that creates and returns a new instance of a
+// CircularStack.
+//
+// Parameters:
+//   - capacity: The number of values the stack keeps. Must be positive.
+//   - opts: The options of the stack. The only supported option is
+//     WithInitialValues, which are pushed in order so that the last one is on top;
+//     only the last capacity of them are kept.
+//
+// Returns:
+//   - *CircularStack[T]: A pointer to the newly created CircularStack.
+//   - error: An error of type *errors.ErrInvalidParameter if capacity is not
+//     positive, or an error if the options are invalid.
+func NewCircularStack[T any](capacity int, opts ...options.Option) (*CircularStack[T], error) {
+	if capacity <= 0 {
+		return nil, gcers.NewErrInvalidParameter("capacity", gcint.NewErrGT(0))
+	}
+
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Only("CircularStack", "WithInitialValues")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
+	}
+
+	stack := &CircularStack[T]{
+		values: make([]T, capacity),
+	}
+
+	stack.PushMany(values)
+
+	return stack, nil
+}
+
+// index returns the index in the ring buffer of the i-th element from the top.
+//
+// Parameters:
+//   - i: The position from the top. Assumed to be in the range [0, size).
+//
+// Returns:
+//   - int: The index in the ring buffer.
+func (stack *CircularStack[T]) index(i int) int {
+	idx := stack.next - 1 - i
+	if idx < 0 {
+		idx += len(stack.values)
+	}
+
+	return idx
+}
+
+// PushEvict is a method of the CircularStack type. It adds a value on top of the
+// stack, evicting the value at the bottom if the stack is full.
+//
+// Parameters:
+//   - value: The value to add.
+//
+// Returns:
+//   - T: The evicted value, if any.
+//   - bool: True if a value was evicted, false otherwise.
+func (stack *CircularStack[T]) PushEvict(value T) (T, bool) {
+	var (
+		evicted T
+		ok      bool
+	)
+
+	if stack.size == len(stack.values) {
+		// The bottom of a full stack is the slot the new value goes to.
+		evicted = stack.values[stack.next]
+		ok = true
+	} else {
+		stack.size++
+	}
+
+	stack.values[stack.next] = value
+
+	stack.next++
+	if stack.next == len(stack.values) {
+		stack.next = 0
+	}
+
+	return evicted, ok
+}
+
+// Push implements the Stacker interface.
+//
+// The value at the bottom is evicted if the stack is full; see PushEvict to get
+// it. Always returns true.
+func (stack *CircularStack[T]) Push(value T) bool {
+	stack.PushEvict(value)
+
+	return true
+}
+
+// PushMany implements the Stacker interface.
+//
+// Only the last Capacity() values are kept if there are more. Always returns the
+// number of values pushed, evicted or not.
+func (stack *CircularStack[T]) PushMany(values []T) int {
+	for _, value := range values {
+		stack.PushEvict(value)
+	}
+
+	return len(values)
+}
+
+// Pop implements the Stacker interface.
+func (stack *CircularStack[T]) Pop() (T, bool) {
+	if stack.size == 0 {
+		return *new(T), false
+	}
+
+	idx := stack.index(0)
+
+	toRemove := stack.values[idx]
+	stack.values[idx] = *new(T)
+
+	stack.next = idx
+	stack.size--
+
+	return toRemove, true
+}
+
+// Peek implements the Stacker interface.
+func (stack *CircularStack[T]) Peek() (T, bool) {
+	if stack.size == 0 {
+		return *new(T), false
+	}
+
+	return stack.values[stack.index(0)], true
+}
+
+// IsEmpty implements the Stacker interface.
+func (stack *CircularStack[T]) IsEmpty() bool {
+	return stack.size == 0
+}
+
+// Size implements the Stacker interface.
+func (stack *CircularStack[T]) Size() int {
+	return stack.size
+}
+
+// Iterator implements the Stacker interface.
+//
+// The values are iterated from the top to the bottom.
+func (stack *CircularStack[T]) Iterator() itrs.Iterater[T] {
+	var builder itrs.Builder[T]
+
+	for i := 0; i < stack.size; i++ {
+		builder.Add(stack.values[stack.index(i)])
+	}
+
+	return builder.Build()
+}
+
+// Clear implements the Stacker interface.
+func (stack *CircularStack[T]) Clear() {
+	clear(stack.values)
+
+	stack.next = 0
+	stack.size = 0
+}
+
+// layout returns the description of the stack used to format it.
+func (stack *CircularStack[T]) layout() display.Container[T] {
+	values := make([]T, stack.size)
+
+	for i := 0; i < stack.size; i++ {
+		values[stack.size-1-i] = stack.values[stack.index(i)]
+	}
+
+	return display.Container[T]{
+		Pkg:      "stack",
+		Name:     "CircularStack",
+		TypeArgs: []string{display.TypeName[T]()},
+		Args:     []string{strconv.Itoa(len(stack.values))},
+		Capacity: len(stack.values),
+		Marker:   display.Top,
+		Values:   values,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (stack *CircularStack[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, stack.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (stack *CircularStack[T]) String() string {
+	return fmt.Sprint(stack)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (stack *CircularStack[T]) GoString() string {
+	return stack.layout().GoSyntax()
+}
+
+// Slice implements the Stacker interface.
+//
+// The last element is the top of the stack.
+func (stack *CircularStack[T]) Slice() []T {
+	slice := make([]T, stack.size)
+
+	for i := 0; i < stack.size; i++ {
+		slice[stack.size-1-i] = stack.values[stack.index(i)]
+	}
+
+	return slice
+}
+
+// Capacity implements the Stacker interface.
+func (stack *CircularStack[T]) Capacity() int {
+	return len(stack.values)
+}
+
+// IsFull implements the Stacker interface.
+//
+// A full stack still accepts values, evicting the oldest one.
+func (stack *CircularStack[T]) IsFull() bool {
+	return stack.size == len(stack.values)
+}
+
+// Copy is a method of the CircularStack type. It is used to create a shallow copy
+// of the stack.
+//
+// Returns:
+//   - *CircularStack[T]: A copy of the stack. Never returns nil.
+func (stack *CircularStack[T]) Copy() *CircularStack[T] {
+	values := make([]T, len(stack.values))
+	copy(values, stack.values)
+
+	return &CircularStack[T]{
+		values: values,
+		next:   stack.next,
+		size:   stack.size,
+	}
+}
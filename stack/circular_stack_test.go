@@ -0,0 +1,131 @@
+package stack
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/PlayerR9/listlike/options"
+
+	itrs "github.com/PlayerR9/iterators/simple"
+)
+
+// consume_all returns the values of the iterator in order.
+func consume_all[T any](iter itrs.Iterater[T]) []T {
+	var values []T
+
+	for value, err := iter.Consume(); err == nil; value, err = iter.Consume() {
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// TestCircularStackWraparound checks the stack against a slice that keeps the
+// last values pushed, over many wraps of the ring buffer.
+func TestCircularStackWraparound(t *testing.T) {
+	const capacity = 4
+
+	rng := rand.New(rand.NewSource(1))
+
+	stack, err := NewCircularStack[int](capacity)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// model holds the values from the bottom to the top.
+	var model []int
+
+	for op := 0; op < 2000; op++ {
+		if rng.Intn(3) == 0 {
+			value, ok := stack.Pop()
+
+			if len(model) == 0 {
+				if ok {
+					t.Fatalf("op %d: popped %d from an empty stack", op, value)
+				}
+			} else {
+				want := model[len(model)-1]
+				model = model[:len(model)-1]
+
+				if !ok || value != want {
+					t.Fatalf("op %d: got %d, %t, want %d", op, value, ok, want)
+				}
+			}
+		} else {
+			evicted, ok := stack.PushEvict(op)
+
+			if len(model) == capacity {
+				if !ok || evicted != model[0] {
+					t.Fatalf("op %d: evicted %d, %t, want %d", op, evicted, ok, model[0])
+				}
+
+				model = model[1:]
+			} else if ok {
+				t.Fatalf("op %d: evicted %d from a stack that is not full", op, evicted)
+			}
+
+			model = append(model, op)
+		}
+
+		if got := stack.Slice(); !slices.Equal(got, model) {
+			t.Fatalf("op %d: got %v, want %v", op, got, model)
+		}
+
+		top_down := slices.Clone(model)
+		slices.Reverse(top_down)
+
+		if got := consume_all(stack.Iterator()); !slices.Equal(got, top_down) {
+			t.Fatalf("op %d: iterated %v, want %v", op, got, top_down)
+		}
+
+		if stack.Size() != len(model) || stack.IsFull() != (len(model) == capacity) {
+			t.Fatalf("op %d: got size %d, full %t, want %d", op, stack.Size(), stack.IsFull(), len(model))
+		}
+
+		if top, ok := stack.Peek(); ok != (len(model) > 0) || ok && top != model[len(model)-1] {
+			t.Fatalf("op %d: peeked %d, %t, want %v", op, top, ok, model)
+		}
+	}
+}
+
+// TestCircularStackPopAfterWrap checks that a wrapped stack pops its values
+// across the end of the ring buffer, and then accepts new ones.
+func TestCircularStackPopAfterWrap(t *testing.T) {
+	stack, err := NewCircularStack[int](3, options.WithInitialValues[int](1, 2, 3, 4, 5))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []int{5, 4, 3} {
+		if got, ok := stack.Pop(); !ok || got != want {
+			t.Fatalf("got %d, %t, want %d", got, ok, want)
+		}
+	}
+
+	if _, ok := stack.Pop(); ok {
+		t.Fatal("popped from an empty stack")
+	}
+
+	stack.PushMany([]int{6, 7})
+
+	if got := consume_all(stack.Iterator()); !slices.Equal(got, []int{7, 6}) {
+		t.Fatalf("iterated %v, want [7 6]", got)
+	}
+
+	copied := stack.Copy()
+	copied.Push(8)
+	copied.Push(9)
+
+	if got := stack.Slice(); !slices.Equal(got, []int{6, 7}) {
+		t.Fatalf("pushing onto the copy changed the stack to %v", got)
+	}
+
+	if got := copied.Slice(); !slices.Equal(got, []int{7, 8, 9}) {
+		t.Fatalf("got copy %v, want [7 8 9]", got)
+	}
+
+	if _, err := NewCircularStack[int](0); err == nil {
+		t.Fatal("got no error for a zero capacity")
+	}
+}
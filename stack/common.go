@@ -2,6 +2,10 @@ package stack
 
 import (
 	"fmt"
+
+	"github.com/PlayerR9/listlike/display"
+
+	itrs "github.com/PlayerR9/iterators/simple"
 )
 
 // Stacker is an interface that defines methods for a stack data structure.
@@ -75,9 +79,50 @@ type Stacker[T any] interface {
 	// 	- []T: A slice of the values in the stack.
 	Slice() []T
 
+	// Iterator returns an iterator over the stack, from the top to the bottom.
+	// It makes every stack an fn.Iterable. Stackers implemented outside this
+	// package must add it: they no longer satisfy the interface without it.
+	//
+	// Returns:
+	//  	- itrs.Iterater[T]: An iterator for the stack.
+	Iterator() itrs.Iterater[T]
+
 	fmt.GoStringer
 }
 
+// layouter is implemented by the stacks that describe themselves to be formatted.
+type layouter[T any] interface {
+	// layout returns the description of the stack used to format it.
+	layout() display.Container[T]
+}
+
+// format_wrapped formats a stack that wraps another one. If the wrapped stack
+// describes itself, the detailed form gives the label prefix; otherwise, it
+// formats itself.
+//
+// Parameters:
+//   - f: The state of the formatter.
+//   - verb: The verb.
+//   - prefix: The prefix of the label of the detailed form, such as "Observed".
+//   - stack: The wrapped stack.
+func format_wrapped[T any](f fmt.State, verb rune, prefix string, stack Stacker[T]) {
+	l, ok := stack.(layouter[T])
+	if !ok {
+		fmt.Fprintf(f, fmt.FormatString(f, verb), stack)
+		return
+	}
+
+	c := l.layout()
+
+	if c.Label == "" {
+		c.Label = c.Name
+	}
+
+	c.Label = prefix + c.Label
+
+	display.Format(f, verb, c)
+}
+
 // StackNode represents a node in a linked list.
 type StackNode[T any] struct {
 	// value is the value stored in the node.
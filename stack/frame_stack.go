@@ -0,0 +1,344 @@
+package stack
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/internal/growth"
+	"github.com/PlayerR9/listlike/options"
+
+	gcers "github.com/PlayerR9/go-commons/errors"
+	gcint "github.com/PlayerR9/go-commons/ints"
+	itrs "github.com/PlayerR9/iterators/simple"
+)
+
+// FrameStack is a generic type that represents a stack whose values are grouped
+// into frames, such as the call frames of an interpreter. It is implemented on top
+// of an ArrayStack.
+//
+// The values pushed before any PushFrame belong to the root frame, which cannot be
+// popped. Push, Pop and Peek work on the current frame: Pop never takes a value
+// from an enclosing frame. Local gives access to the values of the current frame
+// by their position in it, and LocalAt to those of the enclosing frames.
+type FrameStack[T any] struct {
+	// stack holds the values of all the frames.
+	stack *ArrayStack[T]
+
+	// bases are the indices, in stack, of the first value of each frame opened
+	// with PushFrame, from the outermost to the innermost.
+	bases []int
+}
+
+// NewFrameStack is a function that creates and returns a new instance of a
+// FrameStack.
+//
+// Parameters:
+//   - opts: The options of the underlying ArrayStack; see NewArrayStack. The
+//     initial values belong to the root frame.
+//
+// Returns:
+//   - *FrameStack[T]: A pointer to the newly created FrameStack.
+//   - error: An error if the options are invalid.
+func NewFrameStack[T any](opts ...options.Option) (*FrameStack[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Only("FrameStack", array_stack_options...)
+	if err != nil {
+		return nil, err
+	}
+
+	stack, err := new_array_stack[T](s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FrameStack[T]{
+		stack: stack,
+	}, nil
+}
+
+// base returns the index of the first value of a frame.
+//
+// Parameters:
+//   - up: The number of frames to go up from the current one. Assumed to be in
+//     the range [0, Depth()].
+//
+// Returns:
+//   - int: The index of the first value of the frame.
+func (fs *FrameStack[T]) base(up int) int {
+	i := len(fs.bases) - 1 - up
+	if i < 0 {
+		return 0
+	}
+
+	return fs.bases[i]
+}
+
+// end returns the index past the last value of a frame.
+//
+// Parameters:
+//   - up: The number of frames to go up from the current one. Assumed to be in
+//     the range [0, Depth()].
+//
+// Returns:
+//   - int: The index past the last value of the frame.
+func (fs *FrameStack[T]) end(up int) int {
+	if up == 0 {
+		return len(fs.stack.values)
+	}
+
+	return fs.base(up - 1)
+}
+
+// PushFrame is a method of the FrameStack type. It opens a new, empty frame on
+// top of the current one.
+func (fs *FrameStack[T]) PushFrame() {
+	fs.bases = append(fs.bases, len(fs.stack.values))
+}
+
+// PopFrame is a method of the FrameStack type. It closes the current frame and
+// removes its values.
+//
+// Returns:
+//   - []T: The values of the frame, from the bottom to the top.
+//   - bool: True if a frame was closed, false if the current frame is the root
+//     frame.
+func (fs *FrameStack[T]) PopFrame() ([]T, bool) {
+	if len(fs.bases) == 0 {
+		return nil, false
+	}
+
+	base := fs.bases[len(fs.bases)-1]
+	fs.bases = fs.bases[:len(fs.bases)-1]
+
+	values := fs.stack.values
+
+	frame := slices.Clone(values[base:])
+
+	clear(values[base:])
+
+	fs.stack.values = growth.Shrink(fs.stack.policy, values[:base])
+
+	return frame, true
+}
+
+// Depth is a method of the FrameStack type. It returns the number of frames opened
+// with PushFrame and not closed yet.
+//
+// Returns:
+//   - int: The depth. 0 when only the root frame is open.
+func (fs *FrameStack[T]) Depth() int {
+	return len(fs.bases)
+}
+
+// FrameSize is a method of the FrameStack type. It returns the number of values in
+// the current frame.
+//
+// Returns:
+//   - int: The number of values in the current frame.
+func (fs *FrameStack[T]) FrameSize() int {
+	return fs.end(0) - fs.base(0)
+}
+
+// Local is a method of the FrameStack type. It returns a value of the current
+// frame by its position in it.
+//
+// Parameters:
+//   - i: The position of the value. 0 is the first value pushed in the frame.
+//
+// Returns:
+//   - T: The value.
+//   - error: An error of type *errors.ErrInvalidParameter if i is out of bounds.
+func (fs *FrameStack[T]) Local(i int) (T, error) {
+	return fs.LocalAt(0, i)
+}
+
+// LocalAt is a method of the FrameStack type. It returns a value of the current
+// frame or of one of its enclosing frames by its position in that frame.
+//
+// Parameters:
+//   - up: The number of frames to go up from the current one. 0 is the current
+//     frame and Depth() is the root frame.
+//   - i: The position of the value. 0 is the first value pushed in the frame.
+//
+// Returns:
+//   - T: The value.
+//   - error: An error of type *errors.ErrInvalidParameter if up or i is out of
+//     bounds.
+func (fs *FrameStack[T]) LocalAt(up, i int) (T, error) {
+	if up < 0 || up > len(fs.bases) {
+		return *new(T), gcers.NewErrInvalidParameter("up", gcint.NewErrOutOfBounds(up, 0, len(fs.bases)).WithUpperBound(true))
+	}
+
+	base := fs.base(up)
+	size := fs.end(up) - base
+
+	if i < 0 || i >= size {
+		return *new(T), gcers.NewErrInvalidParameter("i", gcint.NewErrOutOfBounds(i, 0, size))
+	}
+
+	return fs.stack.values[base+i], nil
+}
+
+// SetLocal is a method of the FrameStack type. It replaces a value of the current
+// frame by its position in it.
+//
+// Parameters:
+//   - i: The position of the value. 0 is the first value pushed in the frame.
+//   - value: The new value.
+//
+// Returns:
+//   - error: An error of type *errors.ErrInvalidParameter if i is out of bounds.
+func (fs *FrameStack[T]) SetLocal(i int, value T) error {
+	base := fs.base(0)
+	size := fs.end(0) - base
+
+	if i < 0 || i >= size {
+		return gcers.NewErrInvalidParameter("i", gcint.NewErrOutOfBounds(i, 0, size))
+	}
+
+	fs.stack.values[base+i] = value
+
+	return nil
+}
+
+// Frame is a method of the FrameStack type. It returns the values of the current
+// frame or of one of its enclosing frames.
+//
+// Parameters:
+//   - up: The number of frames to go up from the current one. 0 is the current
+//     frame and Depth() is the root frame.
+//
+// Returns:
+//   - []T: A copy of the values of the frame, from the bottom to the top.
+//   - error: An error of type *errors.ErrInvalidParameter if up is out of bounds.
+func (fs *FrameStack[T]) Frame(up int) ([]T, error) {
+	if up < 0 || up > len(fs.bases) {
+		return nil, gcers.NewErrInvalidParameter("up", gcint.NewErrOutOfBounds(up, 0, len(fs.bases)).WithUpperBound(true))
+	}
+
+	return slices.Clone(fs.stack.values[fs.base(up):fs.end(up)]), nil
+}
+
+// Push implements the Stacker interface.
+//
+// The value is added to the current frame. Always returns true.
+func (fs *FrameStack[T]) Push(value T) bool {
+	return fs.stack.Push(value)
+}
+
+// PushMany implements the Stacker interface.
+//
+// The values are added to the current frame.
+func (fs *FrameStack[T]) PushMany(values []T) int {
+	return fs.stack.PushMany(values)
+}
+
+// Pop implements the Stacker interface.
+//
+// Only the values of the current frame can be popped; it fails if the frame is
+// empty, even if an enclosing frame is not.
+func (fs *FrameStack[T]) Pop() (T, bool) {
+	if fs.FrameSize() == 0 {
+		return *new(T), false
+	}
+
+	return fs.stack.Pop()
+}
+
+// Peek implements the Stacker interface.
+//
+// Only the values of the current frame can be peeked.
+func (fs *FrameStack[T]) Peek() (T, bool) {
+	if fs.FrameSize() == 0 {
+		return *new(T), false
+	}
+
+	return fs.stack.Peek()
+}
+
+// IsEmpty implements the Stacker interface.
+//
+// It checks all the frames, not just the current one.
+func (fs *FrameStack[T]) IsEmpty() bool {
+	return fs.stack.IsEmpty()
+}
+
+// Size implements the Stacker interface.
+//
+// It counts the values of all the frames; see FrameSize for the current one.
+func (fs *FrameStack[T]) Size() int {
+	return fs.stack.Size()
+}
+
+// Iterator implements the Stacker interface.
+//
+// The values of all the frames are iterated from the top to the bottom.
+func (fs *FrameStack[T]) Iterator() itrs.Iterater[T] {
+	return fs.stack.Iterator()
+}
+
+// Clear implements the Stacker interface.
+//
+// All the frames are closed and their values removed.
+func (fs *FrameStack[T]) Clear() {
+	fs.stack.Clear()
+	fs.bases = nil
+}
+
+// layout returns the description of the stack used to format it.
+func (fs *FrameStack[T]) layout() display.Container[T] {
+	// The frames cannot be rebuilt by the constructor, so the Go syntax only
+	// rebuilds the values.
+	return display.Container[T]{
+		Pkg:      "stack",
+		Name:     "FrameStack",
+		TypeArgs: []string{display.TypeName[T]()},
+		Options:  fs.stack.policy.Options(),
+		Capacity: -1,
+		Marker:   display.Top,
+		Fields:   []display.Field{{Name: "frames", Value: fs.Depth()}},
+		Values:   fs.stack.values,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (fs *FrameStack[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, fs.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (fs *FrameStack[T]) String() string {
+	return fmt.Sprint(fs)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (fs *FrameStack[T]) GoString() string {
+	return fs.layout().GoSyntax()
+}
+
+// Slice implements the Stacker interface.
+//
+// It holds the values of all the frames; the last element is the top of the
+// stack.
+func (fs *FrameStack[T]) Slice() []T {
+	return fs.stack.Slice()
+}
+
+// Capacity implements the Stacker interface.
+//
+// Always returns -1.
+func (fs *FrameStack[T]) Capacity() int {
+	return -1
+}
+
+// IsFull implements the Stacker interface.
+//
+// Always returns false.
+func (fs *FrameStack[T]) IsFull() bool {
+	return false
+}
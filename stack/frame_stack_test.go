@@ -0,0 +1,175 @@
+package stack
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/PlayerR9/listlike/options"
+)
+
+// TestFrameStackFrames checks that the frames open and close in order, and that
+// Pop and Peek stay in the current frame.
+func TestFrameStackFrames(t *testing.T) {
+	fs, err := NewFrameStack[int](options.WithInitialValues[int](1, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := fs.PopFrame(); ok {
+		t.Fatal("closed the root frame")
+	}
+
+	fs.PushFrame()
+	fs.PushMany([]int{3, 4})
+	fs.PushFrame()
+
+	if fs.Depth() != 2 || fs.FrameSize() != 0 || fs.Size() != 4 {
+		t.Fatalf("got depth %d, frame size %d, size %d, want 2, 0, 4", fs.Depth(), fs.FrameSize(), fs.Size())
+	}
+
+	if value, ok := fs.Pop(); ok {
+		t.Fatalf("popped %d from an empty frame", value)
+	}
+
+	if value, ok := fs.Peek(); ok {
+		t.Fatalf("peeked %d in an empty frame", value)
+	}
+
+	fs.Push(5)
+
+	if value, ok := fs.Pop(); !ok || value != 5 {
+		t.Fatalf("got %d, %t, want 5", value, ok)
+	}
+
+	if _, ok := fs.Pop(); ok {
+		t.Fatal("Pop crossed into the enclosing frame")
+	}
+
+	frame, ok := fs.PopFrame()
+	if !ok || len(frame) != 0 {
+		t.Fatalf("got frame %v, %t, want an empty frame", frame, ok)
+	}
+
+	if value, ok := fs.Peek(); !ok || value != 4 {
+		t.Fatalf("peeked %d, %t, want 4", value, ok)
+	}
+
+	frame, ok = fs.PopFrame()
+	if !ok || !slices.Equal(frame, []int{3, 4}) {
+		t.Fatalf("got frame %v, %t, want [3 4]", frame, ok)
+	}
+
+	if fs.Depth() != 0 || !slices.Equal(fs.Slice(), []int{1, 2}) {
+		t.Fatalf("got depth %d and %v, want 0 and [1 2]", fs.Depth(), fs.Slice())
+	}
+
+	for _, want := range []int{2, 1} {
+		if value, ok := fs.Pop(); !ok || value != want {
+			t.Fatalf("got %d, %t, want %d", value, ok, want)
+		}
+	}
+
+	if _, ok := fs.Pop(); ok {
+		t.Fatal("popped from an empty root frame")
+	}
+}
+
+// TestFrameStackLocals checks the access to the values of the frames by their
+// position, and its bounds.
+func TestFrameStackLocals(t *testing.T) {
+	fs, err := NewFrameStack[string](options.WithInitialValues[string]("g"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fs.PushFrame()
+	fs.PushMany([]string{"a", "b"})
+	fs.PushFrame()
+	fs.Push("x")
+
+	tests := []struct {
+		up, i int
+		want  string
+	}{
+		{0, 0, "x"},
+		{1, 0, "a"},
+		{1, 1, "b"},
+		{2, 0, "g"},
+	}
+
+	for _, test := range tests {
+		got, err := fs.LocalAt(test.up, test.i)
+		if err != nil || got != test.want {
+			t.Fatalf("LocalAt(%d, %d): got %q, %v, want %q", test.up, test.i, got, err, test.want)
+		}
+	}
+
+	bad := [][2]int{{-1, 0}, {3, 0}, {0, -1}, {0, 1}, {1, 2}, {2, 1}}
+
+	for _, args := range bad {
+		if got, err := fs.LocalAt(args[0], args[1]); err == nil {
+			t.Fatalf("LocalAt(%d, %d): got %q, want an error", args[0], args[1], got)
+		}
+	}
+
+	if got, err := fs.Local(0); err != nil || got != "x" {
+		t.Fatalf("Local(0): got %q, %v, want \"x\"", got, err)
+	}
+
+	if _, err := fs.Local(1); err == nil {
+		t.Fatal("Local(1) read past the current frame")
+	}
+
+	if err := fs.SetLocal(0, "y"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := fs.SetLocal(1, "z"); err == nil {
+		t.Fatal("SetLocal(1) wrote past the current frame")
+	}
+
+	if frame, err := fs.Frame(1); err != nil || !slices.Equal(frame, []string{"a", "b"}) {
+		t.Fatalf("Frame(1): got %v, %v, want [a b]", frame, err)
+	}
+
+	if _, err := fs.Frame(3); err == nil {
+		t.Fatal("Frame(3) went past the root frame")
+	}
+
+	if got := fs.Slice(); !slices.Equal(got, []string{"g", "a", "b", "y"}) {
+		t.Fatalf("got %v, want [g a b y]", got)
+	}
+}
+
+// TestFrameStackClear checks that Clear closes all the frames.
+func TestFrameStackClear(t *testing.T) {
+	fs, err := NewFrameStack[int]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fs.Push(1)
+	fs.PushFrame()
+	fs.Push(2)
+	fs.PushFrame()
+
+	fs.Clear()
+
+	if !fs.IsEmpty() || fs.Depth() != 0 || fs.FrameSize() != 0 {
+		t.Fatalf("got size %d, depth %d after Clear", fs.Size(), fs.Depth())
+	}
+
+	if _, ok := fs.PopFrame(); ok {
+		t.Fatal("closed a frame after Clear")
+	}
+
+	fs.Push(3)
+
+	if value, ok := fs.Pop(); !ok || value != 3 {
+		t.Fatalf("got %d, %t, want 3", value, ok)
+	}
+
+	if _, err := NewFrameStack[int](options.WithHistory(1)); err == nil {
+		t.Fatal("got no error for an unsupported option")
+	}
+}
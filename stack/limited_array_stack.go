@@ -1,11 +1,13 @@
 package stack
 
 import (
+	"fmt"
 	"slices"
-	"strconv"
-	"strings"
 
-	gcstr "github.com/PlayerR9/go-commons/strings"
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/internal/growth"
+	"github.com/PlayerR9/listlike/options"
+
 	itrs "github.com/PlayerR9/iterators/simple"
 )
 
@@ -15,49 +17,108 @@ type LimitedArrayStack[T any] struct {
 	// values is a slice of type T that stores the elements in the stack.
 	values []T
 
-	// capacity is the maximum number of elements the stack can hold.
+	// capacity is the maximum number of elements the stack can hold. -1 means
+	// that the stack is unlimited.
 	capacity int
+
+	// overflow is what the stack does when a value is pushed while it is full.
+	overflow options.OverflowPolicy
+
+	// policy is the capacity policy of the values.
+	policy growth.Policy
 }
 
 // NewLimitedArrayStack is a function that creates and returns a new instance of a
 // LimitedArrayStack.
 //
 // Parameters:
-//
-//   - values: A variadic parameter of type T, which represents the initial values to be
-//     stored in the stack.
+//   - opts: The options of the stack. Supported options are WithCapacity, without
+//     which the stack is unlimited, WithInitialValues, which are pushed in order so
+//     that the last one is on top, WithOverflowPolicy, WithGrowthFactor,
+//     WithReserve and WithShrinkThreshold.
 //
 // Returns:
-//
 //   - *LimitedArrayStack[T]: A pointer to the newly created LimitedArrayStack.
-func NewLimitedArrayStack[T any](values ...T) *LimitedArrayStack[T] {
-	slices.Reverse(values)
+//   - error: An error if the options are invalid.
+func NewLimitedArrayStack[T any](opts ...options.Option) (*LimitedArrayStack[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Only("LimitedArrayStack", limited_array_stack_options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new_limited_array_stack[T](s)
+}
+
+// new_limited_array_stack creates a new LimitedArrayStack from validated settings.
+//
+// Parameters:
+//   - s: The settings. Assumed to be non-nil.
+//
+// Returns:
+//   - *LimitedArrayStack[T]: The new stack.
+//   - error: An error if the initial values are invalid.
+func new_limited_array_stack[T any](s *options.Settings) (*LimitedArrayStack[T], error) {
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := growth.New(s)
 
 	stack := &LimitedArrayStack[T]{
-		values: make([]T, len(values)),
+		values:   growth.Clone(policy, values),
+		capacity: s.Capacity,
+		overflow: s.Overflow,
+		policy:   policy,
 	}
-	copy(stack.values, values)
 
-	return stack
+	return stack, nil
 }
 
 // Push implements the Stacker interface.
 func (stack *LimitedArrayStack[T]) Push(value T) bool {
-	if len(stack.values) == stack.capacity {
-		return false
+	if stack.capacity != -1 && len(stack.values) >= stack.capacity {
+		if stack.overflow != options.DropOldest || stack.capacity == 0 {
+			return false
+		}
+
+		stack.values = slices.Delete(stack.values, 0, 1)
 	}
 
+	stack.values = growth.Grow(stack.policy, stack.values, 1)
 	stack.values = append(stack.values, value)
 
 	return true
 }
 
 // PushMany implements the Stacker interface.
+//
+// With the Reject policy, either all the values are pushed or none of them is.
 func (stack *LimitedArrayStack[T]) PushMany(values []T) int {
-	if len(stack.values)+len(values) > stack.capacity {
-		return 0
+	if stack.capacity != -1 && len(stack.values)+len(values) > stack.capacity {
+		if stack.overflow != options.DropOldest || stack.capacity == 0 {
+			return 0
+		}
+
+		n := len(values)
+
+		if len(values) > stack.capacity {
+			values = values[len(values)-stack.capacity:]
+		}
+
+		stack.values = slices.Delete(stack.values, 0, len(stack.values)+len(values)-stack.capacity)
+		stack.values = growth.Grow(stack.policy, stack.values, len(values))
+		stack.values = append(stack.values, values...)
+
+		return n
 	}
 
+	stack.values = growth.Grow(stack.policy, stack.values, len(values))
 	stack.values = append(stack.values, values...)
 
 	return len(values)
@@ -71,6 +132,7 @@ func (stack *LimitedArrayStack[T]) Pop() (T, bool) {
 
 	toRemove := stack.values[len(stack.values)-1]
 	stack.values = stack.values[:len(stack.values)-1]
+	stack.values = growth.Shrink(stack.policy, stack.values)
 
 	return toRemove, true
 }
@@ -136,7 +198,7 @@ func (stack *LimitedArrayStack[T]) Iterator() itrs.Iterater[T] {
 // Clear is a method of the LimitedArrayStack type. It is used to remove all elements from the
 // stack, making it empty.
 func (stack *LimitedArrayStack[T]) Clear() {
-	stack.values = make([]T, 0, stack.capacity)
+	stack.values = make([]T, 0, stack.policy.Reserve)
 }
 
 // IsFull is a method of the LimitedArrayStack type. It is used to check if the stack is full,
@@ -146,27 +208,45 @@ func (stack *LimitedArrayStack[T]) Clear() {
 //
 //   - isFull: A boolean value that is true if the stack is full, and false otherwise.
 func (stack *LimitedArrayStack[T]) IsFull() (isFull bool) {
-	return len(stack.values) == stack.capacity
+	return stack.capacity != -1 && len(stack.values) >= stack.capacity
 }
 
-// GoString implements the fmt.GoStringer interface.
-func (stack *LimitedArrayStack[T]) GoString() string {
-	values := make([]string, 0, len(stack.values))
-	for _, value := range stack.values {
-		values = append(values, gcstr.GoStringOf(value))
+// layout returns the description of the stack used to format it.
+func (stack *LimitedArrayStack[T]) layout() display.Container[T] {
+	var opts []string
+
+	if stack.capacity != -1 {
+		opts = append(opts, display.Option("WithCapacity", stack.capacity))
 	}
 
-	var builder strings.Builder
+	if stack.overflow != options.Reject {
+		opts = append(opts, display.Option("WithOverflowPolicy", stack.overflow))
+	}
+
+	return display.Container[T]{
+		Pkg:      "stack",
+		Name:     "LimitedArrayStack",
+		TypeArgs: []string{display.TypeName[T]()},
+		Options:  append(opts, stack.policy.Options()...),
+		Capacity: stack.capacity,
+		Marker:   display.Top,
+		Values:   stack.values,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (stack *LimitedArrayStack[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, stack.layout())
+}
 
-	builder.WriteString("LimitedArrayStack[capacity=")
-	builder.WriteString(strconv.Itoa(stack.capacity))
-	builder.WriteString(", size=")
-	builder.WriteString(strconv.Itoa(len(stack.values)))
-	builder.WriteString(", values=[")
-	builder.WriteString(strings.Join(values, ", "))
-	builder.WriteString(" →]]")
+// String implements the fmt.Stringer interface.
+func (stack *LimitedArrayStack[T]) String() string {
+	return fmt.Sprint(stack)
+}
 
-	return builder.String()
+// GoString implements the fmt.GoStringer interface.
+func (stack *LimitedArrayStack[T]) GoString() string {
+	return stack.layout().GoSyntax()
 }
 
 // Slice is a method of the LimitedArrayStack type. It is used to return a slice of the
@@ -189,10 +269,27 @@ func (stack *LimitedArrayStack[T]) Slice() []T {
 //   - *LimitedArrayStack[T]: A copy of the stack.
 func (stack *LimitedArrayStack[T]) Copy() *LimitedArrayStack[T] {
 	stackCopy := &LimitedArrayStack[T]{
-		values:   make([]T, len(stack.values)),
+		values:   growth.Clone(stack.policy, stack.values),
 		capacity: stack.capacity,
+		overflow: stack.overflow,
+		policy:   stack.policy,
 	}
-	copy(stackCopy.values, stack.values)
 
 	return stackCopy
 }
+
+// Reserve is a method of the LimitedArrayStack type. It makes room for at least n
+// more elements so that adding them does not reallocate the backing array. The room
+// is capped at the capacity of the stack.
+//
+// Parameters:
+//   - n: The number of elements to make room for. Non-positive values are a no-op.
+func (stack *LimitedArrayStack[T]) Reserve(n int) {
+	stack.values = growth.Reserve(stack.policy, stack.values, n)
+}
+
+// ShrinkToFit is a method of the LimitedArrayStack type. It releases the unused
+// part of the backing array, except for the reserve the stack was created with.
+func (stack *LimitedArrayStack[T]) ShrinkToFit() {
+	stack.values = growth.Fit(stack.policy, stack.values)
+}
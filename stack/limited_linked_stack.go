@@ -1,10 +1,11 @@
 package stack
 
 import (
-	"strconv"
-	"strings"
+	"fmt"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
 
-	gcstr "github.com/PlayerR9/go-commons/strings"
 	itrs "github.com/PlayerR9/iterators/simple"
 )
 
@@ -17,52 +18,79 @@ type LimitedLinkedStack[T any] struct {
 	// size is the current number of elements in the stack.
 	size int
 
-	// capacity is the maximum number of elements the stack can hold.
+	// capacity is the maximum number of elements the stack can hold. -1 means
+	// that the stack is unlimited.
 	capacity int
+
+	// overflow is what the stack does when a value is pushed while it is full.
+	overflow options.OverflowPolicy
+
+	// pool is the pool of free nodes. Nil if pooling is disabled.
+	pool *node_pool[T]
 }
 
 // NewLimitedLinkedStack is a function that creates and returns a new instance of a
 // LimitedLinkedStack.
 //
 // Parameters:
-//
-//   - values: A variadic parameter of type T, which represents the initial values to be
-//     stored in the stack.
+//   - opts: The options of the stack. Supported options are WithCapacity, without
+//     which the stack is unlimited, WithInitialValues, which are pushed in order so
+//     that the last one is on top, WithOverflowPolicy and WithNodePool.
 //
 // Returns:
-//
 //   - *LimitedLinkedStack[T]: A pointer to the newly created LimitedLinkedStack.
-func NewLimitedLinkedStack[T any](values ...T) *LimitedLinkedStack[T] {
-	stack := new(LimitedLinkedStack[T])
-	stack.size = len(values)
-
-	if len(values) == 0 {
-		return stack
+//   - error: An error if the options are invalid.
+func NewLimitedLinkedStack[T any](opts ...options.Option) (*LimitedLinkedStack[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
 	}
 
-	// First node
-	node := NewStackNode(values[0])
+	err = s.Only("LimitedLinkedStack", limited_linked_stack_options...)
+	if err != nil {
+		return nil, err
+	}
 
-	stack.front = node
+	return new_limited_linked_stack[T](s)
+}
 
-	// Subsequent nodes
-	for _, element := range values[1:] {
-		node = NewStackNode(element)
-		node.SetNext(stack.front)
+// new_limited_linked_stack creates a new LimitedLinkedStack from validated
+// settings.
+//
+// Parameters:
+//   - s: The settings. Assumed to be non-nil.
+//
+// Returns:
+//   - *LimitedLinkedStack[T]: The new stack.
+//   - error: An error if the initial values are invalid.
+func new_limited_linked_stack[T any](s *options.Settings) (*LimitedLinkedStack[T], error) {
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
+	}
 
-		stack.front = node
+	stack := &LimitedLinkedStack[T]{
+		capacity: s.Capacity,
+		overflow: s.Overflow,
+		pool:     new_node_pool[T](s.NodePool),
 	}
 
-	return stack
+	stack.PushMany(values)
+
+	return stack, nil
 }
 
 // Push implements the Stacker interface.
 func (stack *LimitedLinkedStack[T]) Push(value T) bool {
-	if stack.size >= stack.capacity {
-		return false
+	if stack.capacity != -1 && stack.size >= stack.capacity {
+		if stack.overflow != options.DropOldest || stack.capacity == 0 {
+			return false
+		}
+
+		stack.dropBottom()
 	}
 
-	node := NewStackNode(value)
+	node := stack.pool.get(value)
 
 	if stack.front != nil {
 		node.SetNext(stack.front)
@@ -75,16 +103,27 @@ func (stack *LimitedLinkedStack[T]) Push(value T) bool {
 }
 
 // PushMany implements the Stacker interface.
+//
+// With the Reject policy, either all the values are pushed or none of them is.
 func (stack *LimitedLinkedStack[T]) PushMany(values []T) int {
-	if stack.size+len(values) > stack.capacity {
-		return 0
+	if stack.capacity != -1 && stack.size+len(values) > stack.capacity {
+		if stack.overflow != options.DropOldest || stack.capacity == 0 {
+			return 0
+		}
+	}
+
+	n := len(values)
+
+	if stack.capacity != -1 && len(values) >= stack.capacity {
+		values = values[len(values)-stack.capacity:]
+		stack.Clear()
 	}
 
 	for _, value := range values {
 		stack.Push(value)
 	}
 
-	return len(values)
+	return n
 }
 
 // Pop implements the Stacker interface.
@@ -97,9 +136,13 @@ func (stack *LimitedLinkedStack[T]) Pop() (T, bool) {
 	stack.front = stack.front.Next()
 
 	stack.size--
+
+	value := toRemove.Value
 	toRemove.SetNext(nil)
 
-	return toRemove.Value, true
+	stack.pool.put(toRemove)
+
+	return value, true
 }
 
 // Peek implements the Stacker interface.
@@ -164,18 +207,17 @@ func (stack *LimitedLinkedStack[T]) Clear() {
 		return // Stack is already empty
 	}
 
-	// 1. First node
-	prev := stack.front
+	// 1. Unlink the nodes
+	for node := stack.front; node != nil; {
+		next := node.Next()
 
-	// 2. Subsequent nodes
-	for node := stack.front.Next(); node != nil; node = node.Next() {
-		prev = node
-		prev.SetNext(nil)
-	}
+		node.SetNext(nil)
+		stack.pool.put(node)
 
-	prev.SetNext(nil)
+		node = next
+	}
 
-	// 3. Reset list fields
+	// 2. Reset list fields
 	stack.front = nil
 	stack.size = 0
 }
@@ -187,27 +229,57 @@ func (stack *LimitedLinkedStack[T]) Clear() {
 //
 //   - isFull: true if the stack is full, and false otherwise.
 func (stack *LimitedLinkedStack[T]) IsFull() bool {
-	return stack.size >= stack.capacity
+	return stack.capacity != -1 && stack.size >= stack.capacity
 }
 
-// GoString implements the fmt.GoStringer interface.
-func (stack *LimitedLinkedStack[T]) GoString() string {
-	values := make([]string, 0, stack.size)
-	for stack_node := stack.front; stack_node != nil; stack_node = stack_node.Next() {
-		values = append(values, gcstr.GoStringOf(stack_node.Value))
+// layout returns the description of the stack used to format it.
+func (stack *LimitedLinkedStack[T]) layout() display.Container[T] {
+	var opts []string
+
+	if stack.capacity != -1 {
+		opts = append(opts, display.Option("WithCapacity", stack.capacity))
+	}
+
+	if stack.overflow != options.Reject {
+		opts = append(opts, display.Option("WithOverflowPolicy", stack.overflow))
+	}
+
+	if stack.pool != nil {
+		opts = append(opts, display.Option("WithNodePool", stack.pool.limit))
 	}
 
-	var builder strings.Builder
+	values := make([]T, stack.size)
 
-	builder.WriteString("LimitedLinkedStack[capacity=")
-	builder.WriteString(strconv.Itoa(stack.capacity))
-	builder.WriteString(", size=")
-	builder.WriteString(strconv.Itoa(stack.size))
-	builder.WriteString(", values=[")
-	builder.WriteString(strings.Join(values, ", "))
-	builder.WriteString(" →]]")
+	i := stack.size
+	for node := stack.front; node != nil; node = node.Next() {
+		i--
+		values[i] = node.Value
+	}
 
-	return builder.String()
+	return display.Container[T]{
+		Pkg:      "stack",
+		Name:     "LimitedLinkedStack",
+		TypeArgs: []string{display.TypeName[T]()},
+		Options:  opts,
+		Capacity: stack.capacity,
+		Marker:   display.Top,
+		Values:   values,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (stack *LimitedLinkedStack[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, stack.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (stack *LimitedLinkedStack[T]) String() string {
+	return fmt.Sprint(stack)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (stack *LimitedLinkedStack[T]) GoString() string {
+	return stack.layout().GoSyntax()
 }
 
 // Slice is a method of the LimitedLinkedStack type. It is used to return a slice of the
@@ -235,6 +307,8 @@ func (stack *LimitedLinkedStack[T]) Copy() *LimitedLinkedStack[T] {
 	stackCopy := &LimitedLinkedStack[T]{
 		size:     stack.size,
 		capacity: stack.capacity,
+		overflow: stack.overflow,
+		pool:     stack.pool.copy(),
 	}
 
 	if stack.front == nil {
@@ -258,3 +332,26 @@ func (stack *LimitedLinkedStack[T]) Copy() *LimitedLinkedStack[T] {
 
 	return stackCopy
 }
+
+// dropBottom removes the bottom element of the stack. This takes linear time.
+// The stack is assumed to be non-empty.
+func (stack *LimitedLinkedStack[T]) dropBottom() {
+	var prev *StackNode[T]
+
+	node := stack.front
+
+	for node.Next() != nil {
+		prev = node
+		node = node.Next()
+	}
+
+	if prev == nil {
+		stack.front = nil
+	} else {
+		prev.SetNext(nil)
+	}
+
+	stack.size--
+
+	stack.pool.put(node)
+}
@@ -2,9 +2,10 @@
 package stack
 
 import (
+	"fmt"
 	"github.com/PlayerR9/iterators/simple"
-	"strconv"
-	"strings"
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
 )
 
 // stack_node_complex128 is a node in the linked stack.
@@ -18,25 +19,88 @@ type stack_node_complex128 struct {
 type Complex128Stack struct {
 	front *stack_node_complex128
 	size int
+
+	// free is the first node of the pool of free nodes.
+	free *stack_node_complex128
+
+	// free_size is the number of free nodes.
+	free_size int
+
+	// free_limit is the maximum number of free nodes. 0 disables pooling.
+	free_limit int
 }
 
 // NewComplex128Stack creates a new linked stack.
 //
+// Parameters:
+//   - opts: The options of the stack. Supported options are WithInitialValues, which
+//     are pushed in order so that the last one is on top, and WithNodePool.
+//
 // Returns:
-//   - *Complex128Stack: A pointer to the newly created stack. Never returns nil.
-func NewComplex128Stack() *Complex128Stack {
-	return &Complex128Stack{
-		size: 0,
+//   - *Complex128Stack: A pointer to the newly created stack.
+//   - error: An error if the options are invalid.
+func NewComplex128Stack(opts ...options.Option) (*Complex128Stack, error) {
+	settings, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = settings.Only("Complex128Stack", "WithInitialValues", "WithNodePool")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[complex128](settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Complex128Stack{
+		free_limit: settings.NodePool,
+	}
+
+	s.PushMany(values)
+
+	return s, nil
+}
+
+// getNode returns a node holding the value, reusing a free node if there is one.
+func (s *Complex128Stack) getNode(value complex128) *stack_node_complex128 {
+	if s.free == nil {
+		return &stack_node_complex128{
+			value: value,
+		}
+	}
+
+	node := s.free
+
+	s.free = node.next
+	s.free_size--
+
+	node.value = value
+	node.next = nil
+
+	return node
+}
+
+// putNode zeroes the node and keeps it for reuse unless the pool is full.
+func (s *Complex128Stack) putNode(node *stack_node_complex128) {
+	if s.free_size >= s.free_limit {
+		return
 	}
+
+	node.value = 0
+	node.next = s.free
+
+	s.free = node
+	s.free_size++
 }
 
 // Push implements the stack.Stacker interface.
 //
 // Always returns true.
 func (s *Complex128Stack) Push(value complex128) bool {
-	node := &stack_node_complex128{
-		value: value,
-	}
+	node := s.getNode(value)
 
 	if s.front != nil {
 		node.next = s.front
@@ -56,21 +120,9 @@ func (s *Complex128Stack) PushMany(values []complex128) int {
 		return 0
 	}
 
-	node := &stack_node_complex128{
-		value: values[0],
-	}
-
-	if s.front != nil {
+	for _, value := range values {
+		node := s.getNode(value)
 		node.next = s.front
-	}
-
-	s.front = node
-
-	for i := 1; i < len(values); i++ {
-		node := &stack_node_complex128{
-			value: values[i],
-			next:  s.front,
-		}
 
 		s.front = node
 	}
@@ -90,9 +142,13 @@ func (s *Complex128Stack) Pop() (complex128, bool) {
 	s.front = s.front.next
 
 	s.size--
+
+	value := to_remove.value
 	to_remove.next = nil
 
-	return to_remove.value, true
+	s.putNode(to_remove)
+
+	return value, true
 }
 
 // Peek implements the stack.Stacker interface.
@@ -131,35 +187,58 @@ func (s *Complex128Stack) Clear() {
 		return
 	}
 
-	prev := s.front
+	for node := s.front; node != nil; {
+		next := node.next
 
-	for node := s.front.next; node != nil; node = node.next {
-		prev = node
-		prev.next = nil
-	}
+		node.next = nil
+		s.putNode(node)
 
-	prev.next = nil
+		node = next
+	}
 
 	s.front = nil
 	s.size = 0
 }
 
-// GoString implements the stack.Stacker interface.
-func (s *Complex128Stack) GoString() string {
-	values := make([]string, 0, s.size)
+// layout returns the description of the stack used to format it.
+func (s *Complex128Stack) layout() display.Container[complex128] {
+	var opts []string
+
+	if s.free_limit > 0 {
+		opts = append(opts, display.Option("WithNodePool", s.free_limit))
+	}
+
+	values := make([]complex128, s.size)
+
+	i := s.size
 	for node := s.front; node != nil; node = node.next {
-		values = append(values, strconv.FormatComplex(node.value, 'f', -1, 128))
+		i--
+		values[i] = node.value
 	}
 
-	var builder strings.Builder
+	return display.Container[complex128]{
+		Pkg:      "stack",
+		Name:     "Complex128Stack",
+		Options:  opts,
+		Capacity: -1,
+		Marker:   display.Top,
+		Values:   values,
+	}
+}
 
-	builder.WriteString("Complex128Stack[size=")
-	builder.WriteString(strconv.Itoa(s.size))
-	builder.WriteString(", values=[")
-	builder.WriteString(strings.Join(values, ", "))
-	builder.WriteString(" →]]")
+// Format implements the fmt.Formatter interface.
+func (s *Complex128Stack) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, s.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (s *Complex128Stack) String() string {
+	return fmt.Sprint(s)
+}
 
-	return builder.String()
+// GoString implements the stack.Stacker interface.
+func (s *Complex128Stack) GoString() string {
+	return s.layout().GoSyntax()
 }
 
 // Slice implements the stack.Stacker interface.
@@ -195,11 +274,14 @@ func (s *Complex128Stack) IsFull() bool {
 //   - *Complex128Stack: A pointer to the newly created stack. Never returns nil.
 func (s *Complex128Stack) Copy() *Complex128Stack {
 	if s.front == nil {
-		return &Complex128Stack{}
+		return &Complex128Stack{
+			free_limit: s.free_limit,
+		}
 	}
 
 	s_copy := &Complex128Stack{
 		size: s.size,
+		free_limit: s.free_limit,
 	}
 
 	node_copy := &stack_node_complex128{
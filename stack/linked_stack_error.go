@@ -2,9 +2,10 @@
 package stack
 
 import (
+	"fmt"
 	"github.com/PlayerR9/iterators/simple"
-	"strconv"
-	"strings"
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
 )
 
 // stack_node_error is a node in the linked stack.
@@ -18,25 +19,88 @@ type stack_node_error struct {
 type ErrorStack struct {
 	front *stack_node_error
 	size int
+
+	// free is the first node of the pool of free nodes.
+	free *stack_node_error
+
+	// free_size is the number of free nodes.
+	free_size int
+
+	// free_limit is the maximum number of free nodes. 0 disables pooling.
+	free_limit int
 }
 
 // NewErrorStack creates a new linked stack.
 //
+// Parameters:
+//   - opts: The options of the stack. Supported options are WithInitialValues, which
+//     are pushed in order so that the last one is on top, and WithNodePool.
+//
 // Returns:
-//   - *ErrorStack: A pointer to the newly created stack. Never returns nil.
-func NewErrorStack() *ErrorStack {
-	return &ErrorStack{
-		size: 0,
+//   - *ErrorStack: A pointer to the newly created stack.
+//   - error: An error if the options are invalid.
+func NewErrorStack(opts ...options.Option) (*ErrorStack, error) {
+	settings, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = settings.Only("ErrorStack", "WithInitialValues", "WithNodePool")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[error](settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &ErrorStack{
+		free_limit: settings.NodePool,
+	}
+
+	s.PushMany(values)
+
+	return s, nil
+}
+
+// getNode returns a node holding the value, reusing a free node if there is one.
+func (s *ErrorStack) getNode(value error) *stack_node_error {
+	if s.free == nil {
+		return &stack_node_error{
+			value: value,
+		}
+	}
+
+	node := s.free
+
+	s.free = node.next
+	s.free_size--
+
+	node.value = value
+	node.next = nil
+
+	return node
+}
+
+// putNode zeroes the node and keeps it for reuse unless the pool is full.
+func (s *ErrorStack) putNode(node *stack_node_error) {
+	if s.free_size >= s.free_limit {
+		return
 	}
+
+	node.value = nil
+	node.next = s.free
+
+	s.free = node
+	s.free_size++
 }
 
 // Push implements the stack.Stacker interface.
 //
 // Always returns true.
 func (s *ErrorStack) Push(value error) bool {
-	node := &stack_node_error{
-		value: value,
-	}
+	node := s.getNode(value)
 
 	if s.front != nil {
 		node.next = s.front
@@ -56,21 +120,9 @@ func (s *ErrorStack) PushMany(values []error) int {
 		return 0
 	}
 
-	node := &stack_node_error{
-		value: values[0],
-	}
-
-	if s.front != nil {
+	for _, value := range values {
+		node := s.getNode(value)
 		node.next = s.front
-	}
-
-	s.front = node
-
-	for i := 1; i < len(values); i++ {
-		node := &stack_node_error{
-			value: values[i],
-			next:  s.front,
-		}
 
 		s.front = node
 	}
@@ -90,9 +142,13 @@ func (s *ErrorStack) Pop() (error, bool) {
 	s.front = s.front.next
 
 	s.size--
+
+	value := to_remove.value
 	to_remove.next = nil
 
-	return to_remove.value, true
+	s.putNode(to_remove)
+
+	return value, true
 }
 
 // Peek implements the stack.Stacker interface.
@@ -131,35 +187,58 @@ func (s *ErrorStack) Clear() {
 		return
 	}
 
-	prev := s.front
+	for node := s.front; node != nil; {
+		next := node.next
 
-	for node := s.front.next; node != nil; node = node.next {
-		prev = node
-		prev.next = nil
-	}
+		node.next = nil
+		s.putNode(node)
 
-	prev.next = nil
+		node = next
+	}
 
 	s.front = nil
 	s.size = 0
 }
 
-// GoString implements the stack.Stacker interface.
-func (s *ErrorStack) GoString() string {
-	values := make([]string, 0, s.size)
+// layout returns the description of the stack used to format it.
+func (s *ErrorStack) layout() display.Container[error] {
+	var opts []string
+
+	if s.free_limit > 0 {
+		opts = append(opts, display.Option("WithNodePool", s.free_limit))
+	}
+
+	values := make([]error, s.size)
+
+	i := s.size
 	for node := s.front; node != nil; node = node.next {
-		values = append(values, node.value.Error())
+		i--
+		values[i] = node.value
 	}
 
-	var builder strings.Builder
+	return display.Container[error]{
+		Pkg:      "stack",
+		Name:     "ErrorStack",
+		Options:  opts,
+		Capacity: -1,
+		Marker:   display.Top,
+		Values:   values,
+	}
+}
 
-	builder.WriteString("ErrorStack[size=")
-	builder.WriteString(strconv.Itoa(s.size))
-	builder.WriteString(", values=[")
-	builder.WriteString(strings.Join(values, ", "))
-	builder.WriteString(" →]]")
+// Format implements the fmt.Formatter interface.
+func (s *ErrorStack) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, s.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (s *ErrorStack) String() string {
+	return fmt.Sprint(s)
+}
 
-	return builder.String()
+// GoString implements the stack.Stacker interface.
+func (s *ErrorStack) GoString() string {
+	return s.layout().GoSyntax()
 }
 
 // Slice implements the stack.Stacker interface.
@@ -195,11 +274,14 @@ func (s *ErrorStack) IsFull() bool {
 //   - *ErrorStack: A pointer to the newly created stack. Never returns nil.
 func (s *ErrorStack) Copy() *ErrorStack {
 	if s.front == nil {
-		return &ErrorStack{}
+		return &ErrorStack{
+			free_limit: s.free_limit,
+		}
 	}
 
 	s_copy := &ErrorStack{
 		size: s.size,
+		free_limit: s.free_limit,
 	}
 
 	node_copy := &stack_node_error{
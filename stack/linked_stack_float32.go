@@ -2,9 +2,10 @@
 package stack
 
 import (
+	"fmt"
 	"github.com/PlayerR9/iterators/simple"
-	"strconv"
-	"strings"
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
 )
 
 // stack_node_float32 is a node in the linked stack.
@@ -18,25 +19,88 @@ type stack_node_float32 struct {
 type Float32Stack struct {
 	front *stack_node_float32
 	size int
+
+	// free is the first node of the pool of free nodes.
+	free *stack_node_float32
+
+	// free_size is the number of free nodes.
+	free_size int
+
+	// free_limit is the maximum number of free nodes. 0 disables pooling.
+	free_limit int
 }
 
 // NewFloat32Stack creates a new linked stack.
 //
+// Parameters:
+//   - opts: The options of the stack. Supported options are WithInitialValues, which
+//     are pushed in order so that the last one is on top, and WithNodePool.
+//
 // Returns:
-//   - *Float32Stack: A pointer to the newly created stack. Never returns nil.
-func NewFloat32Stack() *Float32Stack {
-	return &Float32Stack{
-		size: 0,
+//   - *Float32Stack: A pointer to the newly created stack.
+//   - error: An error if the options are invalid.
+func NewFloat32Stack(opts ...options.Option) (*Float32Stack, error) {
+	settings, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = settings.Only("Float32Stack", "WithInitialValues", "WithNodePool")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[float32](settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Float32Stack{
+		free_limit: settings.NodePool,
+	}
+
+	s.PushMany(values)
+
+	return s, nil
+}
+
+// getNode returns a node holding the value, reusing a free node if there is one.
+func (s *Float32Stack) getNode(value float32) *stack_node_float32 {
+	if s.free == nil {
+		return &stack_node_float32{
+			value: value,
+		}
+	}
+
+	node := s.free
+
+	s.free = node.next
+	s.free_size--
+
+	node.value = value
+	node.next = nil
+
+	return node
+}
+
+// putNode zeroes the node and keeps it for reuse unless the pool is full.
+func (s *Float32Stack) putNode(node *stack_node_float32) {
+	if s.free_size >= s.free_limit {
+		return
 	}
+
+	node.value = 0.0
+	node.next = s.free
+
+	s.free = node
+	s.free_size++
 }
 
 // Push implements the stack.Stacker interface.
 //
 // Always returns true.
 func (s *Float32Stack) Push(value float32) bool {
-	node := &stack_node_float32{
-		value: value,
-	}
+	node := s.getNode(value)
 
 	if s.front != nil {
 		node.next = s.front
@@ -56,21 +120,9 @@ func (s *Float32Stack) PushMany(values []float32) int {
 		return 0
 	}
 
-	node := &stack_node_float32{
-		value: values[0],
-	}
-
-	if s.front != nil {
+	for _, value := range values {
+		node := s.getNode(value)
 		node.next = s.front
-	}
-
-	s.front = node
-
-	for i := 1; i < len(values); i++ {
-		node := &stack_node_float32{
-			value: values[i],
-			next:  s.front,
-		}
 
 		s.front = node
 	}
@@ -90,9 +142,13 @@ func (s *Float32Stack) Pop() (float32, bool) {
 	s.front = s.front.next
 
 	s.size--
+
+	value := to_remove.value
 	to_remove.next = nil
 
-	return to_remove.value, true
+	s.putNode(to_remove)
+
+	return value, true
 }
 
 // Peek implements the stack.Stacker interface.
@@ -131,35 +187,58 @@ func (s *Float32Stack) Clear() {
 		return
 	}
 
-	prev := s.front
+	for node := s.front; node != nil; {
+		next := node.next
 
-	for node := s.front.next; node != nil; node = node.next {
-		prev = node
-		prev.next = nil
-	}
+		node.next = nil
+		s.putNode(node)
 
-	prev.next = nil
+		node = next
+	}
 
 	s.front = nil
 	s.size = 0
 }
 
-// GoString implements the stack.Stacker interface.
-func (s *Float32Stack) GoString() string {
-	values := make([]string, 0, s.size)
+// layout returns the description of the stack used to format it.
+func (s *Float32Stack) layout() display.Container[float32] {
+	var opts []string
+
+	if s.free_limit > 0 {
+		opts = append(opts, display.Option("WithNodePool", s.free_limit))
+	}
+
+	values := make([]float32, s.size)
+
+	i := s.size
 	for node := s.front; node != nil; node = node.next {
-		values = append(values, strconv.FormatFloat(float64(node.value), 'f', -1, 32))
+		i--
+		values[i] = node.value
 	}
 
-	var builder strings.Builder
+	return display.Container[float32]{
+		Pkg:      "stack",
+		Name:     "Float32Stack",
+		Options:  opts,
+		Capacity: -1,
+		Marker:   display.Top,
+		Values:   values,
+	}
+}
 
-	builder.WriteString("Float32Stack[size=")
-	builder.WriteString(strconv.Itoa(s.size))
-	builder.WriteString(", values=[")
-	builder.WriteString(strings.Join(values, ", "))
-	builder.WriteString(" →]]")
+// Format implements the fmt.Formatter interface.
+func (s *Float32Stack) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, s.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (s *Float32Stack) String() string {
+	return fmt.Sprint(s)
+}
 
-	return builder.String()
+// GoString implements the stack.Stacker interface.
+func (s *Float32Stack) GoString() string {
+	return s.layout().GoSyntax()
 }
 
 // Slice implements the stack.Stacker interface.
@@ -195,11 +274,14 @@ func (s *Float32Stack) IsFull() bool {
 //   - *Float32Stack: A pointer to the newly created stack. Never returns nil.
 func (s *Float32Stack) Copy() *Float32Stack {
 	if s.front == nil {
-		return &Float32Stack{}
+		return &Float32Stack{
+			free_limit: s.free_limit,
+		}
 	}
 
 	s_copy := &Float32Stack{
 		size: s.size,
+		free_limit: s.free_limit,
 	}
 
 	node_copy := &stack_node_float32{
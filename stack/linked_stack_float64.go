@@ -2,9 +2,10 @@
 package stack
 
 import (
+	"fmt"
 	"github.com/PlayerR9/iterators/simple"
-	"strconv"
-	"strings"
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
 )
 
 // stack_node_float64 is a node in the linked stack.
@@ -18,25 +19,88 @@ type stack_node_float64 struct {
 type Float64Stack struct {
 	front *stack_node_float64
 	size int
+
+	// free is the first node of the pool of free nodes.
+	free *stack_node_float64
+
+	// free_size is the number of free nodes.
+	free_size int
+
+	// free_limit is the maximum number of free nodes. 0 disables pooling.
+	free_limit int
 }
 
 // NewFloat64Stack creates a new linked stack.
 //
+// Parameters:
+//   - opts: The options of the stack. Supported options are WithInitialValues, which
+//     are pushed in order so that the last one is on top, and WithNodePool.
+//
 // Returns:
-//   - *Float64Stack: A pointer to the newly created stack. Never returns nil.
-func NewFloat64Stack() *Float64Stack {
-	return &Float64Stack{
-		size: 0,
+//   - *Float64Stack: A pointer to the newly created stack.
+//   - error: An error if the options are invalid.
+func NewFloat64Stack(opts ...options.Option) (*Float64Stack, error) {
+	settings, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = settings.Only("Float64Stack", "WithInitialValues", "WithNodePool")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[float64](settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Float64Stack{
+		free_limit: settings.NodePool,
+	}
+
+	s.PushMany(values)
+
+	return s, nil
+}
+
+// getNode returns a node holding the value, reusing a free node if there is one.
+func (s *Float64Stack) getNode(value float64) *stack_node_float64 {
+	if s.free == nil {
+		return &stack_node_float64{
+			value: value,
+		}
+	}
+
+	node := s.free
+
+	s.free = node.next
+	s.free_size--
+
+	node.value = value
+	node.next = nil
+
+	return node
+}
+
+// putNode zeroes the node and keeps it for reuse unless the pool is full.
+func (s *Float64Stack) putNode(node *stack_node_float64) {
+	if s.free_size >= s.free_limit {
+		return
 	}
+
+	node.value = 0.0
+	node.next = s.free
+
+	s.free = node
+	s.free_size++
 }
 
 // Push implements the stack.Stacker interface.
 //
 // Always returns true.
 func (s *Float64Stack) Push(value float64) bool {
-	node := &stack_node_float64{
-		value: value,
-	}
+	node := s.getNode(value)
 
 	if s.front != nil {
 		node.next = s.front
@@ -56,21 +120,9 @@ func (s *Float64Stack) PushMany(values []float64) int {
 		return 0
 	}
 
-	node := &stack_node_float64{
-		value: values[0],
-	}
-
-	if s.front != nil {
+	for _, value := range values {
+		node := s.getNode(value)
 		node.next = s.front
-	}
-
-	s.front = node
-
-	for i := 1; i < len(values); i++ {
-		node := &stack_node_float64{
-			value: values[i],
-			next:  s.front,
-		}
 
 		s.front = node
 	}
@@ -90,9 +142,13 @@ func (s *Float64Stack) Pop() (float64, bool) {
 	s.front = s.front.next
 
 	s.size--
+
+	value := to_remove.value
 	to_remove.next = nil
 
-	return to_remove.value, true
+	s.putNode(to_remove)
+
+	return value, true
 }
 
 // Peek implements the stack.Stacker interface.
@@ -131,35 +187,58 @@ func (s *Float64Stack) Clear() {
 		return
 	}
 
-	prev := s.front
+	for node := s.front; node != nil; {
+		next := node.next
 
-	for node := s.front.next; node != nil; node = node.next {
-		prev = node
-		prev.next = nil
-	}
+		node.next = nil
+		s.putNode(node)
 
-	prev.next = nil
+		node = next
+	}
 
 	s.front = nil
 	s.size = 0
 }
 
-// GoString implements the stack.Stacker interface.
-func (s *Float64Stack) GoString() string {
-	values := make([]string, 0, s.size)
+// layout returns the description of the stack used to format it.
+func (s *Float64Stack) layout() display.Container[float64] {
+	var opts []string
+
+	if s.free_limit > 0 {
+		opts = append(opts, display.Option("WithNodePool", s.free_limit))
+	}
+
+	values := make([]float64, s.size)
+
+	i := s.size
 	for node := s.front; node != nil; node = node.next {
-		values = append(values, strconv.FormatFloat(node.value, 'f', -1, 64))
+		i--
+		values[i] = node.value
 	}
 
-	var builder strings.Builder
+	return display.Container[float64]{
+		Pkg:      "stack",
+		Name:     "Float64Stack",
+		Options:  opts,
+		Capacity: -1,
+		Marker:   display.Top,
+		Values:   values,
+	}
+}
 
-	builder.WriteString("Float64Stack[size=")
-	builder.WriteString(strconv.Itoa(s.size))
-	builder.WriteString(", values=[")
-	builder.WriteString(strings.Join(values, ", "))
-	builder.WriteString(" →]]")
+// Format implements the fmt.Formatter interface.
+func (s *Float64Stack) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, s.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (s *Float64Stack) String() string {
+	return fmt.Sprint(s)
+}
 
-	return builder.String()
+// GoString implements the stack.Stacker interface.
+func (s *Float64Stack) GoString() string {
+	return s.layout().GoSyntax()
 }
 
 // Slice implements the stack.Stacker interface.
@@ -195,11 +274,14 @@ func (s *Float64Stack) IsFull() bool {
 //   - *Float64Stack: A pointer to the newly created stack. Never returns nil.
 func (s *Float64Stack) Copy() *Float64Stack {
 	if s.front == nil {
-		return &Float64Stack{}
+		return &Float64Stack{
+			free_limit: s.free_limit,
+		}
 	}
 
 	s_copy := &Float64Stack{
 		size: s.size,
+		free_limit: s.free_limit,
 	}
 
 	node_copy := &stack_node_float64{
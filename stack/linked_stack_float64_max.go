@@ -0,0 +1,348 @@
+// Code generated with go generate. DO NOT EDIT.
+package stack
+
+import (
+	"cmp"
+	"fmt"
+	"github.com/PlayerR9/iterators/simple"
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+)
+
+// stack_max_node_float64 is a node in the linked stack.
+type stack_max_node_float64 struct {
+	value float64
+
+	// extremum is the largest value of this node and of the nodes below it.
+	extremum float64
+	next *stack_max_node_float64
+}
+
+// Float64MaxStack is a stack of float64 values implemented without a maximum capacity
+// and using a linked list.
+//
+// Each node also remembers the largest value below it, so that Max runs in O(1) time.
+type Float64MaxStack struct {
+	front *stack_max_node_float64
+	size int
+
+	// free is the first node of the pool of free nodes.
+	free *stack_max_node_float64
+
+	// free_size is the number of free nodes.
+	free_size int
+
+	// free_limit is the maximum number of free nodes. 0 disables pooling.
+	free_limit int
+}
+
+// NewFloat64MaxStack creates a new linked stack.
+//
+// Parameters:
+//   - opts: The options of the stack. Supported options are WithInitialValues, which
+//     are pushed in order so that the last one is on top, and WithNodePool.
+//
+// Returns:
+//   - *Float64MaxStack: A pointer to the newly created stack.
+//   - error: An error if the options are invalid.
+func NewFloat64MaxStack(opts ...options.Option) (*Float64MaxStack, error) {
+	settings, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = settings.Only("Float64MaxStack", "WithInitialValues", "WithNodePool")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[float64](settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Float64MaxStack{
+		free_limit: settings.NodePool,
+	}
+
+	s.PushMany(values)
+
+	return s, nil
+}
+
+// getNode returns a node holding the value, reusing a free node if there is one.
+func (s *Float64MaxStack) getNode(value float64) *stack_max_node_float64 {
+	if s.free == nil {
+		return &stack_max_node_float64{
+			value: value,
+		}
+	}
+
+	node := s.free
+
+	s.free = node.next
+	s.free_size--
+
+	node.value = value
+	node.next = nil
+
+	return node
+}
+
+// putNode zeroes the node and keeps it for reuse unless the pool is full.
+func (s *Float64MaxStack) putNode(node *stack_max_node_float64) {
+	if s.free_size >= s.free_limit {
+		return
+	}
+
+	node.value = 0.0
+	node.extremum = 0.0
+	node.next = s.free
+
+	s.free = node
+	s.free_size++
+}
+
+// Push implements the stack.Stacker interface.
+//
+// Always returns true.
+func (s *Float64MaxStack) Push(value float64) bool {
+	node := s.getNode(value)
+	s.track(node)
+
+	if s.front != nil {
+		node.next = s.front
+	}
+
+	s.front = node
+	s.size++
+
+	return true
+}
+
+// PushMany implements the stack.Stacker interface.
+//
+// Always returns the number of values pushed onto the stack.
+func (s *Float64MaxStack) PushMany(values []float64) int {
+	if len(values) == 0 {
+		return 0
+	}
+
+	for _, value := range values {
+		node := s.getNode(value)
+		s.track(node)
+		node.next = s.front
+
+		s.front = node
+	}
+
+	s.size += len(values)
+	
+	return len(values)
+}
+
+// Pop implements the stack.Stacker interface.
+func (s *Float64MaxStack) Pop() (float64, bool) {
+	if s.front == nil {
+		return 0.0, false
+	}
+
+	to_remove := s.front
+	s.front = s.front.next
+
+	s.size--
+
+	value := to_remove.value
+	to_remove.next = nil
+
+	s.putNode(to_remove)
+
+	return value, true
+}
+
+// Peek implements the stack.Stacker interface.
+func (s *Float64MaxStack) Peek() (float64, bool) {
+	if s.front == nil {
+		return 0.0, false
+	}
+
+	return s.front.value, true
+}
+
+// track sets the extremum of the node that is about to be pushed. The values are
+// compared with cmp.Compare, which orders NaN before any other value, as MinStack
+// does.
+func (s *Float64MaxStack) track(node *stack_max_node_float64) {
+	if s.front == nil || cmp.Compare(node.value, s.front.extremum) >= 0 {
+		node.extremum = node.value
+	} else {
+		node.extremum = s.front.extremum
+	}
+}
+
+// Max returns the largest value of the stack without removing it.
+//
+// Returns:
+//   - float64: The largest value.
+//   - bool: True if the stack is not empty, false otherwise.
+func (s *Float64MaxStack) Max() (float64, bool) {
+	if s.front == nil {
+		return 0.0, false
+	}
+
+	return s.front.extremum, true
+}
+
+// IsEmpty implements the stack.Stacker interface.
+func (s *Float64MaxStack) IsEmpty() bool {
+	return s.front == nil
+}
+
+// Size implements the stack.Stacker interface.
+func (s *Float64MaxStack) Size() int {
+	return s.size
+}
+
+// Iterator implements the stack.Stacker interface.
+func (s *Float64MaxStack) Iterator() simple.Iterater[float64] {
+	var builder simple.Builder[float64]
+
+	for node := s.front; node != nil; node = node.next {
+		builder.Add(node.value)
+	}
+
+	return builder.Build()
+}
+
+// Clear implements the stack.Stacker interface.
+func (s *Float64MaxStack) Clear() {
+	if s.front == nil {
+		return
+	}
+
+	for node := s.front; node != nil; {
+		next := node.next
+
+		node.next = nil
+		s.putNode(node)
+
+		node = next
+	}
+
+	s.front = nil
+	s.size = 0
+}
+
+// layout returns the description of the stack used to format it.
+func (s *Float64MaxStack) layout() display.Container[float64] {
+	var opts []string
+
+	if s.free_limit > 0 {
+		opts = append(opts, display.Option("WithNodePool", s.free_limit))
+	}
+
+	var fields []display.Field
+
+	if s.front != nil {
+		fields = append(fields, display.Field{Name: "max", Value: s.front.extremum})
+	}
+
+	values := make([]float64, s.size)
+
+	i := s.size
+	for node := s.front; node != nil; node = node.next {
+		i--
+		values[i] = node.value
+	}
+
+	return display.Container[float64]{
+		Pkg:      "stack",
+		Name:     "Float64MaxStack",
+		Options:  opts,
+		Capacity: -1,
+		Marker:   display.Top,
+		Fields:   fields,
+		Values:   values,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (s *Float64MaxStack) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, s.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (s *Float64MaxStack) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString implements the stack.Stacker interface.
+func (s *Float64MaxStack) GoString() string {
+	return s.layout().GoSyntax()
+}
+
+// Slice implements the stack.Stacker interface.
+//
+// The 0th element is the top of the stack.
+func (s *Float64MaxStack) Slice() []float64 {
+	slice := make([]float64, 0, s.size)
+
+	for node := s.front; node != nil; node = node.next {
+		slice = append(slice, node.value)
+	}
+
+	return slice
+}
+
+// Capacity implements the stack.Stacker interface.
+//
+// Always returns -1.
+func (s *Float64MaxStack) Capacity() int {
+	return -1
+}
+
+// IsFull implements the stack.Stacker interface.
+//
+// Always returns false.
+func (s *Float64MaxStack) IsFull() bool {
+	return false
+}
+
+// Copy is a method that returns a deep copy of the stack.
+//
+// Returns:
+//   - *Float64MaxStack: A pointer to the newly created stack. Never returns nil.
+func (s *Float64MaxStack) Copy() *Float64MaxStack {
+	if s.front == nil {
+		return &Float64MaxStack{
+			free_limit: s.free_limit,
+		}
+	}
+
+	s_copy := &Float64MaxStack{
+		size: s.size,
+		free_limit: s.free_limit,
+	}
+
+	node_copy := &stack_max_node_float64{
+		value: s.front.value,
+		extremum: s.front.extremum,
+	}
+
+	s_copy.front = node_copy
+
+	prev := node_copy
+
+	for node := s.front.next; node != nil; node = node.next {
+		node_copy := &stack_max_node_float64{
+			value: node.value,
+			extremum: node.extremum,
+		}
+
+		prev.next = node_copy
+
+		prev = node_copy
+	}
+
+	return s_copy
+}
\ No newline at end of file
@@ -0,0 +1,348 @@
+// Code generated with go generate. DO NOT EDIT.
+package stack
+
+import (
+	"cmp"
+	"fmt"
+	"github.com/PlayerR9/iterators/simple"
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+)
+
+// stack_max_node_int is a node in the linked stack.
+type stack_max_node_int struct {
+	value int
+
+	// extremum is the largest value of this node and of the nodes below it.
+	extremum int
+	next *stack_max_node_int
+}
+
+// IntMaxStack is a stack of int values implemented without a maximum capacity
+// and using a linked list.
+//
+// Each node also remembers the largest value below it, so that Max runs in O(1) time.
+type IntMaxStack struct {
+	front *stack_max_node_int
+	size int
+
+	// free is the first node of the pool of free nodes.
+	free *stack_max_node_int
+
+	// free_size is the number of free nodes.
+	free_size int
+
+	// free_limit is the maximum number of free nodes. 0 disables pooling.
+	free_limit int
+}
+
+// NewIntMaxStack creates a new linked stack.
+//
+// Parameters:
+//   - opts: The options of the stack. Supported options are WithInitialValues, which
+//     are pushed in order so that the last one is on top, and WithNodePool.
+//
+// Returns:
+//   - *IntMaxStack: A pointer to the newly created stack.
+//   - error: An error if the options are invalid.
+func NewIntMaxStack(opts ...options.Option) (*IntMaxStack, error) {
+	settings, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = settings.Only("IntMaxStack", "WithInitialValues", "WithNodePool")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[int](settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &IntMaxStack{
+		free_limit: settings.NodePool,
+	}
+
+	s.PushMany(values)
+
+	return s, nil
+}
+
+// getNode returns a node holding the value, reusing a free node if there is one.
+func (s *IntMaxStack) getNode(value int) *stack_max_node_int {
+	if s.free == nil {
+		return &stack_max_node_int{
+			value: value,
+		}
+	}
+
+	node := s.free
+
+	s.free = node.next
+	s.free_size--
+
+	node.value = value
+	node.next = nil
+
+	return node
+}
+
+// putNode zeroes the node and keeps it for reuse unless the pool is full.
+func (s *IntMaxStack) putNode(node *stack_max_node_int) {
+	if s.free_size >= s.free_limit {
+		return
+	}
+
+	node.value = 0
+	node.extremum = 0
+	node.next = s.free
+
+	s.free = node
+	s.free_size++
+}
+
+// Push implements the stack.Stacker interface.
+//
+// Always returns true.
+func (s *IntMaxStack) Push(value int) bool {
+	node := s.getNode(value)
+	s.track(node)
+
+	if s.front != nil {
+		node.next = s.front
+	}
+
+	s.front = node
+	s.size++
+
+	return true
+}
+
+// PushMany implements the stack.Stacker interface.
+//
+// Always returns the number of values pushed onto the stack.
+func (s *IntMaxStack) PushMany(values []int) int {
+	if len(values) == 0 {
+		return 0
+	}
+
+	for _, value := range values {
+		node := s.getNode(value)
+		s.track(node)
+		node.next = s.front
+
+		s.front = node
+	}
+
+	s.size += len(values)
+	
+	return len(values)
+}
+
+// Pop implements the stack.Stacker interface.
+func (s *IntMaxStack) Pop() (int, bool) {
+	if s.front == nil {
+		return 0, false
+	}
+
+	to_remove := s.front
+	s.front = s.front.next
+
+	s.size--
+
+	value := to_remove.value
+	to_remove.next = nil
+
+	s.putNode(to_remove)
+
+	return value, true
+}
+
+// Peek implements the stack.Stacker interface.
+func (s *IntMaxStack) Peek() (int, bool) {
+	if s.front == nil {
+		return 0, false
+	}
+
+	return s.front.value, true
+}
+
+// track sets the extremum of the node that is about to be pushed. The values are
+// compared with cmp.Compare, which orders NaN before any other value, as MinStack
+// does.
+func (s *IntMaxStack) track(node *stack_max_node_int) {
+	if s.front == nil || cmp.Compare(node.value, s.front.extremum) >= 0 {
+		node.extremum = node.value
+	} else {
+		node.extremum = s.front.extremum
+	}
+}
+
+// Max returns the largest value of the stack without removing it.
+//
+// Returns:
+//   - int: The largest value.
+//   - bool: True if the stack is not empty, false otherwise.
+func (s *IntMaxStack) Max() (int, bool) {
+	if s.front == nil {
+		return 0, false
+	}
+
+	return s.front.extremum, true
+}
+
+// IsEmpty implements the stack.Stacker interface.
+func (s *IntMaxStack) IsEmpty() bool {
+	return s.front == nil
+}
+
+// Size implements the stack.Stacker interface.
+func (s *IntMaxStack) Size() int {
+	return s.size
+}
+
+// Iterator implements the stack.Stacker interface.
+func (s *IntMaxStack) Iterator() simple.Iterater[int] {
+	var builder simple.Builder[int]
+
+	for node := s.front; node != nil; node = node.next {
+		builder.Add(node.value)
+	}
+
+	return builder.Build()
+}
+
+// Clear implements the stack.Stacker interface.
+func (s *IntMaxStack) Clear() {
+	if s.front == nil {
+		return
+	}
+
+	for node := s.front; node != nil; {
+		next := node.next
+
+		node.next = nil
+		s.putNode(node)
+
+		node = next
+	}
+
+	s.front = nil
+	s.size = 0
+}
+
+// layout returns the description of the stack used to format it.
+func (s *IntMaxStack) layout() display.Container[int] {
+	var opts []string
+
+	if s.free_limit > 0 {
+		opts = append(opts, display.Option("WithNodePool", s.free_limit))
+	}
+
+	var fields []display.Field
+
+	if s.front != nil {
+		fields = append(fields, display.Field{Name: "max", Value: s.front.extremum})
+	}
+
+	values := make([]int, s.size)
+
+	i := s.size
+	for node := s.front; node != nil; node = node.next {
+		i--
+		values[i] = node.value
+	}
+
+	return display.Container[int]{
+		Pkg:      "stack",
+		Name:     "IntMaxStack",
+		Options:  opts,
+		Capacity: -1,
+		Marker:   display.Top,
+		Fields:   fields,
+		Values:   values,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (s *IntMaxStack) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, s.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (s *IntMaxStack) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString implements the stack.Stacker interface.
+func (s *IntMaxStack) GoString() string {
+	return s.layout().GoSyntax()
+}
+
+// Slice implements the stack.Stacker interface.
+//
+// The 0th element is the top of the stack.
+func (s *IntMaxStack) Slice() []int {
+	slice := make([]int, 0, s.size)
+
+	for node := s.front; node != nil; node = node.next {
+		slice = append(slice, node.value)
+	}
+
+	return slice
+}
+
+// Capacity implements the stack.Stacker interface.
+//
+// Always returns -1.
+func (s *IntMaxStack) Capacity() int {
+	return -1
+}
+
+// IsFull implements the stack.Stacker interface.
+//
+// Always returns false.
+func (s *IntMaxStack) IsFull() bool {
+	return false
+}
+
+// Copy is a method that returns a deep copy of the stack.
+//
+// Returns:
+//   - *IntMaxStack: A pointer to the newly created stack. Never returns nil.
+func (s *IntMaxStack) Copy() *IntMaxStack {
+	if s.front == nil {
+		return &IntMaxStack{
+			free_limit: s.free_limit,
+		}
+	}
+
+	s_copy := &IntMaxStack{
+		size: s.size,
+		free_limit: s.free_limit,
+	}
+
+	node_copy := &stack_max_node_int{
+		value: s.front.value,
+		extremum: s.front.extremum,
+	}
+
+	s_copy.front = node_copy
+
+	prev := node_copy
+
+	for node := s.front.next; node != nil; node = node.next {
+		node_copy := &stack_max_node_int{
+			value: node.value,
+			extremum: node.extremum,
+		}
+
+		prev.next = node_copy
+
+		prev = node_copy
+	}
+
+	return s_copy
+}
\ No newline at end of file
@@ -0,0 +1,348 @@
+// Code generated with go generate. DO NOT EDIT.
+package stack
+
+import (
+	"cmp"
+	"fmt"
+	"github.com/PlayerR9/iterators/simple"
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+)
+
+// stack_min_node_int is a node in the linked stack.
+type stack_min_node_int struct {
+	value int
+
+	// extremum is the smallest value of this node and of the nodes below it.
+	extremum int
+	next *stack_min_node_int
+}
+
+// IntMinStack is a stack of int values implemented without a maximum capacity
+// and using a linked list.
+//
+// Each node also remembers the smallest value below it, so that Min runs in O(1) time.
+type IntMinStack struct {
+	front *stack_min_node_int
+	size int
+
+	// free is the first node of the pool of free nodes.
+	free *stack_min_node_int
+
+	// free_size is the number of free nodes.
+	free_size int
+
+	// free_limit is the maximum number of free nodes. 0 disables pooling.
+	free_limit int
+}
+
+// NewIntMinStack creates a new linked stack.
+//
+// Parameters:
+//   - opts: The options of the stack. Supported options are WithInitialValues, which
+//     are pushed in order so that the last one is on top, and WithNodePool.
+//
+// Returns:
+//   - *IntMinStack: A pointer to the newly created stack.
+//   - error: An error if the options are invalid.
+func NewIntMinStack(opts ...options.Option) (*IntMinStack, error) {
+	settings, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = settings.Only("IntMinStack", "WithInitialValues", "WithNodePool")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[int](settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &IntMinStack{
+		free_limit: settings.NodePool,
+	}
+
+	s.PushMany(values)
+
+	return s, nil
+}
+
+// getNode returns a node holding the value, reusing a free node if there is one.
+func (s *IntMinStack) getNode(value int) *stack_min_node_int {
+	if s.free == nil {
+		return &stack_min_node_int{
+			value: value,
+		}
+	}
+
+	node := s.free
+
+	s.free = node.next
+	s.free_size--
+
+	node.value = value
+	node.next = nil
+
+	return node
+}
+
+// putNode zeroes the node and keeps it for reuse unless the pool is full.
+func (s *IntMinStack) putNode(node *stack_min_node_int) {
+	if s.free_size >= s.free_limit {
+		return
+	}
+
+	node.value = 0
+	node.extremum = 0
+	node.next = s.free
+
+	s.free = node
+	s.free_size++
+}
+
+// Push implements the stack.Stacker interface.
+//
+// Always returns true.
+func (s *IntMinStack) Push(value int) bool {
+	node := s.getNode(value)
+	s.track(node)
+
+	if s.front != nil {
+		node.next = s.front
+	}
+
+	s.front = node
+	s.size++
+
+	return true
+}
+
+// PushMany implements the stack.Stacker interface.
+//
+// Always returns the number of values pushed onto the stack.
+func (s *IntMinStack) PushMany(values []int) int {
+	if len(values) == 0 {
+		return 0
+	}
+
+	for _, value := range values {
+		node := s.getNode(value)
+		s.track(node)
+		node.next = s.front
+
+		s.front = node
+	}
+
+	s.size += len(values)
+	
+	return len(values)
+}
+
+// Pop implements the stack.Stacker interface.
+func (s *IntMinStack) Pop() (int, bool) {
+	if s.front == nil {
+		return 0, false
+	}
+
+	to_remove := s.front
+	s.front = s.front.next
+
+	s.size--
+
+	value := to_remove.value
+	to_remove.next = nil
+
+	s.putNode(to_remove)
+
+	return value, true
+}
+
+// Peek implements the stack.Stacker interface.
+func (s *IntMinStack) Peek() (int, bool) {
+	if s.front == nil {
+		return 0, false
+	}
+
+	return s.front.value, true
+}
+
+// track sets the extremum of the node that is about to be pushed. The values are
+// compared with cmp.Compare, which orders NaN before any other value, as MinStack
+// does.
+func (s *IntMinStack) track(node *stack_min_node_int) {
+	if s.front == nil || cmp.Compare(node.value, s.front.extremum) <= 0 {
+		node.extremum = node.value
+	} else {
+		node.extremum = s.front.extremum
+	}
+}
+
+// Min returns the smallest value of the stack without removing it.
+//
+// Returns:
+//   - int: The smallest value.
+//   - bool: True if the stack is not empty, false otherwise.
+func (s *IntMinStack) Min() (int, bool) {
+	if s.front == nil {
+		return 0, false
+	}
+
+	return s.front.extremum, true
+}
+
+// IsEmpty implements the stack.Stacker interface.
+func (s *IntMinStack) IsEmpty() bool {
+	return s.front == nil
+}
+
+// Size implements the stack.Stacker interface.
+func (s *IntMinStack) Size() int {
+	return s.size
+}
+
+// Iterator implements the stack.Stacker interface.
+func (s *IntMinStack) Iterator() simple.Iterater[int] {
+	var builder simple.Builder[int]
+
+	for node := s.front; node != nil; node = node.next {
+		builder.Add(node.value)
+	}
+
+	return builder.Build()
+}
+
+// Clear implements the stack.Stacker interface.
+func (s *IntMinStack) Clear() {
+	if s.front == nil {
+		return
+	}
+
+	for node := s.front; node != nil; {
+		next := node.next
+
+		node.next = nil
+		s.putNode(node)
+
+		node = next
+	}
+
+	s.front = nil
+	s.size = 0
+}
+
+// layout returns the description of the stack used to format it.
+func (s *IntMinStack) layout() display.Container[int] {
+	var opts []string
+
+	if s.free_limit > 0 {
+		opts = append(opts, display.Option("WithNodePool", s.free_limit))
+	}
+
+	var fields []display.Field
+
+	if s.front != nil {
+		fields = append(fields, display.Field{Name: "min", Value: s.front.extremum})
+	}
+
+	values := make([]int, s.size)
+
+	i := s.size
+	for node := s.front; node != nil; node = node.next {
+		i--
+		values[i] = node.value
+	}
+
+	return display.Container[int]{
+		Pkg:      "stack",
+		Name:     "IntMinStack",
+		Options:  opts,
+		Capacity: -1,
+		Marker:   display.Top,
+		Fields:   fields,
+		Values:   values,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (s *IntMinStack) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, s.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (s *IntMinStack) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString implements the stack.Stacker interface.
+func (s *IntMinStack) GoString() string {
+	return s.layout().GoSyntax()
+}
+
+// Slice implements the stack.Stacker interface.
+//
+// The 0th element is the top of the stack.
+func (s *IntMinStack) Slice() []int {
+	slice := make([]int, 0, s.size)
+
+	for node := s.front; node != nil; node = node.next {
+		slice = append(slice, node.value)
+	}
+
+	return slice
+}
+
+// Capacity implements the stack.Stacker interface.
+//
+// Always returns -1.
+func (s *IntMinStack) Capacity() int {
+	return -1
+}
+
+// IsFull implements the stack.Stacker interface.
+//
+// Always returns false.
+func (s *IntMinStack) IsFull() bool {
+	return false
+}
+
+// Copy is a method that returns a deep copy of the stack.
+//
+// Returns:
+//   - *IntMinStack: A pointer to the newly created stack. Never returns nil.
+func (s *IntMinStack) Copy() *IntMinStack {
+	if s.front == nil {
+		return &IntMinStack{
+			free_limit: s.free_limit,
+		}
+	}
+
+	s_copy := &IntMinStack{
+		size: s.size,
+		free_limit: s.free_limit,
+	}
+
+	node_copy := &stack_min_node_int{
+		value: s.front.value,
+		extremum: s.front.extremum,
+	}
+
+	s_copy.front = node_copy
+
+	prev := node_copy
+
+	for node := s.front.next; node != nil; node = node.next {
+		node_copy := &stack_min_node_int{
+			value: node.value,
+			extremum: node.extremum,
+		}
+
+		prev.next = node_copy
+
+		prev = node_copy
+	}
+
+	return s_copy
+}
\ No newline at end of file
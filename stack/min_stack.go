@@ -0,0 +1,440 @@
+package stack
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+
+	gcers "github.com/PlayerR9/go-commons/errors"
+	itrs "github.com/PlayerR9/iterators/simple"
+)
+
+var (
+	// extremum_stack_options are the options supported by the MinStack and MaxStack
+	// constructors.
+	extremum_stack_options = []string{"WithCapacity", "WithInitialValues"}
+)
+
+// extremum_stack is the array-backed stack shared by MinStack and MaxStack. Next to
+// the values, it keeps an auxiliary stack of the successive extrema so that the
+// current one is always on top of it.
+type extremum_stack[T any] struct {
+	// values is a slice of type T that stores the elements in the stack.
+	values []T
+
+	// extrema is the auxiliary stack. A value is pushed onto it when it is at least
+	// as extreme as its top, and popped from it when it leaves the stack.
+	extrema []T
+
+	// cmp returns a negative number when a is more extreme than b, and 0 when they
+	// are equally extreme.
+	cmp func(a, b T) int
+
+	// ordered tells whether cmp is cmp.Compare, or its reverse; as given by
+	// NewMinStack and NewMaxStack.
+	ordered bool
+
+	// capacity is the maximum number of elements the stack can hold. -1 means
+	// that the stack is unlimited.
+	capacity int
+
+	// name is the name of the type, used to format the stack.
+	name string
+
+	// label is the name of the extremum, used to format the stack.
+	label string
+}
+
+// new_extremum_stack creates a new extremum_stack.
+//
+// Parameters:
+//   - name: The name of the type.
+//   - label: The name of the extremum.
+//   - cmp: The comparison function. Assumed to be non-nil.
+//   - opts: The options of the stack.
+//
+// Returns:
+//   - extremum_stack[T]: The new stack.
+//   - error: An error if the options are invalid.
+func new_extremum_stack[T any](name, label string, cmp func(a, b T) int, opts []options.Option) (extremum_stack[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return extremum_stack[T]{}, err
+	}
+
+	err = s.Only(name, extremum_stack_options...)
+	if err != nil {
+		return extremum_stack[T]{}, err
+	}
+
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return extremum_stack[T]{}, err
+	}
+
+	stack := extremum_stack[T]{
+		cmp:      cmp,
+		capacity: s.Capacity,
+		name:     name,
+		label:    label,
+	}
+
+	stack.PushMany(values)
+
+	return stack, nil
+}
+
+// extremum returns the current extremum of the stack.
+//
+// Returns:
+//   - T: The extremum.
+//   - bool: True if the stack is not empty, false otherwise.
+func (stack *extremum_stack[T]) extremum() (T, bool) {
+	if len(stack.extrema) == 0 {
+		return *new(T), false
+	}
+
+	return stack.extrema[len(stack.extrema)-1], true
+}
+
+// push pushes a value without checking the capacity.
+//
+// Parameters:
+//   - value: The value to push.
+func (stack *extremum_stack[T]) push(value T) {
+	stack.values = append(stack.values, value)
+
+	if len(stack.extrema) == 0 || stack.cmp(value, stack.extrema[len(stack.extrema)-1]) <= 0 {
+		stack.extrema = append(stack.extrema, value)
+	}
+}
+
+// Push implements the Stacker interface.
+func (stack *extremum_stack[T]) Push(value T) bool {
+	if stack.capacity != -1 && len(stack.values) >= stack.capacity {
+		return false
+	}
+
+	stack.push(value)
+
+	return true
+}
+
+// PushMany implements the Stacker interface.
+//
+// Either all the values are pushed or none of them is.
+func (stack *extremum_stack[T]) PushMany(values []T) int {
+	if stack.capacity != -1 && len(stack.values)+len(values) > stack.capacity {
+		return 0
+	}
+
+	stack.values = slices.Grow(stack.values, len(values))
+
+	for _, value := range values {
+		stack.push(value)
+	}
+
+	return len(values)
+}
+
+// Pop implements the Stacker interface.
+func (stack *extremum_stack[T]) Pop() (T, bool) {
+	if len(stack.values) == 0 {
+		return *new(T), false
+	}
+
+	toRemove := stack.values[len(stack.values)-1]
+
+	stack.values[len(stack.values)-1] = *new(T)
+	stack.values = stack.values[:len(stack.values)-1]
+
+	last := len(stack.extrema) - 1
+
+	if stack.cmp(toRemove, stack.extrema[last]) == 0 {
+		stack.extrema[last] = *new(T)
+		stack.extrema = stack.extrema[:last]
+	}
+
+	return toRemove, true
+}
+
+// Peek implements the Stacker interface.
+func (stack *extremum_stack[T]) Peek() (T, bool) {
+	if len(stack.values) == 0 {
+		return *new(T), false
+	}
+
+	return stack.values[len(stack.values)-1], true
+}
+
+// IsEmpty implements the Stacker interface.
+func (stack *extremum_stack[T]) IsEmpty() bool {
+	return len(stack.values) == 0
+}
+
+// Size implements the Stacker interface.
+func (stack *extremum_stack[T]) Size() int {
+	return len(stack.values)
+}
+
+// Iterator implements the Stacker interface.
+func (stack *extremum_stack[T]) Iterator() itrs.Iterater[T] {
+	var builder itrs.Builder[T]
+
+	for i := len(stack.values) - 1; i >= 0; i-- {
+		builder.Add(stack.values[i])
+	}
+
+	return builder.Build()
+}
+
+// Clear implements the Stacker interface.
+func (stack *extremum_stack[T]) Clear() {
+	stack.values = nil
+	stack.extrema = nil
+}
+
+// layout returns the description of the stack used to format it.
+func (stack *extremum_stack[T]) layout() display.Container[T] {
+	var opts []string
+
+	if stack.capacity != -1 {
+		opts = append(opts, display.Option("WithCapacity", stack.capacity))
+	}
+
+	var fields []display.Field
+
+	if len(stack.extrema) > 0 {
+		fields = append(fields, display.Field{Name: stack.label, Value: stack.extrema[len(stack.extrema)-1]})
+	}
+
+	return display.Container[T]{
+		Pkg:      "stack",
+		Name:     stack.name,
+		TypeArgs: []string{display.TypeName[T]()},
+		Options:  opts,
+		Opaque:   !stack.ordered,
+		Capacity: stack.capacity,
+		Marker:   display.Top,
+		Fields:   fields,
+		Values:   stack.values,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+//
+// The %#v verb cannot rebuild a stack created with NewMinStackFunc or
+// NewMaxStackFunc, as its comparison function has no Go syntax; it gives the
+// detailed form instead.
+func (stack *extremum_stack[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, stack.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (stack *extremum_stack[T]) String() string {
+	return fmt.Sprint(stack)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (stack *extremum_stack[T]) GoString() string {
+	return stack.layout().GoSyntax()
+}
+
+// Slice implements the Stacker interface.
+//
+// The last element is the top of the stack.
+func (stack *extremum_stack[T]) Slice() []T {
+	slice := make([]T, len(stack.values))
+	copy(slice, stack.values)
+
+	return slice
+}
+
+// Capacity implements the Stacker interface.
+func (stack *extremum_stack[T]) Capacity() int {
+	return stack.capacity
+}
+
+// IsFull implements the Stacker interface.
+func (stack *extremum_stack[T]) IsFull() bool {
+	return stack.capacity != -1 && len(stack.values) >= stack.capacity
+}
+
+// copy returns a deep copy of the stack.
+//
+// Returns:
+//   - extremum_stack[T]: The copy.
+func (stack *extremum_stack[T]) copy() extremum_stack[T] {
+	return extremum_stack[T]{
+		values:   slices.Clone(stack.values),
+		extrema:  slices.Clone(stack.extrema),
+		cmp:      stack.cmp,
+		ordered:  stack.ordered,
+		capacity: stack.capacity,
+		name:     stack.name,
+		label:    stack.label,
+	}
+}
+
+// MinStack is a generic type that represents a stack data structure with or
+// without a limited capacity that also knows its smallest element. It is
+// implemented using an array and an auxiliary stack of the successive minimums,
+// so that Min runs in O(1) time.
+type MinStack[T any] struct {
+	extremum_stack[T]
+}
+
+// NewMinStack is a function that creates and returns a new instance of a MinStack
+// whose values are compared with cmp.Compare.
+//
+// Parameters:
+//   - opts: The options of the stack. Supported options are WithCapacity, without
+//     which the stack is unlimited, and WithInitialValues, which are pushed in
+//     order so that the last one is on top. A full stack rejects new values.
+//
+// Returns:
+//   - *MinStack[T]: A pointer to the newly created MinStack.
+//   - error: An error if the options are invalid.
+func NewMinStack[T cmp.Ordered](opts ...options.Option) (*MinStack[T], error) {
+	stack, err := NewMinStackFunc(cmp.Compare[T], opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	stack.ordered = true
+
+	return stack, nil
+}
+
+// NewMinStackFunc is a function that creates and returns a new instance of a
+// MinStack whose values are compared with the given function.
+//
+// Parameters:
+//   - cmp: The comparison function. It returns a negative number when a < b, 0
+//     when a == b and a positive number when a > b.
+//   - opts: The options of the stack. See NewMinStack.
+//
+// Returns:
+//   - *MinStack[T]: A pointer to the newly created MinStack.
+//   - error: An error of type *errors.ErrInvalidParameter if cmp is nil, or an
+//     error if the options are invalid.
+func NewMinStackFunc[T any](cmp func(a, b T) int, opts ...options.Option) (*MinStack[T], error) {
+	if cmp == nil {
+		return nil, gcers.NewErrNilParameter("cmp")
+	}
+
+	inner, err := new_extremum_stack("MinStack", "min", cmp, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MinStack[T]{
+		extremum_stack: inner,
+	}, nil
+}
+
+// Min is a method of the MinStack type. It returns the smallest element of the
+// stack without removing it.
+//
+// Returns:
+//   - T: The smallest element. If several elements are equally small, any of them.
+//   - bool: True if the stack is not empty, false otherwise.
+func (stack *MinStack[T]) Min() (T, bool) {
+	return stack.extremum()
+}
+
+// Copy is a method of the MinStack type. It is used to create a shallow copy of
+// the stack.
+//
+// Returns:
+//   - *MinStack[T]: A copy of the stack. Never returns nil.
+func (stack *MinStack[T]) Copy() *MinStack[T] {
+	return &MinStack[T]{
+		extremum_stack: stack.copy(),
+	}
+}
+
+// MaxStack is a generic type that represents a stack data structure with or
+// without a limited capacity that also knows its largest element. It is
+// implemented using an array and an auxiliary stack of the successive maximums,
+// so that Max runs in O(1) time.
+type MaxStack[T any] struct {
+	extremum_stack[T]
+}
+
+// NewMaxStack is a function that creates and returns a new instance of a MaxStack
+// whose values are compared with cmp.Compare.
+//
+// Parameters:
+//   - opts: The options of the stack. Supported options are WithCapacity, without
+//     which the stack is unlimited, and WithInitialValues, which are pushed in
+//     order so that the last one is on top. A full stack rejects new values.
+//
+// Returns:
+//   - *MaxStack[T]: A pointer to the newly created MaxStack.
+//   - error: An error if the options are invalid.
+func NewMaxStack[T cmp.Ordered](opts ...options.Option) (*MaxStack[T], error) {
+	stack, err := NewMaxStackFunc(cmp.Compare[T], opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	stack.ordered = true
+
+	return stack, nil
+}
+
+// NewMaxStackFunc is a function that creates and returns a new instance of a
+// MaxStack whose values are compared with the given function.
+//
+// Parameters:
+//   - cmp: The comparison function. It returns a negative number when a < b, 0
+//     when a == b and a positive number when a > b.
+//   - opts: The options of the stack. See NewMaxStack.
+//
+// Returns:
+//   - *MaxStack[T]: A pointer to the newly created MaxStack.
+//   - error: An error of type *errors.ErrInvalidParameter if cmp is nil, or an
+//     error if the options are invalid.
+func NewMaxStackFunc[T any](cmp func(a, b T) int, opts ...options.Option) (*MaxStack[T], error) {
+	if cmp == nil {
+		return nil, gcers.NewErrNilParameter("cmp")
+	}
+
+	reversed := func(a, b T) int {
+		return cmp(b, a)
+	}
+
+	inner, err := new_extremum_stack("MaxStack", "max", reversed, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MaxStack[T]{
+		extremum_stack: inner,
+	}, nil
+}
+
+// Max is a method of the MaxStack type. It returns the largest element of the
+// stack without removing it.
+//
+// Returns:
+//   - T: The largest element. If several elements are equally large, any of them.
+//   - bool: True if the stack is not empty, false otherwise.
+func (stack *MaxStack[T]) Max() (T, bool) {
+	return stack.extremum()
+}
+
+// Copy is a method of the MaxStack type. It is used to create a shallow copy of
+// the stack.
+//
+// Returns:
+//   - *MaxStack[T]: A copy of the stack. Never returns nil.
+func (stack *MaxStack[T]) Copy() *MaxStack[T] {
+	return &MaxStack[T]{
+		extremum_stack: stack.copy(),
+	}
+}
@@ -0,0 +1,125 @@
+package stack
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/PlayerR9/listlike/options"
+)
+
+// same_float tells whether two extrema are the same, NaN included.
+func same_float(a, b float64) bool {
+	return a == b || (math.IsNaN(a) && math.IsNaN(b))
+}
+
+// extremum_sequences are the sequences of values pushed by the tests, with NaN in
+// several positions.
+var extremum_sequences = [][]float64{
+	{1, 2, 3},
+	{math.NaN(), 1, 2},
+	{1, math.NaN(), 0},
+	{2, 1, math.NaN()},
+	{math.NaN(), math.NaN(), -1, 5},
+	{3, math.Inf(-1), math.NaN(), math.Inf(1)},
+}
+
+// TestFloat64MinStackNaN checks that the generated Float64MinStack and
+// MinStack[float64] give the same minimums after every push and pop.
+func TestFloat64MinStackNaN(t *testing.T) {
+	for _, values := range extremum_sequences {
+		generated, err := NewFloat64MinStack()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		generic, err := NewMinStack[float64]()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		check := func(step string) {
+			got, ok1 := generated.Min()
+			want, ok2 := generic.Min()
+
+			if ok1 != ok2 || !same_float(got, want) {
+				t.Fatalf("%v, %s: Float64MinStack.Min() = %v, %t; MinStack.Min() = %v, %t", values, step, got, ok1, want, ok2)
+			}
+		}
+
+		for _, value := range values {
+			generated.Push(value)
+			generic.Push(value)
+			check("push")
+		}
+
+		for range values {
+			generated.Pop()
+			generic.Pop()
+			check("pop")
+		}
+	}
+}
+
+// TestFloat64MaxStackNaN checks the same for Float64MaxStack and MaxStack[float64].
+func TestFloat64MaxStackNaN(t *testing.T) {
+	for _, values := range extremum_sequences {
+		generated, err := NewFloat64MaxStack()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		generic, err := NewMaxStack[float64]()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		check := func(step string) {
+			got, ok1 := generated.Max()
+			want, ok2 := generic.Max()
+
+			if ok1 != ok2 || !same_float(got, want) {
+				t.Fatalf("%v, %s: Float64MaxStack.Max() = %v, %t; MaxStack.Max() = %v, %t", values, step, got, ok1, want, ok2)
+			}
+		}
+
+		for _, value := range values {
+			generated.Push(value)
+			generic.Push(value)
+			check("push")
+		}
+
+		for range values {
+			generated.Pop()
+			generic.Pop()
+			check("pop")
+		}
+	}
+}
+
+// TestMinStackGoString checks that %#v rebuilds a MinStack created with
+// NewMinStack, and gives the detailed form for a comparison function.
+func TestMinStackGoString(t *testing.T) {
+	stack, err := NewMinStack[int](options.WithInitialValues[int](3, 1, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "func() *stack.MinStack[int] { c, _ := stack.NewMinStack[int](options.WithInitialValues[int](3, 1, 2)); return c }()"
+
+	if got := fmt.Sprintf("%#v", stack.Copy()); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	stack, err = NewMinStackFunc(cmp.Compare[int], options.WithInitialValues[int](3, 1, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want = "MinStack{size=3, capacity=unbounded, min=1, values=[3, 1, 2 →]}"
+
+	if got := fmt.Sprintf("%#v", stack); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
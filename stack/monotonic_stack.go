@@ -0,0 +1,241 @@
+package stack
+
+import (
+	"fmt"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+
+	gcers "github.com/PlayerR9/go-commons/errors"
+	itrs "github.com/PlayerR9/iterators/simple"
+)
+
+// MonotonicStack is a generic type that represents a stack whose values are kept
+// in non-increasing order, from the bottom to the top, according to a comparator.
+// Pushing a value first evicts the values on top that are smaller than it; these
+// are said to be dominated by it.
+//
+// With cmp.Compare, the top is the smallest value and the bottom the largest one;
+// pass a reversed comparator for the opposite order. The evicted values can be
+// observed with OnEvict, which is how next-greater-element queries are answered.
+type MonotonicStack[T any] struct {
+	// values is a slice of type T that stores the elements in the stack.
+	values []T
+
+	// cmp returns a negative number when a < b, 0 when a == b and a positive
+	// number when a > b.
+	cmp func(a, b T) int
+
+	// on_evict is called with each evicted value and the value that evicted it.
+	// Nil if not set.
+	on_evict func(evicted, by T)
+}
+
+// NewMonotonicStack is a function that creates and returns a new instance of a
+// MonotonicStack.
+//
+// Parameters:
+//   - cmp: The comparison function. It returns a negative number when a < b, 0
+//     when a == b and a positive number when a > b.
+//   - opts: The options of the stack. The only supported option is
+//     WithInitialValues, which are pushed in order; dominated values are evicted.
+//
+// Returns:
+//   - *MonotonicStack[T]: A pointer to the newly created MonotonicStack.
+//   - error: An error of type *errors.ErrInvalidParameter if cmp is nil, or an
+//     error if the options are invalid.
+func NewMonotonicStack[T any](cmp func(a, b T) int, opts ...options.Option) (*MonotonicStack[T], error) {
+	if cmp == nil {
+		return nil, gcers.NewErrNilParameter("cmp")
+	}
+
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Only("MonotonicStack", "WithInitialValues")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
+	}
+
+	stack := &MonotonicStack[T]{
+		cmp: cmp,
+	}
+
+	stack.PushMany(values)
+
+	return stack, nil
+}
+
+// OnEvict is a method of the MonotonicStack type. It sets the function called,
+// from the top down, with each value evicted by a push.
+//
+// Parameters:
+//   - f: The function, called with the evicted value and the value being pushed.
+//     Nil disables the callback.
+func (stack *MonotonicStack[T]) OnEvict(f func(evicted, by T)) {
+	stack.on_evict = f
+}
+
+// Push implements the Stacker interface.
+//
+// The values on top that are smaller than value are evicted first. Always returns
+// true.
+func (stack *MonotonicStack[T]) Push(value T) bool {
+	for len(stack.values) > 0 {
+		top := stack.values[len(stack.values)-1]
+		if stack.cmp(top, value) >= 0 {
+			break
+		}
+
+		stack.values[len(stack.values)-1] = *new(T)
+		stack.values = stack.values[:len(stack.values)-1]
+
+		if stack.on_evict != nil {
+			stack.on_evict(top, value)
+		}
+	}
+
+	stack.values = append(stack.values, value)
+
+	return true
+}
+
+// PushMany implements the Stacker interface.
+//
+// Always returns the number of values pushed, evicted or not.
+func (stack *MonotonicStack[T]) PushMany(values []T) int {
+	for _, value := range values {
+		stack.Push(value)
+	}
+
+	return len(values)
+}
+
+// Pop implements the Stacker interface.
+//
+// Popped values are not reported to the OnEvict callback.
+func (stack *MonotonicStack[T]) Pop() (T, bool) {
+	if len(stack.values) == 0 {
+		return *new(T), false
+	}
+
+	toRemove := stack.values[len(stack.values)-1]
+
+	stack.values[len(stack.values)-1] = *new(T)
+	stack.values = stack.values[:len(stack.values)-1]
+
+	return toRemove, true
+}
+
+// Peek implements the Stacker interface.
+func (stack *MonotonicStack[T]) Peek() (T, bool) {
+	if len(stack.values) == 0 {
+		return *new(T), false
+	}
+
+	return stack.values[len(stack.values)-1], true
+}
+
+// Bottom is a method of the MonotonicStack type. It returns the value at the bottom
+// of the stack, which is the largest one, without removing it.
+//
+// Returns:
+//   - T: The value at the bottom of the stack.
+//   - bool: True if the stack is not empty, false otherwise.
+func (stack *MonotonicStack[T]) Bottom() (T, bool) {
+	if len(stack.values) == 0 {
+		return *new(T), false
+	}
+
+	return stack.values[0], true
+}
+
+// IsEmpty implements the Stacker interface.
+func (stack *MonotonicStack[T]) IsEmpty() bool {
+	return len(stack.values) == 0
+}
+
+// Size implements the Stacker interface.
+func (stack *MonotonicStack[T]) Size() int {
+	return len(stack.values)
+}
+
+// Iterator implements the Stacker interface.
+func (stack *MonotonicStack[T]) Iterator() itrs.Iterater[T] {
+	var builder itrs.Builder[T]
+
+	for i := len(stack.values) - 1; i >= 0; i-- {
+		builder.Add(stack.values[i])
+	}
+
+	return builder.Build()
+}
+
+// Clear implements the Stacker interface.
+//
+// Cleared values are not reported to the OnEvict callback.
+func (stack *MonotonicStack[T]) Clear() {
+	stack.values = nil
+}
+
+// layout returns the description of the stack used to format it.
+func (stack *MonotonicStack[T]) layout() display.Container[T] {
+	return display.Container[T]{
+		Pkg:      "stack",
+		Name:     "MonotonicStack",
+		TypeArgs: []string{display.TypeName[T]()},
+		Opaque:   true,
+		Capacity: -1,
+		Marker:   display.Top,
+		Values:   stack.values,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+//
+// The %#v verb cannot rebuild the stack, as its comparison function has no Go
+// syntax; it gives the detailed form instead.
+func (stack *MonotonicStack[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, stack.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (stack *MonotonicStack[T]) String() string {
+	return fmt.Sprint(stack)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (stack *MonotonicStack[T]) GoString() string {
+	return stack.layout().GoSyntax()
+}
+
+// Slice implements the Stacker interface.
+//
+// The last element is the top of the stack.
+func (stack *MonotonicStack[T]) Slice() []T {
+	slice := make([]T, len(stack.values))
+	copy(slice, stack.values)
+
+	return slice
+}
+
+// Capacity implements the Stacker interface.
+//
+// Always returns -1.
+func (stack *MonotonicStack[T]) Capacity() int {
+	return -1
+}
+
+// IsFull implements the Stacker interface.
+//
+// Always returns false.
+func (stack *MonotonicStack[T]) IsFull() bool {
+	return false
+}
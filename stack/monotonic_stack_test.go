@@ -0,0 +1,90 @@
+package stack
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+// TestMonotonicStackOnEvict checks that the evicted values are reported from the
+// top down, with the value that evicted them, and that equal values are kept.
+func TestMonotonicStackOnEvict(t *testing.T) {
+	stack, err := NewMonotonicStack(cmp.Compare[int])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got [][2]int
+
+	stack.OnEvict(func(evicted, by int) {
+		got = append(got, [2]int{evicted, by})
+	})
+
+	for _, value := range []int{9, 4, 4, 1, 6, 6} {
+		stack.Push(value)
+	}
+
+	want := [][2]int{{1, 6}, {4, 6}, {4, 6}}
+
+	if !slices.Equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if values := stack.Slice(); !slices.Equal(values, []int{9, 6, 6}) {
+		t.Fatalf("got %v, want [9 6 6]", values)
+	}
+
+	if bottom, ok := stack.Bottom(); !ok || bottom != 9 {
+		t.Fatalf("got bottom %d, %t, want 9", bottom, ok)
+	}
+
+	stack.Pop()
+	stack.Clear()
+
+	if len(got) != len(want) {
+		t.Fatal("Pop or Clear called OnEvict")
+	}
+}
+
+// TestMonotonicStackNextGreater checks the next-greater-element query answered
+// with OnEvict against a brute-force search.
+func TestMonotonicStackNextGreater(t *testing.T) {
+	values := []int{2, 7, 3, 5, 4, 6, 8, 1, 1, 9, 3}
+
+	// The indices are pushed, compared by their values, so that the duplicates
+	// are told apart.
+	indexed, err := NewMonotonicStack(func(a, b int) int {
+		return cmp.Compare(values[a], values[b])
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := make([]int, len(values))
+	for i := range got {
+		got[i] = -1
+	}
+
+	indexed.OnEvict(func(evicted, by int) {
+		got[evicted] = values[by]
+	})
+
+	for i := range values {
+		indexed.Push(i)
+	}
+
+	for i, value := range values {
+		want := -1
+
+		for _, other := range values[i+1:] {
+			if other > value {
+				want = other
+				break
+			}
+		}
+
+		if got[i] != want {
+			t.Fatalf("index %d: got next greater %d, want %d", i, got[i], want)
+		}
+	}
+}
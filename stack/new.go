@@ -0,0 +1,220 @@
+package stack
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+
+	itrs "github.com/PlayerR9/iterators/simple"
+)
+
+var (
+	// array_stack_options are the options supported by NewArrayStack.
+	array_stack_options = []string{"WithInitialValues", "WithGrowthFactor", "WithReserve", "WithShrinkThreshold"}
+
+	// limited_array_stack_options are the options supported by NewLimitedArrayStack.
+	limited_array_stack_options = []string{"WithCapacity", "WithInitialValues", "WithOverflowPolicy", "WithGrowthFactor", "WithReserve", "WithShrinkThreshold"}
+
+	// limited_linked_stack_options are the options supported by NewLimitedLinkedStack.
+	limited_linked_stack_options = []string{"WithCapacity", "WithInitialValues", "WithOverflowPolicy", "WithNodePool"}
+)
+
+// New is a function that creates a stack whose implementation is picked from the
+// options:
+//   - a LimitedLinkedStack if WithNodePool is given;
+//   - a LimitedArrayStack if WithCapacity or WithOverflowPolicy is given;
+//   - an ArrayStack otherwise.
+//
+// Each of them supports WithThreadSafety on top of its own options. When enabled,
+// every method of the stack is guarded by a mutex.
+//
+// Parameters:
+//   - opts: The options of the stack.
+//
+// Returns:
+//   - Stacker[T]: The new stack.
+//   - error: An error if the options are invalid.
+func New[T any](opts ...options.Option) (Stacker[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var stack Stacker[T]
+
+	switch {
+	case s.Has("WithNodePool"):
+		err := s.Only("LimitedLinkedStack", append(limited_linked_stack_options, "WithThreadSafety")...)
+		if err != nil {
+			return nil, err
+		}
+
+		stack, err = new_limited_linked_stack[T](s)
+		if err != nil {
+			return nil, err
+		}
+	case s.Has("WithCapacity"), s.Has("WithOverflowPolicy"):
+		err := s.Only("LimitedArrayStack", append(limited_array_stack_options, "WithThreadSafety")...)
+		if err != nil {
+			return nil, err
+		}
+
+		stack, err = new_limited_array_stack[T](s)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		err := s.Only("ArrayStack", append(array_stack_options, "WithThreadSafety")...)
+		if err != nil {
+			return nil, err
+		}
+
+		stack, err = new_array_stack[T](s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if s.ThreadSafe {
+		stack = &safe_stack[T]{
+			stack: stack,
+		}
+	}
+
+	return stack, nil
+}
+
+// safe_stack is a Stacker that guards every method of another one with a mutex.
+type safe_stack[T any] struct {
+	// stack is the guarded stack.
+	stack Stacker[T]
+
+	// mu is the mutex that guards the stack.
+	mu sync.RWMutex
+}
+
+// Push implements the Stacker interface.
+func (s *safe_stack[T]) Push(value T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.Push(value)
+}
+
+// PushMany implements the Stacker interface.
+func (s *safe_stack[T]) PushMany(values []T) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.PushMany(values)
+}
+
+// Pop implements the Stacker interface.
+func (s *safe_stack[T]) Pop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.Pop()
+}
+
+// Peek implements the Stacker interface.
+func (s *safe_stack[T]) Peek() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.stack.Peek()
+}
+
+// IsEmpty implements the Stacker interface.
+func (s *safe_stack[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.stack.IsEmpty()
+}
+
+// Size implements the Stacker interface.
+func (s *safe_stack[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.stack.Size()
+}
+
+// Clear implements the Stacker interface.
+func (s *safe_stack[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stack.Clear()
+}
+
+// Capacity implements the Stacker interface.
+func (s *safe_stack[T]) Capacity() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.stack.Capacity()
+}
+
+// IsFull implements the Stacker interface.
+func (s *safe_stack[T]) IsFull() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.stack.IsFull()
+}
+
+// Slice implements the Stacker interface.
+func (s *safe_stack[T]) Slice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.stack.Slice()
+}
+
+// Iterator implements the Stacker interface.
+//
+// The iterator works on a copy of the elements, so it is not affected by later
+// modifications of the stack.
+func (s *safe_stack[T]) Iterator() itrs.Iterater[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.stack.Iterator()
+}
+
+// layout returns the description of the stack used to format it.
+func (s *safe_stack[T]) layout() display.Container[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// The guarded stack is built by New, so it describes itself.
+	c := s.stack.(layouter[T]).layout()
+
+	c.Label = "Safe" + c.Name
+	c.Iface = "Stacker"
+	c.Ctor = "New"
+	c.Options = append([]string{display.Option("WithThreadSafety", true)}, c.Options...)
+	c.Values = slices.Clone(c.Values)
+
+	return c
+}
+
+// Format implements the fmt.Formatter interface.
+func (s *safe_stack[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, s.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (s *safe_stack[T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (s *safe_stack[T]) GoString() string {
+	return s.layout().GoSyntax()
+}
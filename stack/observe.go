@@ -0,0 +1,204 @@
+package stack
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/PlayerR9/listlike/internal/stamps"
+	"github.com/PlayerR9/listlike/observe"
+
+	gcers "github.com/PlayerR9/go-commons/errors"
+	itrs "github.com/PlayerR9/iterators/simple"
+)
+
+// Observe is a function that returns a stack that reports every operation
+// performed through it to an observer. The elements already in the stack are
+// considered pushed at the time of the call.
+//
+// The stack must only be modified through the returned one afterwards; otherwise,
+// the reported wait times are wrong. The returned stack is safe for concurrent use
+// if the stack is.
+//
+// Parameters:
+//   - stack: The stack to observe.
+//   - o: The observer.
+//
+// Returns:
+//   - Stacker[T]: The observed stack.
+//   - error: An error of type *errors.ErrInvalidParameter if stack or o is nil.
+func Observe[T any](stack Stacker[T], o observe.Observer) (Stacker[T], error) {
+	if stack == nil {
+		return nil, gcers.NewErrNilParameter("stack")
+	} else if o == nil {
+		return nil, gcers.NewErrNilParameter("o")
+	}
+
+	s := &observed_stack[T]{
+		stack:    stack,
+		observer: o,
+	}
+
+	s.stamps.Fill(stack.Size(), time.Now())
+
+	return s, nil
+}
+
+// observed_stack is a Stacker that reports the operations performed on another one
+// to an observer.
+type observed_stack[T any] struct {
+	// stack is the observed stack.
+	stack Stacker[T]
+
+	// observer is the observer to report to.
+	observer observe.Observer
+
+	// stamps are the times at which the elements were pushed, from the bottom to
+	// the top.
+	stamps stamps.Deque
+
+	// mu is the mutex that serializes the modifications, so that the stamps match
+	// the elements.
+	mu sync.Mutex
+}
+
+// Push implements the Stacker interface.
+func (s *observed_stack[T]) Push(value T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	size := s.stack.Size()
+
+	ok := s.stack.Push(value)
+	if !ok {
+		s.observer.OnOverflow(observe.Event{Size: size, Time: time.Now()})
+	} else {
+		s.pushed(size, 1)
+	}
+
+	return ok
+}
+
+// PushMany implements the Stacker interface.
+func (s *observed_stack[T]) PushMany(values []T) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	size := s.stack.Size()
+
+	n := s.stack.PushMany(values)
+	s.pushed(size, n)
+
+	now := time.Now()
+
+	for i := n; i < len(values); i++ {
+		s.observer.OnOverflow(observe.Event{Size: s.stack.Size(), Time: now})
+	}
+
+	return n
+}
+
+// Pop implements the Stacker interface.
+func (s *observed_stack[T]) Pop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.stack.Pop()
+	if ok {
+		now := time.Now()
+
+		s.observer.OnPop(observe.Event{Size: s.stack.Size(), Time: now, Wait: s.stamps.PopBack(now)})
+	}
+
+	return value, ok
+}
+
+// Peek implements the Stacker interface.
+func (s *observed_stack[T]) Peek() (T, bool) {
+	return s.stack.Peek()
+}
+
+// IsEmpty implements the Stacker interface.
+func (s *observed_stack[T]) IsEmpty() bool {
+	return s.stack.IsEmpty()
+}
+
+// Size implements the Stacker interface.
+func (s *observed_stack[T]) Size() int {
+	return s.stack.Size()
+}
+
+// Clear implements the Stacker interface.
+func (s *observed_stack[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	size := s.stack.Size()
+
+	s.stack.Clear()
+	s.stamps.Clear()
+
+	s.observer.OnClear(observe.Event{Size: size, Time: time.Now()})
+}
+
+// Capacity implements the Stacker interface.
+func (s *observed_stack[T]) Capacity() int {
+	return s.stack.Capacity()
+}
+
+// IsFull implements the Stacker interface.
+func (s *observed_stack[T]) IsFull() bool {
+	return s.stack.IsFull()
+}
+
+// Slice implements the Stacker interface.
+func (s *observed_stack[T]) Slice() []T {
+	return s.stack.Slice()
+}
+
+// Iterator implements the Stacker interface.
+func (s *observed_stack[T]) Iterator() itrs.Iterater[T] {
+	return s.stack.Iterator()
+}
+
+// Format implements the fmt.Formatter interface.
+//
+// The stack is formatted as the observed one.
+func (s *observed_stack[T]) Format(f fmt.State, verb rune) {
+	format_wrapped(f, verb, "Observed", s.stack)
+}
+
+// String implements the fmt.Stringer interface.
+func (s *observed_stack[T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString implements the fmt.GoStringer interface.
+//
+// The observer cannot be rebuilt, so the Go syntax is the one of the observed
+// stack.
+func (s *observed_stack[T]) GoString() string {
+	return s.stack.GoString()
+}
+
+// pushed reports that n values were pushed on a stack of the given size, along
+// with the elements the stack dropped to make room for them. The caller must hold
+// the lock.
+//
+// Parameters:
+//   - size: The size of the stack before the push.
+//   - n: The number of values pushed.
+func (s *observed_stack[T]) pushed(size, n int) {
+	now := time.Now()
+	after := s.stack.Size()
+
+	s.stamps.Fill(n, now)
+
+	for i := 0; i < n; i++ {
+		s.observer.OnPush(observe.Event{Size: after, Time: now})
+	}
+
+	for i := after; i < size+n; i++ {
+		s.observer.OnOverflow(observe.Event{Size: after, Time: now, Wait: s.stamps.PopFront(now)})
+	}
+}
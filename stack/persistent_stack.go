@@ -0,0 +1,224 @@
+package stack
+
+import (
+	"fmt"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+
+	itrs "github.com/PlayerR9/iterators/simple"
+)
+
+// persistent_stack_node is an immutable node of a persistent stack. Once created, a
+// node is never modified, so it can be shared by any number of stacks.
+type persistent_stack_node[T any] struct {
+	// value is the value stored in the node.
+	value T
+
+	// next is a pointer to the node below this one.
+	next *persistent_stack_node[T]
+}
+
+// PersistentStack is a generic type that represents an immutable stack data
+// structure, implemented using a linked list whose nodes are shared between
+// versions.
+//
+// Push and Pop never modify the stack they are called on; instead, they return a
+// new version in O(1) time that shares all its nodes with the original. This makes
+// it cheap to keep many snapshots around, for instance when backtracking.
+//
+// Because of this, it does not implement the Stacker interface. The zero value and
+// the nil pointer are both valid empty stacks.
+type PersistentStack[T any] struct {
+	// front is a pointer to the top node of the stack.
+	front *persistent_stack_node[T]
+
+	// size is the number of elements in the stack.
+	size int
+}
+
+// NewPersistentStack is a function that creates and returns a new instance of a
+// PersistentStack.
+//
+// Parameters:
+//   - opts: The options of the stack. The only supported option is
+//     WithInitialValues, which are pushed in order so that the last one is on top.
+//
+// Returns:
+//   - *PersistentStack[T]: A pointer to the newly created PersistentStack.
+//   - error: An error if the options are invalid.
+func NewPersistentStack[T any](opts ...options.Option) (*PersistentStack[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Only("PersistentStack", "WithInitialValues")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := options.InitialValues[T](s)
+	if err != nil {
+		return nil, err
+	}
+
+	stack := &PersistentStack[T]{
+		size: len(values),
+	}
+
+	for _, value := range values {
+		stack.front = &persistent_stack_node[T]{
+			value: value,
+			next:  stack.front,
+		}
+	}
+
+	return stack, nil
+}
+
+// Push is a method of the PersistentStack type. It returns a new version of the
+// stack with the value on top. The stack itself is left unchanged.
+//
+// Parameters:
+//   - value: The value to push.
+//
+// Returns:
+//   - *PersistentStack[T]: The new version of the stack. Never returns nil.
+func (stack *PersistentStack[T]) Push(value T) *PersistentStack[T] {
+	if stack == nil {
+		stack = &PersistentStack[T]{}
+	}
+
+	return &PersistentStack[T]{
+		front: &persistent_stack_node[T]{
+			value: value,
+			next:  stack.front,
+		},
+		size: stack.size + 1,
+	}
+}
+
+// Pop is a method of the PersistentStack type. It returns a new version of the
+// stack without its top element. The stack itself is left unchanged.
+//
+// Returns:
+//   - *PersistentStack[T]: The new version of the stack. The stack itself if it is
+//     empty.
+//   - T: The value that was on top of the stack.
+//   - bool: True if the stack was not empty, false otherwise.
+func (stack *PersistentStack[T]) Pop() (*PersistentStack[T], T, bool) {
+	if stack == nil || stack.front == nil {
+		return stack, *new(T), false
+	}
+
+	popped := &PersistentStack[T]{
+		front: stack.front.next,
+		size:  stack.size - 1,
+	}
+
+	return popped, stack.front.value, true
+}
+
+// Peek is a method of the PersistentStack type. It returns the value on top of the
+// stack.
+//
+// Returns:
+//   - T: The value on top of the stack.
+//   - bool: True if the stack is not empty, false otherwise.
+func (stack *PersistentStack[T]) Peek() (T, bool) {
+	if stack == nil || stack.front == nil {
+		return *new(T), false
+	}
+
+	return stack.front.value, true
+}
+
+// IsEmpty is a method of the PersistentStack type. It is used to check if the stack
+// is empty.
+//
+// Returns:
+//   - bool: true if the stack is empty, and false otherwise.
+func (stack *PersistentStack[T]) IsEmpty() bool {
+	return stack == nil || stack.front == nil
+}
+
+// Size is a method of the PersistentStack type. It is used to return the number of
+// elements in the stack.
+//
+// Returns:
+//   - int: The number of elements in the stack.
+func (stack *PersistentStack[T]) Size() int {
+	if stack == nil {
+		return 0
+	}
+
+	return stack.size
+}
+
+// Iterator is a method of the PersistentStack type. It is used to return an
+// iterator over the elements in the stack, from the top to the bottom.
+//
+// Returns:
+//   - itrs.Iterater[T]: An iterator for the elements in the stack.
+func (stack *PersistentStack[T]) Iterator() itrs.Iterater[T] {
+	return itrs.NewSimpleIterator(stack.Slice())
+}
+
+// Slice is a method of the PersistentStack type. It is used to return a slice of
+// the elements in the stack.
+//
+// Returns:
+//   - []T: A slice of the elements in the stack. The 0th element is the top of the
+//     stack.
+func (stack *PersistentStack[T]) Slice() []T {
+	if stack == nil {
+		return nil
+	}
+
+	slice := make([]T, 0, stack.size)
+
+	for node := stack.front; node != nil; node = node.next {
+		slice = append(slice, node.value)
+	}
+
+	return slice
+}
+
+// layout returns the description of the stack used to format it.
+func (stack *PersistentStack[T]) layout() display.Container[T] {
+	values := make([]T, stack.Size())
+
+	if stack != nil {
+		i := len(values)
+
+		for node := stack.front; node != nil; node = node.next {
+			i--
+			values[i] = node.value
+		}
+	}
+
+	return display.Container[T]{
+		Pkg:      "stack",
+		Name:     "PersistentStack",
+		TypeArgs: []string{display.TypeName[T]()},
+		Capacity: -1,
+		Marker:   display.Top,
+		Values:   values,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (stack *PersistentStack[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, stack.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (stack *PersistentStack[T]) String() string {
+	return fmt.Sprint(stack)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (stack *PersistentStack[T]) GoString() string {
+	return stack.layout().GoSyntax()
+}
@@ -0,0 +1,71 @@
+package stack
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/PlayerR9/listlike/options"
+)
+
+// TestPersistentStackVersions checks that Push and Pop leave the stack they are
+// called on unchanged.
+func TestPersistentStackVersions(t *testing.T) {
+	base, err := NewPersistentStack[int](options.WithInitialValues[int](1, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pushed := base.Push(3)
+	other := base.Push(4)
+
+	popped, top, ok := pushed.Pop()
+	if !ok || top != 3 {
+		t.Fatalf("got %d, %t, want 3, true", top, ok)
+	}
+
+	tests := []struct {
+		name  string
+		stack *PersistentStack[int]
+		want  []int
+	}{
+		{"base", base, []int{2, 1}},
+		{"pushed", pushed, []int{3, 2, 1}},
+		{"other", other, []int{4, 2, 1}},
+		{"popped", popped, []int{2, 1}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.stack.Slice(); !slices.Equal(got, tt.want) || tt.stack.Size() != len(tt.want) {
+			t.Errorf("%s: got %v of size %d, want %v", tt.name, got, tt.stack.Size(), tt.want)
+		}
+
+		if top, ok := tt.stack.Peek(); !ok || top != tt.want[0] {
+			t.Errorf("%s: Peek gave %d, %t, want %d", tt.name, top, ok, tt.want[0])
+		}
+	}
+}
+
+// TestPersistentStackEmpty checks the nil and emptied stacks.
+func TestPersistentStackEmpty(t *testing.T) {
+	var empty *PersistentStack[int]
+
+	if !empty.IsEmpty() || empty.Size() != 0 || empty.Slice() != nil {
+		t.Fatal("the nil stack is not empty")
+	}
+
+	if stack, _, ok := empty.Pop(); ok || stack != empty {
+		t.Fatal("Pop on the nil stack succeeded")
+	}
+
+	stack := empty.Push(1)
+
+	stack, _, _ = stack.Pop()
+
+	if _, ok := stack.Peek(); ok || !stack.IsEmpty() {
+		t.Fatal("the emptied stack is not empty")
+	}
+
+	if !empty.IsEmpty() {
+		t.Fatal("Push modified the nil stack")
+	}
+}
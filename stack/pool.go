@@ -0,0 +1,83 @@
+package stack
+
+// node_pool is a free list of nodes that a linked stack reuses instead of
+// allocating new ones. A nil pool is valid and disables pooling.
+type node_pool[T any] struct {
+	// free is the first free node.
+	free *StackNode[T]
+
+	// size is the number of free nodes.
+	size int
+
+	// limit is the maximum number of free nodes.
+	limit int
+}
+
+// new_node_pool creates a new pool.
+//
+// Parameters:
+//   - limit: The maximum number of free nodes.
+//
+// Returns:
+//   - *node_pool[T]: The new pool. Nil if limit is not positive.
+func new_node_pool[T any](limit int) *node_pool[T] {
+	if limit <= 0 {
+		return nil
+	}
+
+	return &node_pool[T]{
+		limit: limit,
+	}
+}
+
+// get returns a node holding the value, reusing a free node if there is one.
+//
+// Parameters:
+//   - value: The value of the node.
+//
+// Returns:
+//   - *StackNode[T]: The node. Never returns nil.
+func (p *node_pool[T]) get(value T) *StackNode[T] {
+	if p == nil || p.free == nil {
+		return NewStackNode(value)
+	}
+
+	node := p.free
+
+	p.free = node.next
+	p.size--
+
+	node.Value = value
+	node.next = nil
+
+	return node
+}
+
+// put zeroes the node and keeps it for reuse unless the pool is full.
+//
+// Parameters:
+//   - node: The node. Assumed to be non-nil and no longer in a stack.
+func (p *node_pool[T]) put(node *StackNode[T]) {
+	if p == nil || p.size >= p.limit {
+		return
+	}
+
+	*node = StackNode[T]{
+		next: p.free,
+	}
+
+	p.free = node
+	p.size++
+}
+
+// copy returns an empty pool with the same limit.
+//
+// Returns:
+//   - *node_pool[T]: The new pool. Nil if the pool is nil.
+func (p *node_pool[T]) copy() *node_pool[T] {
+	if p == nil {
+		return nil
+	}
+
+	return new_node_pool[T](p.limit)
+}
@@ -0,0 +1,162 @@
+package stack
+
+import (
+	"testing"
+
+	"github.com/PlayerR9/listlike/options"
+)
+
+// pool_size is the node pool size used by the tests.
+const pool_size int = 8
+
+// TestNodePoolZeroes checks that a recycled node holds neither its value nor a link
+// into the stack, so a pooled node does not keep a value alive.
+func TestNodePoolZeroes(t *testing.T) {
+	value := new(int)
+
+	stack, err := NewLimitedLinkedStack[*int](options.WithNodePool(pool_size))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stack.Push(new(int))
+	stack.Push(value)
+
+	if got, _ := stack.Pop(); got != value {
+		t.Fatal("Pop did not return the pushed value")
+	}
+
+	node := stack.pool.free
+	if node == nil {
+		t.Fatal("the popped node was not pooled")
+	}
+
+	if node.Value != nil {
+		t.Error("the pooled node still holds its value")
+	}
+
+	if node.next != nil {
+		t.Error("the pooled node still links to the stack")
+	}
+
+	reused := stack.pool.get(nil)
+	if reused != node || reused.next != nil {
+		t.Error("get did not reuse the pooled node with a cleared link")
+	}
+}
+
+// TestLinkedStackPoolZeroes checks the same for the generated linked stacks.
+func TestLinkedStackPoolZeroes(t *testing.T) {
+	value := new(int)
+
+	stack, err := NewLinkedStack[*int](options.WithNodePool(pool_size))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stack.Push(new(int))
+	stack.Push(value)
+	stack.Pop()
+
+	node := stack.free
+	if node == nil {
+		t.Fatal("the popped node was not pooled")
+	}
+
+	if node.value != nil {
+		t.Error("the pooled node still holds its value")
+	}
+
+	if node.next != nil {
+		t.Error("the pooled node still links to the stack")
+	}
+}
+
+// TestNodePoolLimit checks that the pool keeps no more nodes than its limit.
+func TestNodePoolLimit(t *testing.T) {
+	stack, err := NewLimitedLinkedStack[int](options.WithNodePool(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 5; i++ {
+		stack.Push(i)
+	}
+
+	for !stack.IsEmpty() {
+		stack.Pop()
+	}
+
+	if stack.pool.size != 2 {
+		t.Errorf("got %d pooled nodes, want 2", stack.pool.size)
+	}
+}
+
+// TestNodePoolNoAllocs checks that pushing and popping on a warmed up stack does
+// not allocate.
+func TestNodePoolNoAllocs(t *testing.T) {
+	limited, err := NewLimitedLinkedStack[int](options.WithNodePool(pool_size))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	linked, err := NewLinkedStack[int](options.WithNodePool(pool_size))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ints, err := NewIntStack(options.WithNodePool(pool_size))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		run  func()
+	}{
+		{"LimitedLinkedStack", func() { limited.Push(1); limited.Pop() }},
+		{"LinkedStack", func() { linked.Push(1); linked.Pop() }},
+		{"IntStack", func() { ints.Push(1); ints.Pop() }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Warm up the pool.
+			tt.run()
+
+			if allocs := testing.AllocsPerRun(100, tt.run); allocs != 0 {
+				t.Errorf("got %v allocations per push and pop, want 0", allocs)
+			}
+		})
+	}
+}
+
+// BenchmarkNodePool measures a push and a pop on a stack with and without a node
+// pool.
+func BenchmarkNodePool(b *testing.B) {
+	for _, size := range []int{0, pool_size} {
+		name := "NoPool"
+		if size > 0 {
+			name = "Pool"
+		}
+
+		b.Run(name, func(b *testing.B) {
+			var opts []options.Option
+			if size > 0 {
+				opts = append(opts, options.WithNodePool(size))
+			}
+
+			stack, err := NewLinkedStack[int](opts...)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				stack.Push(i)
+				stack.Pop()
+			}
+		})
+	}
+}
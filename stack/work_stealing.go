@@ -0,0 +1,441 @@
+package stack
+
+import (
+	"fmt"
+	"math/bits"
+	"math/rand/v2"
+	"sync/atomic"
+
+	"github.com/PlayerR9/listlike/display"
+	"github.com/PlayerR9/listlike/options"
+
+	gcers "github.com/PlayerR9/go-commons/errors"
+	gcint "github.com/PlayerR9/go-commons/ints"
+)
+
+const (
+	// ws_default_size is the default size of the array of a work-stealing deque.
+	ws_default_size int = 32
+)
+
+// ws_array is the circular array of a work-stealing deque. Each slot holds a
+// pointer to an immutable copy of its value, so that thieves can read it without
+// racing with the owner.
+type ws_array[T any] struct {
+	// slots are the slots of the array. Their number is a power of two.
+	slots []atomic.Pointer[T]
+
+	// mask is len(slots) - 1.
+	mask int64
+}
+
+// new_ws_array creates a new circular array.
+//
+// Parameters:
+//   - size: The number of slots. Assumed to be a power of two.
+//
+// Returns:
+//   - *ws_array[T]: The new array. Never returns nil.
+func new_ws_array[T any](size int) *ws_array[T] {
+	return &ws_array[T]{
+		slots: make([]atomic.Pointer[T], size),
+		mask:  int64(size - 1),
+	}
+}
+
+// slot returns the slot of the given index.
+//
+// Parameters:
+//   - i: The index. It wraps around the array.
+//
+// Returns:
+//   - *atomic.Pointer[T]: The slot. Never returns nil.
+func (a *ws_array[T]) slot(i int64) *atomic.Pointer[T] {
+	return &a.slots[i&a.mask]
+}
+
+// grow returns an array twice as large holding the elements in [top, bottom).
+//
+// Parameters:
+//   - top: The index of the first element.
+//   - bottom: The index past the last element.
+//
+// Returns:
+//   - *ws_array[T]: The new array. Never returns nil.
+func (a *ws_array[T]) grow(top, bottom int64) *ws_array[T] {
+	grown := new_ws_array[T](2 * len(a.slots))
+
+	for i := top; i < bottom; i++ {
+		grown.slot(i).Store(a.slot(i).Load())
+	}
+
+	return grown
+}
+
+// WorkStealingDeque is a generic type that represents a Chase–Lev work-stealing
+// deque: a stack owned by one goroutine, from whose bottom other goroutines can
+// steal elements.
+//
+// Push and Pop must only be called by the owner goroutine; they work at the top
+// of the stack and never block. Steal can be called by any goroutine; it takes the
+// element at the bottom of the stack, that is, the oldest one. The elements are
+// kept in a circular array that grows as needed and never shrinks.
+//
+// The zero value is not ready to use; see NewWorkStealingDeque.
+type WorkStealingDeque[T any] struct {
+	// top is the index of the oldest element; thieves steal from it.
+	top atomic.Int64
+
+	// bottom is the index past the newest element; the owner pushes and pops at
+	// it.
+	bottom atomic.Int64
+
+	// array is the current circular array.
+	array atomic.Pointer[ws_array[T]]
+}
+
+// NewWorkStealingDeque is a function that creates and returns a new instance of a
+// WorkStealingDeque.
+//
+// Parameters:
+//   - opts: The options of the deque. The only supported option is WithReserve,
+//     which sets the initial size of the array; it is rounded up to a power of
+//     two. The default is 32.
+//
+// Returns:
+//   - *WorkStealingDeque[T]: A pointer to the newly created WorkStealingDeque.
+//   - error: An error if the options are invalid.
+func NewWorkStealingDeque[T any](opts ...options.Option) (*WorkStealingDeque[T], error) {
+	s, err := options.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Only("WorkStealingDeque", "WithReserve")
+	if err != nil {
+		return nil, err
+	}
+
+	size := ws_default_size
+	if s.Has("WithReserve") {
+		size = 1 << bits.Len(uint(max(s.Reserve, 2)-1))
+	}
+
+	deque := &WorkStealingDeque[T]{}
+	deque.array.Store(new_ws_array[T](size))
+
+	return deque, nil
+}
+
+// Push is a method of the WorkStealingDeque type. It adds a value at the top of
+// the stack. Only the owner may call it.
+//
+// Parameters:
+//   - value: The value to add.
+func (deque *WorkStealingDeque[T]) Push(value T) {
+	b := deque.bottom.Load()
+	t := deque.top.Load()
+	a := deque.array.Load()
+
+	if b-t >= int64(len(a.slots))-1 {
+		a = a.grow(t, b)
+		deque.array.Store(a)
+	}
+
+	a.slot(b).Store(&value)
+	deque.bottom.Store(b + 1)
+}
+
+// Pop is a method of the WorkStealingDeque type. It removes the value at the top
+// of the stack, that is, the newest one. Only the owner may call it.
+//
+// Returns:
+//   - T: The value.
+//   - bool: True if a value was removed, false if the deque is empty or a thief
+//     took the last value first.
+func (deque *WorkStealingDeque[T]) Pop() (T, bool) {
+	b := deque.bottom.Load() - 1
+	a := deque.array.Load()
+
+	deque.bottom.Store(b)
+
+	t := deque.top.Load()
+
+	if t > b {
+		// Empty.
+		deque.bottom.Store(b + 1)
+
+		return *new(T), false
+	}
+
+	slot := a.slot(b)
+	ptr := slot.Load()
+
+	if t == b {
+		// Last value: race against the thieves for it.
+		ok := deque.top.CompareAndSwap(t, t+1)
+
+		deque.bottom.Store(b + 1)
+
+		if !ok {
+			return *new(T), false
+		}
+	}
+
+	slot.CompareAndSwap(ptr, nil) // Release the reference to the value.
+
+	return *ptr, true
+}
+
+// Steal is a method of the WorkStealingDeque type. It removes the value at the
+// bottom of the stack, that is, the oldest one. Any goroutine may call it.
+//
+// Returns:
+//   - T: The value.
+//   - bool: True if a value was removed, false if the deque is empty or another
+//     goroutine took the value first.
+func (deque *WorkStealingDeque[T]) Steal() (T, bool) {
+	t := deque.top.Load()
+	b := deque.bottom.Load()
+
+	if t >= b {
+		return *new(T), false
+	}
+
+	a := deque.array.Load()
+
+	slot := a.slot(t)
+	ptr := slot.Load()
+
+	if ptr == nil || !deque.top.CompareAndSwap(t, t+1) {
+		return *new(T), false
+	}
+
+	slot.CompareAndSwap(ptr, nil) // Release the reference to the value.
+
+	return *ptr, true
+}
+
+// Size is a method of the WorkStealingDeque type. It returns the number of values
+// in the deque. It is only a snapshot when other goroutines use the deque.
+//
+// Returns:
+//   - int: The number of values.
+func (deque *WorkStealingDeque[T]) Size() int {
+	t := deque.top.Load()
+	b := deque.bottom.Load()
+
+	return int(max(b-t, 0))
+}
+
+// IsEmpty is a method of the WorkStealingDeque type. It checks if the deque is
+// empty. It is only a snapshot when other goroutines use the deque.
+//
+// Returns:
+//   - bool: True if the deque is empty, false otherwise.
+func (deque *WorkStealingDeque[T]) IsEmpty() bool {
+	return deque.Size() == 0
+}
+
+// layout returns the description of the deque used to format it.
+func (deque *WorkStealingDeque[T]) layout() display.Container[T] {
+	// The values are only a snapshot when other goroutines use the deque.
+	t := deque.top.Load()
+	b := deque.bottom.Load()
+	a := deque.array.Load()
+
+	values := make([]T, 0, max(b-t, 0))
+
+	for i := t; i < b; i++ {
+		ptr := a.slot(i).Load()
+		if ptr != nil {
+			values = append(values, *ptr)
+		}
+	}
+
+	return display.Container[T]{
+		Pkg:      "stack",
+		Name:     "WorkStealingDeque",
+		TypeArgs: []string{display.TypeName[T]()},
+		Options:  []string{display.Option("WithReserve", len(a.slots))},
+		Capacity: -1,
+		Marker:   display.Top,
+		Values:   values,
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (deque *WorkStealingDeque[T]) Format(f fmt.State, verb rune) {
+	display.Format(f, verb, deque.layout())
+}
+
+// String implements the fmt.Stringer interface.
+func (deque *WorkStealingDeque[T]) String() string {
+	return fmt.Sprint(deque)
+}
+
+// GoString implements the fmt.GoStringer interface.
+func (deque *WorkStealingDeque[T]) GoString() string {
+	return deque.layout().GoSyntax()
+}
+
+// WorkStealingPool is a generic type that coordinates the work-stealing deques of
+// a fixed number of workers. Worker i owns the i-th deque: only it may push to
+// and pop from it, while any worker may steal from the others.
+type WorkStealingPool[T any] struct {
+	// deques are the deques of the workers.
+	deques []*WorkStealingDeque[T]
+}
+
+// NewWorkStealingPool is a function that creates and returns a new instance of a
+// WorkStealingPool.
+//
+// Parameters:
+//   - n: The number of workers. Must be positive.
+//   - opts: The options of each deque. See NewWorkStealingDeque.
+//
+// Returns:
+//   - *WorkStealingPool[T]: A pointer to the newly created WorkStealingPool.
+//   - error: An error of type *errors.ErrInvalidParameter if n is not positive, or
+//     an error if the options are invalid.
+func NewWorkStealingPool[T any](n int, opts ...options.Option) (*WorkStealingPool[T], error) {
+	if n <= 0 {
+		return nil, gcers.NewErrInvalidParameter("n", gcint.NewErrGT(0))
+	}
+
+	deques := make([]*WorkStealingDeque[T], 0, n)
+
+	for i := 0; i < n; i++ {
+		deque, err := NewWorkStealingDeque[T](opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		deques = append(deques, deque)
+	}
+
+	return &WorkStealingPool[T]{
+		deques: deques,
+	}, nil
+}
+
+// Workers is a method of the WorkStealingPool type. It returns the number of
+// workers of the pool.
+//
+// Returns:
+//   - int: The number of workers.
+func (pool *WorkStealingPool[T]) Workers() int {
+	return len(pool.deques)
+}
+
+// Deque is a method of the WorkStealingPool type. It returns the deque of a
+// worker.
+//
+// Parameters:
+//   - worker: The index of the worker. Must be in the range [0, Workers()).
+//
+// Returns:
+//   - *WorkStealingDeque[T]: The deque.
+//   - error: An error of type *errors.ErrInvalidParameter if worker is out of
+//     bounds.
+func (pool *WorkStealingPool[T]) Deque(worker int) (*WorkStealingDeque[T], error) {
+	if worker < 0 || worker >= len(pool.deques) {
+		return nil, gcers.NewErrInvalidParameter("worker", gcint.NewErrOutOfBounds(worker, 0, len(pool.deques)))
+	}
+
+	return pool.deques[worker], nil
+}
+
+// Push is a method of the WorkStealingPool type. It adds a value to the deque of
+// a worker. Only that worker may call it.
+//
+// Parameters:
+//   - worker: The index of the worker. Must be in the range [0, Workers()).
+//   - value: The value to add.
+//
+// Returns:
+//   - error: An error of type *errors.ErrInvalidParameter if worker is out of
+//     bounds.
+func (pool *WorkStealingPool[T]) Push(worker int, value T) error {
+	deque, err := pool.Deque(worker)
+	if err != nil {
+		return err
+	}
+
+	deque.Push(value)
+
+	return nil
+}
+
+// Next is a method of the WorkStealingPool type. It returns the next value for a
+// worker: the newest value of its own deque or, if it is empty, a value stolen
+// from another worker. Only that worker may call it.
+//
+// Parameters:
+//   - worker: The index of the worker. Must be in the range [0, Workers()).
+//
+// Returns:
+//   - T: The value.
+//   - bool: True if a value was found, false otherwise.
+//   - error: An error of type *errors.ErrInvalidParameter if worker is out of
+//     bounds.
+func (pool *WorkStealingPool[T]) Next(worker int) (T, bool, error) {
+	deque, err := pool.Deque(worker)
+	if err != nil {
+		return *new(T), false, err
+	}
+
+	value, ok := deque.Pop()
+	if ok {
+		return value, true, nil
+	}
+
+	value, ok = pool.Steal(worker)
+
+	return value, ok, nil
+}
+
+// Steal is a method of the WorkStealingPool type. It steals the oldest value of
+// one of the other workers, starting from a random one so that the thieves spread
+// out. Any goroutine may call it.
+//
+// Parameters:
+//   - thief: The index of the worker that steals, whose deque is skipped. Use -1
+//     to steal from every deque.
+//
+// Returns:
+//   - T: The value.
+//   - bool: True if a value was stolen, false if every other deque looked empty.
+func (pool *WorkStealingPool[T]) Steal(thief int) (T, bool) {
+	n := len(pool.deques)
+	start := rand.IntN(n)
+
+	for i := 0; i < n; i++ {
+		victim := (start + i) % n
+		if victim == thief {
+			continue
+		}
+
+		value, ok := pool.deques[victim].Steal()
+		if ok {
+			return value, true
+		}
+	}
+
+	return *new(T), false
+}
+
+// Size is a method of the WorkStealingPool type. It returns the number of values
+// in all the deques. It is only a snapshot when the pool is in use.
+//
+// Returns:
+//   - int: The number of values.
+func (pool *WorkStealingPool[T]) Size() int {
+	var size int
+
+	for _, deque := range pool.deques {
+		size += deque.Size()
+	}
+
+	return size
+}
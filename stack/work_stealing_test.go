@@ -0,0 +1,314 @@
+package stack
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/PlayerR9/listlike/options"
+)
+
+// TestWorkStealingDequeOrder checks that the owner pops the newest values and the
+// thieves steal the oldest ones, across a growth of the array.
+func TestWorkStealingDequeOrder(t *testing.T) {
+	deque, err := NewWorkStealingDeque[int](options.WithReserve(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 10; i++ {
+		deque.Push(i)
+	}
+
+	if deque.Size() != 10 {
+		t.Fatalf("got size %d, want 10", deque.Size())
+	}
+
+	if got, ok := deque.Steal(); !ok || got != 0 {
+		t.Fatalf("Steal() = %d, %t; want 0, true", got, ok)
+	}
+
+	if got, ok := deque.Pop(); !ok || got != 9 {
+		t.Fatalf("Pop() = %d, %t; want 9, true", got, ok)
+	}
+
+	for want := 8; want > 0; want-- {
+		if got, ok := deque.Pop(); !ok || got != want {
+			t.Fatalf("Pop() = %d, %t; want %d, true", got, ok, want)
+		}
+	}
+
+	if _, ok := deque.Pop(); ok {
+		t.Fatal("Pop succeeded on an empty deque")
+	}
+
+	if _, ok := deque.Steal(); ok {
+		t.Fatal("Steal succeeded on an empty deque")
+	}
+}
+
+// TestWorkStealingDequeConcurrent checks that, while the owner pushes and pops
+// and several thieves steal, every pushed value is received exactly once.
+func TestWorkStealingDequeConcurrent(t *testing.T) {
+	const (
+		n       = 50000
+		thieves = 4
+	)
+
+	deque, err := NewWorkStealingDeque[int](options.WithReserve(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		done     atomic.Bool
+		wg       sync.WaitGroup
+		received = make([][]int, thieves+1)
+	)
+
+	for i := 1; i <= thieves; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			for {
+				value, ok := deque.Steal()
+				if ok {
+					received[i] = append(received[i], value)
+					continue
+				}
+
+				if done.Load() && deque.IsEmpty() {
+					return
+				}
+
+				runtime.Gosched()
+			}
+		}(i)
+	}
+
+	for i := 0; i < n; i++ {
+		deque.Push(i)
+
+		// Pop now and then, so that the owner and the thieves race for the last
+		// values.
+		if i%3 == 0 {
+			if value, ok := deque.Pop(); ok {
+				received[0] = append(received[0], value)
+			}
+		}
+	}
+
+	for {
+		value, ok := deque.Pop()
+		if !ok {
+			break
+		}
+
+		received[0] = append(received[0], value)
+	}
+
+	done.Store(true)
+	wg.Wait()
+
+	seen := make([]int, n)
+
+	for _, values := range received {
+		for _, value := range values {
+			seen[value]++
+		}
+	}
+
+	for value, count := range seen {
+		if count != 1 {
+			t.Fatalf("value %d was received %d times, want 1", value, count)
+		}
+	}
+}
+
+// TestWorkStealingPoolConcurrent checks that the workers of a pool together
+// receive every pushed value exactly once.
+func TestWorkStealingPoolConcurrent(t *testing.T) {
+	const (
+		workers = 4
+		n       = 10000
+	)
+
+	pool, err := NewWorkStealingPool[int](workers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		pushed   atomic.Int64
+		received = make([][]int, workers)
+	)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func(w int) {
+			defer wg.Done()
+
+			// Each worker pushes its share of the values and then drains the pool.
+			for i := w; i < n; i += workers {
+				if err := pool.Push(w, i); err != nil {
+					t.Error(err)
+					return
+				}
+
+				pushed.Add(1)
+			}
+
+			for {
+				value, ok, err := pool.Next(w)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+
+				if ok {
+					received[w] = append(received[w], value)
+					continue
+				}
+
+				if pushed.Load() == n && pool.Size() == 0 {
+					return
+				}
+
+				runtime.Gosched()
+			}
+		}(w)
+	}
+
+	wg.Wait()
+
+	seen := make([]int, n)
+
+	for _, values := range received {
+		for _, value := range values {
+			seen[value]++
+		}
+	}
+
+	for value, count := range seen {
+		if count != 1 {
+			t.Fatalf("value %d was received %d times, want 1", value, count)
+		}
+	}
+}
+
+// mutex_stack is an ArrayStack guarded by a mutex, the baseline of the benchmarks.
+type mutex_stack struct {
+	mu    sync.Mutex
+	stack *ArrayStack[int]
+}
+
+// push pushes a value under the lock.
+func (s *mutex_stack) push(value int) {
+	s.mu.Lock()
+	s.stack.Push(value)
+	s.mu.Unlock()
+}
+
+// pop pops a value under the lock.
+func (s *mutex_stack) pop() (int, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.Pop()
+}
+
+// new_mutex_stack returns an empty mutex_stack.
+func new_mutex_stack(b *testing.B) *mutex_stack {
+	stack, err := NewArrayStack[int]()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return &mutex_stack{
+		stack: stack,
+	}
+}
+
+// BenchmarkOwnerPushPop measures a push and a pop by a single goroutine.
+func BenchmarkOwnerPushPop(b *testing.B) {
+	b.Run("WorkStealingDeque", func(b *testing.B) {
+		deque, err := NewWorkStealingDeque[int]()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		for i := 0; i < b.N; i++ {
+			deque.Push(i)
+			deque.Pop()
+		}
+	})
+
+	b.Run("MutexArrayStack", func(b *testing.B) {
+		stack := new_mutex_stack(b)
+
+		for i := 0; i < b.N; i++ {
+			stack.push(i)
+			stack.pop()
+		}
+	})
+}
+
+// BenchmarkContended measures a push and a pop by the owner while thieves keep
+// taking values from the same stack.
+func BenchmarkContended(b *testing.B) {
+	const thieves = 4
+
+	run := func(b *testing.B, push func(int), pop func() (int, bool), steal func() (int, bool)) {
+		var (
+			done atomic.Bool
+			wg   sync.WaitGroup
+		)
+
+		for i := 0; i < thieves; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				for !done.Load() {
+					if _, ok := steal(); !ok {
+						runtime.Gosched()
+					}
+				}
+			}()
+		}
+
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			push(i)
+			push(i)
+			pop()
+		}
+
+		b.StopTimer()
+
+		done.Store(true)
+		wg.Wait()
+	}
+
+	b.Run("WorkStealingDeque", func(b *testing.B) {
+		deque, err := NewWorkStealingDeque[int]()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		run(b, deque.Push, deque.Pop, deque.Steal)
+	})
+
+	b.Run("MutexArrayStack", func(b *testing.B) {
+		stack := new_mutex_stack(b)
+
+		run(b, stack.push, stack.pop, stack.pop)
+	})
+}